@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/google/uuid"
+)
+
+const apiKeyUsage = `usage:
+  apikey create -name NAME -scopes read,write,admin [-tier TIER]
+  apikey list
+  apikey revoke ID`
+
+// runAPIKeyCommand implements the apikey subcommand, which manages the keys
+// accepted by the API.
+func runAPIKeyCommand(ctx context.Context, keys service.APIKeyService, args []string) error {
+	if len(args) == 0 {
+		return errors.New(apiKeyUsage)
+	}
+
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("apikey create", flag.ContinueOnError)
+		name := fs.String("name", "", "name of the key, recorded as the actor in the audit log")
+		scopeList := fs.String("scopes", string(model.ScopeRead), "comma separated scopes: read, write, admin")
+		tier := fs.String("tier", model.TierStandard, "rate limit tier")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *name == "" {
+			return errors.New("-name is required")
+		}
+
+		var scopes []model.Scope
+		for _, s := range strings.Split(*scopeList, ",") {
+			scope, err := model.ParseScope(strings.TrimSpace(s))
+			if err != nil {
+				return err
+			}
+			scopes = append(scopes, scope)
+		}
+
+		key, secret, err := keys.CreateAPIKey(ctx, *name, scopes, *tier)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Created API key %s (%s)\n", key.ID, key.Name)
+		fmt.Printf("Key: %s\n", secret)
+		fmt.Println("Store it now, it cannot be shown again.")
+		return nil
+
+	case "list":
+		list, err := keys.ListAPIKeys(ctx)
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tNAME\tPREFIX\tSCOPES\tTIER\tCREATED\tLAST USED\tREVOKED")
+		for _, key := range list {
+			scopes := make([]string, len(key.Scopes))
+			for i, s := range key.Scopes {
+				scopes[i] = string(s)
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				key.ID, key.Name, key.Prefix, strings.Join(scopes, ","), key.Tier,
+				key.CreatedAt.Format(time.RFC3339), formatOptionalTime(key.LastUsedAt), formatOptionalTime(key.RevokedAt))
+		}
+		return tw.Flush()
+
+	case "revoke":
+		if len(args) != 2 {
+			return errors.New(apiKeyUsage)
+		}
+		id, err := uuid.Parse(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid key ID: %w", err)
+		}
+		if err := keys.RevokeAPIKey(ctx, id); err != nil {
+			return err
+		}
+		fmt.Printf("Revoked API key %s\n", id)
+		return nil
+
+	default:
+		return errors.New(apiKeyUsage)
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ChinmayNoob/f1/internal/config"
+)
+
+// runConfigCommand implements "config show [flags]", which prints the
+// effective configuration with secrets redacted. The flags are the server's.
+func runConfigCommand(w io.Writer, args []string) error {
+	if len(args) == 0 || args[0] != "show" {
+		return errors.New("usage: config show [flags]")
+	}
+
+	cfg, err := config.Load(args[1:])
+	if err != nil {
+		return fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg.Write(w)
+}
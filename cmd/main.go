@@ -2,62 +2,377 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/ChinmayNoob/f1/internal/analytics"
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/config"
+	"github.com/ChinmayNoob/f1/internal/cors"
+	"github.com/ChinmayNoob/f1/internal/graphqlapi"
+	"github.com/ChinmayNoob/f1/internal/grpcapi"
 	"github.com/ChinmayNoob/f1/internal/handler"
+	"github.com/ChinmayNoob/f1/internal/logging"
+	"github.com/ChinmayNoob/f1/internal/metrics"
+	"github.com/ChinmayNoob/f1/internal/middleware"
+	"github.com/ChinmayNoob/f1/internal/openapi"
+	"github.com/ChinmayNoob/f1/internal/querystats"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/render"
 	"github.com/ChinmayNoob/f1/internal/repository"
 	"github.com/ChinmayNoob/f1/internal/router"
 	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
+	"google.golang.org/grpc"
 )
 
 func main() {
-	ctx := context.Background()
-	db.InitDB(ctx)
-	defer db.Conn.Close(ctx)
+	args := os.Args[1:]
+	if len(args) > 0 && args[0] == "config" {
+		if err := runConfigCommand(os.Stdout, args[1:]); err != nil {
+			log.Fatalf("config: %v", err)
+		}
+		return
+	}
 
-	constructorRepo := repository.NewConstructorRepository()
-	constructorService := service.NewConstructorService(constructorRepo)
-	constructorHandler := handler.NewConstructorHandler(ctx, constructorService)
+	// The apikey subcommand has flags of its own, so it only takes settings
+	// from the config file and the environment.
+	var apiKeyArgs []string
+	if len(args) > 0 && args[0] == "apikey" {
+		apiKeyArgs, args = args[1:], nil
+	}
+	cfg, err := config.Load(args)
+	if err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
 
-	driverRepo := repository.NewDriverRepository()
-	driverService := service.NewDriverService(driverRepo)
-	driverHandler := handler.NewDriverHandler(ctx, driverService)
+	logLevel, _ := logging.ParseLevel(cfg.Log.Level)
+	slog.SetDefault(slog.New(logging.NewHandler(os.Stdout, logLevel)))
 
-	circuitRepo := repository.NewCircuitRepository()
-	circuitService := service.NewCircuitService(circuitRepo)
-	circuitHandler := handler.NewCircuitHandler(ctx, circuitService)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing.Exporter)
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
+	}
 
-	seasonRepo := repository.NewSeasonRepository()
-	seasonService := service.NewSeasonService(seasonRepo)
-	seasonHandler := handler.NewSeasonHandler(ctx, seasonService)
+	tracers := []pgx.QueryTracer{tracing.QueryTracer{}}
+	if cfg.Features.Metrics {
+		tracers = append(tracers, metrics.QueryTracer{})
+	}
+	var queryStats *querystats.Collector
+	if cfg.Features.QueryStats {
+		queryStats = querystats.NewCollector(cfg.Queries.SlowThreshold, cfg.Queries.LogSlowArgs)
+		tracers = append(tracers, queryStats)
+	}
+	db.Tracer = multitracer.New(tracers...)
 
-	raceRepo := repository.NewRaceRepository()
-	raceService := service.NewRaceService(raceRepo)
-	raceHandler := handler.NewRaceHandler(ctx, raceService)
+	err = db.InitDB(ctx, db.Options{
+		URL:               cfg.Database.ConnString(),
+		MaxConns:          int32(cfg.Database.MaxConns),
+		MinConns:          int32(cfg.Database.MinConns),
+		MaxConnLifetime:   cfg.Database.MaxConnLifetime,
+		MaxConnIdleTime:   cfg.Database.MaxConnIdleTime,
+		HealthCheckPeriod: cfg.Database.HealthCheckPeriod,
+		ConnectTimeout:    cfg.Database.ConnectTimeout,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to the database: %v", err)
+	}
+	defer db.Pool.Close()
+	if cfg.Features.Metrics {
+		metrics.RegisterPool(db.Pool)
+	}
 
-	resultRepo := repository.NewResultRepository()
-	resultService := service.NewResultService(resultRepo)
-	resultHandler := handler.NewResultHandler(ctx, resultService)
+	// Background jobs flush to the database when ctx is cancelled, so they
+	// are waited for before the pool is closed.
+	var jobs sync.WaitGroup
+	runJob := func(job func()) {
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			job()
+		}()
+	}
 
+	if err := db.Migrate(ctx); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	unitOfWork := repository.NewUnitOfWork()
+
+	apiKeyService := service.NewAPIKeyService(repository.NewAPIKeyRepository())
+	if apiKeyArgs != nil {
+		if err := runAPIKeyCommand(ctx, apiKeyService, apiKeyArgs); err != nil {
+			log.Fatalf("apikey: %v", err)
+		}
+		return
+	}
+
+	constructorRepo := repository.NewConstructorRepository()
+	driverRepo := repository.NewDriverRepository()
+	circuitRepo := repository.NewCircuitRepository()
+	seasonRepo := repository.NewSeasonRepository()
+	raceRepo := repository.NewRaceRepository()
+	resultRepo := repository.NewResultRepository()
 	standingRepo := repository.NewStandingRepository()
-	standingService := service.NewStandingService(standingRepo)
-	standingHandler := handler.NewStandingHandler(ctx, standingService)
 
-	mux := http.NewServeMux()
+	constructorService := service.NewConstructorService(constructorRepo, unitOfWork)
+	constructorHandler := handler.NewConstructorHandler(constructorService, cfg.API.MaxPageSize)
+
+	driverService := service.NewDriverService(driverRepo, resultRepo, standingRepo, unitOfWork)
+	driverHandler := handler.NewDriverHandler(driverService, cfg.API.MaxPageSize)
+
+	circuitService := service.NewCircuitService(circuitRepo, unitOfWork)
+	circuitHandler := handler.NewCircuitHandler(circuitService, cfg.API.MaxPageSize)
+
+	seasonService := service.NewSeasonService(seasonRepo, unitOfWork)
+	seasonHandler := handler.NewSeasonHandler(seasonService)
+
+	raceService := service.NewRaceService(raceRepo, resultRepo, standingRepo, unitOfWork)
+	raceHandler := handler.NewRaceHandler(raceService)
+
+	resultService := service.NewResultService(resultRepo, unitOfWork)
+	resultHandler := handler.NewResultHandler(resultService)
+
+	standingService := service.NewStandingService(standingRepo, unitOfWork)
+	standingHandler := handler.NewStandingHandler(standingService)
+
+	auditRepo := repository.NewAuditRepository()
+	auditService := service.NewAuditService(auditRepo, unitOfWork)
+	auditHandler := handler.NewAuditHandler(auditService, cfg.API.MaxPageSize)
 
-	router.SetupRoutes(mux, constructorHandler, driverHandler, circuitHandler, seasonHandler, raceHandler, resultHandler, standingHandler)
+	usageRepo := repository.NewUsageRepository()
+	usageService := service.NewUsageService(usageRepo)
+	usageHandler := handler.NewUsageHandler(usageService)
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	var queryStatsHandler *handler.QueryStatsHandler
+	if queryStats != nil {
+		queryStatsService := service.NewQueryStatsService(queryStats, repository.NewQueryPlanRepository())
+		queryStatsHandler = handler.NewQueryStatsHandler(queryStatsService)
 	}
+	var metricsHandler http.Handler
+	if cfg.Features.Metrics {
+		metricsHandler = metrics.Handler()
+	}
+
+	healthService := service.NewHealthService(repository.NewHealthRepository())
+	healthHandler := handler.NewHealthHandler(healthService)
+
+	var graphqlHandler *handler.GraphQLHandler
+	if cfg.Features.GraphQL {
+		schema, err := graphqlapi.NewSchema(graphqlapi.Services{
+			Constructors: constructorService,
+			Drivers:      driverService,
+			Circuits:     circuitService,
+			Seasons:      seasonService,
+			Races:        raceService,
+			Results:      resultService,
+			Standings:    standingService,
+		}, graphqlapi.Limits{
+			MaxDepth:    cfg.GraphQL.MaxDepth,
+			MaxCost:     cfg.GraphQL.MaxCost,
+			MaxPageSize: cfg.API.MaxPageSize,
+		})
+		if err != nil {
+			log.Fatalf("Failed to build the GraphQL schema: %v", err)
+		}
+		graphqlHandler = handler.NewGraphQLHandler(schema)
+	}
+
+	var jwtVerifier *auth.JWTVerifier
+	jwtConfig := auth.JWTConfig{
+		HS256SecretFile:    cfg.Auth.JWT.HS256SecretFile,
+		RS256PublicKeyFile: cfg.Auth.JWT.RS256PublicKeyFile,
+		JWKSFile:           cfg.Auth.JWT.JWKSFile,
+		Issuer:             cfg.Auth.JWT.Issuer,
+		Audience:           cfg.Auth.JWT.Audience,
+		RolesClaim:         cfg.Auth.JWT.RolesClaim,
+		Tiers:              cfg.Auth.JWT.Tiers,
+	}
+	if jwtConfig.Enabled() {
+		v, err := auth.NewJWTVerifier(jwtConfig)
+		if err != nil {
+			log.Fatalf("Failed to load JWT keys: %v", err)
+		}
+		jwtVerifier = v
+	}
+
+	authenticator := auth.NewAuthenticator(apiKeyService, jwtVerifier, cfg.Auth.AllowAnonymousRead)
+	// Failed authentications are throttled per client IP whether or not rate
+	// limits are enforced, and across the HTTP and gRPC APIs alike.
+	authFailures := ratelimit.NewFailures(ratelimit.AuthFailureTier)
+	runJob(func() { authFailures.Run(ctx, time.Minute) })
+
+	render.StreamWriteTimeout = cfg.Server.WriteTimeout
+
+	corsConfig := cors.Default()
+	corsConfig.AllowedOrigins = cfg.CORS.AllowedOrigins
+	corsConfig.AllowedMethods = cfg.CORS.AllowedMethods
+	corsConfig.AllowedHeaders = cfg.CORS.AllowedHeaders
+	corsConfig.MaxAge = cfg.CORS.MaxAge
+
+	mux := http.NewServeMux()
+	middlewares := []router.Middleware{
+		middleware.RequestContext,
+		middleware.Tracing(mux),
+		middleware.AccessLog(mux),
+	}
+	if cfg.Features.Metrics {
+		middlewares = append(middlewares, middleware.Metrics(mux))
+	}
+	middlewares = append(middlewares,
+		middleware.Recover,
+		middleware.CORS(corsConfig),
+		middleware.Authenticate(authenticator, authFailures),
+	)
+	// The middleware from here on also runs for every operation of a batch.
+	var operationMiddlewares []router.Middleware
+	var usageRecorder *analytics.Recorder
+	if cfg.Features.UsageAnalytics {
+		usageRecorder = analytics.NewRecorder(usageRepo)
+		runJob(func() { usageRecorder.Run(ctx, time.Minute) })
+		operationMiddlewares = append(operationMiddlewares, middleware.Usage(usageRecorder, mux))
+	}
+	operationMiddlewares = append(operationMiddlewares, middleware.Timeout(mux, cfg.Queries.Timeout, cfg.Queries.StreamTimeout, cfg.Queries.RouteTimeouts))
+	var limiter *ratelimit.Limiter
+	if cfg.Features.RateLimit {
+		tiers, err := ratelimit.ParseTiers(cfg.RateLimit.Tiers)
+		if err != nil {
+			log.Fatalf("Invalid rate limit tiers: %v", err)
+		}
+		limiter = ratelimit.NewLimiter(tiers, repository.NewQuotaRepository())
+		runJob(func() { limiter.Run(ctx, time.Minute) })
+		operationMiddlewares = append(operationMiddlewares, middleware.RateLimit(limiter))
+	}
+	if cfg.Features.Idempotency {
+		idempotencyRepo := repository.NewIdempotencyRepository()
+		runJob(func() { middleware.PurgeIdempotencyKeys(ctx, idempotencyRepo) })
+		operationMiddlewares = append(operationMiddlewares, middleware.Idempotency(idempotencyRepo, cfg.API.IdempotencyTTL))
+	}
+	middlewares = append(middlewares, operationMiddlewares...)
+
+	batchHandler := handler.NewBatchHandler(mux, router.Chain(mux, operationMiddlewares...), unitOfWork)
 
-	log.Printf("Server is running on http://localhost:%s", port)
-	err := http.ListenAndServe(":"+port, mux)
+	routes := router.SetupRoutes(mux, constructorHandler, driverHandler, circuitHandler, seasonHandler, raceHandler, resultHandler, standingHandler, auditHandler, usageHandler, queryStatsHandler, metricsHandler, batchHandler, graphqlHandler)
+	spec, err := json.Marshal(openapi.Build(routes))
 	if err != nil {
+		log.Fatalf("Failed to build the OpenAPI document: %v", err)
+	}
+	docsHandler := handler.NewDocsHandler(spec)
+
+	h := router.Chain(mux, middlewares...)
+
+	port := strconv.Itoa(cfg.Server.Port)
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           router.Root(h, healthHandler, docsHandler),
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+	shutdownTimeout := cfg.Server.ShutdownTimeout
+	drainDelay := cfg.Server.DrainDelay
+
+	serveErr := make(chan error, 2)
+	go func() {
+		slog.Info("Server is running", "addr", "http://localhost:"+port)
+		serveErr <- server.ListenAndServe()
+	}()
+
+	// The gRPC API calls the same services on a port of its own, with the
+	// same rate limits, usage analytics and query timeouts.
+	var grpcServer *grpc.Server
+	if cfg.Features.GRPC {
+		grpcServer = grpcapi.NewServer(grpcapi.Services{
+			Constructors: constructorService,
+			Drivers:      driverService,
+			Circuits:     circuitService,
+			Seasons:      seasonService,
+			Races:        raceService,
+			Results:      resultService,
+			Standings:    standingService,
+			Audit:        auditService,
+			Health:       healthService,
+		}, authenticator, grpcapi.Options{
+			MaxPageSize:   cfg.API.MaxPageSize,
+			Failures:      authFailures,
+			Limiter:       limiter,
+			Usage:         usageRecorder,
+			Timeout:       cfg.Queries.Timeout,
+			StreamTimeout: cfg.Queries.StreamTimeout,
+		})
+		grpcPort := strconv.Itoa(cfg.Server.GRPCPort)
+		listener, err := net.Listen("tcp", ":"+grpcPort)
+		if err != nil {
+			log.Fatalf("Failed to start the gRPC server: %v", err)
+		}
+		go func() {
+			slog.Info("gRPC server is running", "addr", "localhost:"+grpcPort)
+			serveErr <- grpcServer.Serve(listener)
+		}()
+	}
+
+	stop, stopSignals := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+	select {
+	case err := <-serveErr:
 		log.Fatalf("Failed to start: %v", err)
+	case <-stop.Done():
+	}
+
+	// Report not-ready first so load balancers stop routing new requests
+	// here before the listener closes.
+	healthService.Drain()
+	if drainDelay > 0 {
+		slog.Info("Shutting down, waiting for load balancers to notice", "delay", drainDelay.String())
+		time.Sleep(drainDelay)
+	}
+
+	slog.Info("Shutting down, draining in-flight requests", "timeout", shutdownTimeout.String())
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelShutdown()
+	if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("Failed to drain in-flight requests", "error", err)
+	}
+	if grpcServer != nil {
+		stopGRPC(shutdownCtx, grpcServer)
+	}
+
+	cancel()
+	jobs.Wait()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("Failed to flush traces", "error", err)
+	}
+	slog.Info("Server stopped")
+}
+
+// stopGRPC lets in-flight calls finish and cancels those still running when
+// ctx is done.
+func stopGRPC(ctx context.Context, s *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		slog.Error("Failed to drain in-flight gRPC calls", "error", ctx.Err())
+		s.Stop()
 	}
 }
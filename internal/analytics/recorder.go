@@ -0,0 +1,122 @@
+package analytics
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+)
+
+type counterKey struct {
+	hour   time.Time
+	client string
+	route  string
+	status int
+}
+
+// Recorder counts requests in memory and periodically adds the counts to the
+// hourly usage table.
+type Recorder struct {
+	repo repository.UsageRepository
+
+	mu       sync.Mutex
+	counters map[counterKey]*model.UsageCounter
+}
+
+func NewRecorder(repo repository.UsageRepository) *Recorder {
+	return &Recorder{
+		repo:     repo,
+		counters: make(map[counterKey]*model.UsageCounter),
+	}
+}
+
+// Record counts one request.
+func (rec *Recorder) Record(client, route string, status int, duration time.Duration) {
+	key := counterKey{
+		hour:   time.Now().UTC().Truncate(time.Hour),
+		client: client,
+		route:  route,
+		status: status,
+	}
+	ms := float64(duration) / float64(time.Millisecond)
+	bucket := sort.SearchFloat64s(model.LatencyBucketBoundsMs, ms)
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	c := rec.counters[key]
+	if c == nil {
+		c = &model.UsageCounter{
+			Hour:           key.hour,
+			Client:         client,
+			Route:          route,
+			Status:         status,
+			LatencyBuckets: make([]int64, len(model.LatencyBucketBoundsMs)+1),
+		}
+		rec.counters[key] = c
+	}
+	c.Requests++
+	c.TotalDurationMs += ms
+	c.LatencyBuckets[bucket]++
+}
+
+// Run flushes the counts every interval until ctx is cancelled, then once
+// more.
+func (rec *Recorder) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			rec.Flush(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+			rec.Flush(ctx)
+		}
+	}
+}
+
+// Flush adds the counts collected since the last flush to the repository.
+// Counts that fail to store are kept for the next flush.
+func (rec *Recorder) Flush(ctx context.Context) {
+	rec.mu.Lock()
+	if len(rec.counters) == 0 {
+		rec.mu.Unlock()
+		return
+	}
+	pending := rec.counters
+	rec.counters = make(map[counterKey]*model.UsageCounter)
+	rec.mu.Unlock()
+
+	counters := make([]model.UsageCounter, 0, len(pending))
+	for _, c := range pending {
+		counters = append(counters, *c)
+	}
+	if err := rec.repo.AddUsage(ctx, counters); err != nil {
+		slog.ErrorContext(ctx, "Usage error: Failed to store usage", "error", err)
+		rec.restore(pending)
+	}
+}
+
+func (rec *Recorder) restore(pending map[counterKey]*model.UsageCounter) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	for key, old := range pending {
+		c := rec.counters[key]
+		if c == nil {
+			rec.counters[key] = old
+			continue
+		}
+		c.Requests += old.Requests
+		c.TotalDurationMs += old.TotalDurationMs
+		for i, n := range old.LatencyBuckets {
+			c.LatencyBuckets[i] += n
+		}
+	}
+}
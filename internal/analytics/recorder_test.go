@@ -0,0 +1,88 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+)
+
+// usageRepository fails the next fail calls to AddUsage and keeps the
+// counters of the others.
+type usageRepository struct {
+	repository.UsageRepository
+	fail     int
+	calls    int
+	counters []model.UsageCounter
+}
+
+func (r *usageRepository) AddUsage(_ context.Context, counters []model.UsageCounter) error {
+	r.calls++
+	if r.fail > 0 {
+		r.fail--
+		return errors.New("conn closed")
+	}
+	r.counters = append(r.counters, counters...)
+	return nil
+}
+
+func TestFlush(t *testing.T) {
+	const route = "GET /v1/drivers"
+
+	tests := []struct {
+		name string
+		// fail is the number of flushes that fail before the last one.
+		fail      int
+		wantCalls int
+	}{
+		{name: "stored", wantCalls: 1},
+		{name: "kept after a failure", fail: 1, wantCalls: 2},
+		{name: "kept after failures", fail: 2, wantCalls: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &usageRepository{fail: tt.fail}
+			rec := NewRecorder(repo)
+
+			rec.Record("api-key:ci", route, 200, 3*time.Millisecond)
+			for range tt.fail {
+				rec.Flush(context.Background())
+				// Requests counted after a failed flush add to the kept counts.
+				rec.Record("api-key:ci", route, 200, 30*time.Millisecond)
+			}
+			rec.Flush(context.Background())
+
+			if repo.calls != tt.wantCalls {
+				t.Errorf("AddUsage called %d times, want %d", repo.calls, tt.wantCalls)
+			}
+			var requests int64
+			var ms float64
+			buckets := make([]int64, len(model.LatencyBucketBoundsMs)+1)
+			for _, c := range repo.counters {
+				requests += c.Requests
+				ms += c.TotalDurationMs
+				for i, n := range c.LatencyBuckets {
+					buckets[i] += n
+				}
+			}
+			if want := int64(1 + tt.fail); requests != want {
+				t.Errorf("stored %d requests, want %d", requests, want)
+			}
+			if want := 3 + 30*float64(tt.fail); ms != want {
+				t.Errorf("stored %vms, want %vms", ms, want)
+			}
+			if buckets[0] != 1 || buckets[3] != int64(tt.fail) {
+				t.Errorf("stored latency buckets %v, want 1 in the first and %d in the fourth", buckets, tt.fail)
+			}
+
+			// Everything was stored, so there is nothing left to flush.
+			rec.Flush(context.Background())
+			if repo.calls != tt.wantCalls {
+				t.Errorf("flushing nothing called AddUsage")
+			}
+		})
+	}
+}
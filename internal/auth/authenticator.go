@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/service"
+)
+
+// AnonymousName names the principal of callers without credentials.
+const AnonymousName = "anonymous"
+
+// Authenticator identifies callers from the credentials they present, the
+// same way for every API the server exposes.
+type Authenticator struct {
+	keys      service.APIKeyService
+	jwt       *JWTVerifier
+	anonymous model.Principal
+}
+
+// NewAuthenticator checks API keys with keys and, when jwt is not nil,
+// signed JWTs with jwt. Callers without credentials hold the read scope when
+// anonymousRead is set and no scope otherwise.
+func NewAuthenticator(keys service.APIKeyService, jwt *JWTVerifier, anonymousRead bool) *Authenticator {
+	anonymous := model.Principal{Name: AnonymousName, Tier: model.TierAnonymous}
+	if anonymousRead {
+		anonymous.Scopes = []model.Scope{model.ScopeRead}
+	}
+	return &Authenticator{keys: keys, jwt: jwt, anonymous: anonymous}
+}
+
+// Anonymous returns the principal of callers without credentials.
+func (a *Authenticator) Anonymous() model.Principal {
+	return a.anonymous
+}
+
+// Authenticate returns the principal secret belongs to. bearer says whether
+// secret came as a bearer token, which may be a JWT as well as an API key.
+// Credentials that do not check out fail with ErrInvalidToken or
+// service.ErrInvalidAPIKey.
+func (a *Authenticator) Authenticate(ctx context.Context, secret string, bearer bool) (model.Principal, error) {
+	if bearer && a.jwt != nil && strings.Count(secret, ".") == 2 {
+		return a.jwt.Verify(secret)
+	}
+
+	key, err := a.keys.Authenticate(ctx, secret)
+	if err != nil {
+		return model.Principal{}, err
+	}
+	return model.Principal{Name: "api-key:" + key.Name, Scopes: key.Scopes, Tier: key.Tier}, nil
+}
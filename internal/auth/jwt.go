@@ -0,0 +1,321 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+)
+
+const clockSkew = time.Minute
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// JWTConfig says where the verification keys come from and which claims a
+// token must carry. Any combination of key sources may be set.
+type JWTConfig struct {
+	HS256SecretFile    string
+	RS256PublicKeyFile string
+	JWKSFile           string
+	Issuer             string
+	Audience           string
+	// RolesClaim names the claim holding the caller's roles, either as an
+	// array or as a space separated string. Defaults to "roles".
+	RolesClaim string
+	// Tiers lists the rate limit tiers a token may select with its tier
+	// claim. Tokens with another tier, or none, get the standard tier.
+	Tiers []string
+}
+
+// Enabled reports whether any verification key is configured.
+func (c JWTConfig) Enabled() bool {
+	return c.HS256SecretFile != "" || c.RS256PublicKeyFile != "" || c.JWKSFile != ""
+}
+
+// JWTVerifier checks HS256 and RS256 signed tokens and maps their roles to
+// scopes.
+type JWTVerifier struct {
+	secret     []byte
+	publicKey  *rsa.PublicKey
+	jwks       map[string]*rsa.PublicKey
+	issuer     string
+	audience   string
+	rolesClaim string
+	tiers      map[string]bool
+	now        func() time.Time
+}
+
+func NewJWTVerifier(cfg JWTConfig) (*JWTVerifier, error) {
+	v := &JWTVerifier{
+		jwks:       make(map[string]*rsa.PublicKey),
+		issuer:     cfg.Issuer,
+		audience:   cfg.Audience,
+		rolesClaim: cfg.RolesClaim,
+		tiers:      make(map[string]bool, len(cfg.Tiers)),
+		now:        time.Now,
+	}
+	if v.rolesClaim == "" {
+		v.rolesClaim = "roles"
+	}
+	for _, tier := range cfg.Tiers {
+		v.tiers[tier] = true
+	}
+
+	if cfg.HS256SecretFile != "" {
+		secret, err := os.ReadFile(cfg.HS256SecretFile)
+		if err != nil {
+			return nil, err
+		}
+		v.secret = []byte(strings.TrimSpace(string(secret)))
+		if len(v.secret) == 0 {
+			return nil, fmt.Errorf("%s is empty", cfg.HS256SecretFile)
+		}
+	}
+	if cfg.RS256PublicKeyFile != "" {
+		key, err := loadRSAPublicKey(cfg.RS256PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		v.publicKey = key
+	}
+	if cfg.JWKSFile != "" {
+		if err := v.loadJWKS(cfg.JWKSFile); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// Verify checks the token's signature and registered claims and returns the
+// principal it identifies.
+func (v *JWTVerifier) Verify(token string) (model.Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return model.Principal{}, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return model.Principal{}, fmt.Errorf("%w: header: %v", ErrInvalidToken, err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return model.Principal{}, fmt.Errorf("%w: signature: %v", ErrInvalidToken, err)
+	}
+	if err := v.verifySignature(header.Alg, header.Kid, parts[0]+"."+parts[1], signature); err != nil {
+		return model.Principal{}, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return model.Principal{}, fmt.Errorf("%w: claims: %v", ErrInvalidToken, err)
+	}
+	if err := v.checkClaims(claims, v.now()); err != nil {
+		return model.Principal{}, err
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return model.Principal{}, fmt.Errorf("%w: missing sub", ErrInvalidToken)
+	}
+	principal := model.Principal{Name: "jwt:" + subject, Tier: model.TierStandard}
+	if tier, _ := claims["tier"].(string); v.tiers[tier] {
+		principal.Tier = tier
+	}
+	for _, role := range claimStrings(claims[v.rolesClaim]) {
+		if scope, ok := model.Role(role).Scope(); ok {
+			principal.Scopes = append(principal.Scopes, scope)
+		}
+	}
+	return principal, nil
+}
+
+func (v *JWTVerifier) verifySignature(alg, kid, signed string, signature []byte) error {
+	switch alg {
+	case "HS256":
+		if v.secret == nil {
+			return fmt.Errorf("%w: HS256 is not accepted", ErrInvalidToken)
+		}
+		mac := hmac.New(sha256.New, v.secret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+	case "RS256":
+		key, err := v.rsaKey(kid)
+		if err != nil {
+			return err
+		}
+		digest := sha256.Sum256([]byte(signed))
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported alg %q", ErrInvalidToken, alg)
+	}
+}
+
+// rsaKey picks the key for an RS256 token: the JWKS key named by kid, or the
+// configured public key, or the only JWKS key when the token has no kid.
+func (v *JWTVerifier) rsaKey(kid string) (*rsa.PublicKey, error) {
+	if kid != "" {
+		if key, ok := v.jwks[kid]; ok {
+			return key, nil
+		}
+	}
+	if v.publicKey != nil {
+		return v.publicKey, nil
+	}
+	if kid == "" && len(v.jwks) == 1 {
+		for _, key := range v.jwks {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no RS256 key for kid %q", ErrInvalidToken, kid)
+}
+
+func (v *JWTVerifier) checkClaims(claims map[string]interface{}, now time.Time) error {
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("%w: missing exp", ErrInvalidToken)
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return fmt.Errorf("%w: not valid yet", ErrInvalidToken)
+	}
+	if v.issuer != "" && claims["iss"] != v.issuer {
+		return fmt.Errorf("%w: wrong issuer", ErrInvalidToken)
+	}
+	if v.audience != "" {
+		found := false
+		for _, aud := range claimStrings(claims["aud"]) {
+			if aud == v.audience {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: wrong audience", ErrInvalidToken)
+		}
+	}
+	return nil
+}
+
+func (v *JWTVerifier) loadJWKS(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return fmt.Errorf("%s: key %q: %w", path, k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return fmt.Errorf("%s: key %q: %w", path, k.Kid, err)
+		}
+		v.jwks[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	if len(v.jwks) == 0 {
+		return fmt.Errorf("%s: no RSA signing keys", path)
+	}
+	return nil
+}
+
+func loadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data", path)
+	}
+
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		if key, ok := cert.PublicKey.(*rsa.PublicKey); ok {
+			return key, nil
+		}
+	default:
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		if rsaKey, ok := key.(*rsa.PublicKey); ok {
+			return rsaKey, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: not an RSA public key", path)
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// claimStrings reads a claim that is either a string array or a single,
+// space separated string.
+func claimStrings(claim interface{}) []string {
+	switch c := claim.(type) {
+	case string:
+		return strings.Fields(c)
+	case []interface{}:
+		var s []string
+		for _, item := range c {
+			if str, ok := item.(string); ok {
+				s = append(s, str)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,362 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+)
+
+const testSecret = "s3cret-for-tests"
+
+var testNow = time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC)
+
+type jwtKeys struct {
+	primary *rsa.PrivateKey
+	other   *rsa.PrivateKey
+	dir     string
+}
+
+func newJWTKeys(t *testing.T) jwtKeys {
+	t.Helper()
+	keys := jwtKeys{dir: t.TempDir()}
+	for _, k := range []**rsa.PrivateKey{&keys.primary, &keys.other} {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*k = key
+	}
+	return keys
+}
+
+// write stores content in a file of the key directory and returns its path.
+func (k jwtKeys) write(t *testing.T, name string, content []byte) string {
+	t.Helper()
+	path := filepath.Join(k.dir, name)
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func (k jwtKeys) publicKeyPEM(t *testing.T) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&k.primary.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func (k jwtKeys) jwks(t *testing.T) []byte {
+	t.Helper()
+	jwk := func(kid string, key *rsa.PublicKey) map[string]string {
+		return map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}
+	}
+	b, err := json.Marshal(map[string]any{"keys": []any{
+		jwk("primary", &k.primary.PublicKey),
+		jwk("other", &k.other.PublicKey),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// sign returns a token with header and claims, signed with alg: "HS256"
+// with secret, "RS256" with key, or "none" with no signature.
+func sign(t *testing.T, header, claims map[string]any, secret []byte, key *rsa.PrivateKey) string {
+	t.Helper()
+	segment := func(v any) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	signed := segment(header) + "." + segment(claims)
+
+	var signature []byte
+	switch header["alg"] {
+	case "HS256":
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case "RS256":
+		digest := sha256.Sum256([]byte(signed))
+		var err error
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"sub":   "alice",
+		"iss":   "https://issuer.example.com",
+		"aud":   []string{"f1-api", "other-api"},
+		"exp":   testNow.Add(time.Hour).Unix(),
+		"nbf":   testNow.Add(-time.Hour).Unix(),
+		"roles": []string{"viewer"},
+	}
+}
+
+// with returns validClaims changed by set; a nil value removes the claim.
+func with(set map[string]any) map[string]any {
+	claims := validClaims()
+	for name, value := range set {
+		if value == nil {
+			delete(claims, name)
+			continue
+		}
+		claims[name] = value
+	}
+	return claims
+}
+
+func TestJWTVerify(t *testing.T) {
+	keys := newJWTKeys(t)
+	secretFile := keys.write(t, "secret", []byte(testSecret+"\n"))
+	publicKeyFile := keys.write(t, "public.pem", keys.publicKeyPEM(t))
+	jwksFile := keys.write(t, "jwks.json", keys.jwks(t))
+
+	claimsCfg := JWTConfig{Issuer: "https://issuer.example.com", Audience: "f1-api", Tiers: []string{"partner"}}
+	hs256 := claimsCfg
+	hs256.HS256SecretFile = secretFile
+	rs256 := claimsCfg
+	rs256.RS256PublicKeyFile = publicKeyFile
+	jwks := claimsCfg
+	jwks.JWKSFile = jwksFile
+
+	hsHeader := map[string]any{"alg": "HS256", "typ": "JWT"}
+	rsHeader := map[string]any{"alg": "RS256", "typ": "JWT"}
+	kidHeader := func(kid string) map[string]any {
+		return map[string]any{"alg": "RS256", "typ": "JWT", "kid": kid}
+	}
+	secret := []byte(testSecret)
+
+	tests := []struct {
+		name   string
+		cfg    JWTConfig
+		token  func() string
+		want   model.Principal
+		errMsg string
+	}{
+		{
+			name:  "HS256",
+			cfg:   hs256,
+			token: func() string { return sign(t, hsHeader, validClaims(), secret, nil) },
+			want:  model.Principal{Name: "jwt:alice", Scopes: []model.Scope{model.ScopeRead}, Tier: model.TierStandard},
+		},
+		{
+			name:  "RS256 with a public key",
+			cfg:   rs256,
+			token: func() string { return sign(t, rsHeader, validClaims(), nil, keys.primary) },
+			want:  model.Principal{Name: "jwt:alice", Scopes: []model.Scope{model.ScopeRead}, Tier: model.TierStandard},
+		},
+		{
+			name:  "RS256 with a JWKS key named by kid",
+			cfg:   jwks,
+			token: func() string { return sign(t, kidHeader("other"), validClaims(), nil, keys.other) },
+			want:  model.Principal{Name: "jwt:alice", Scopes: []model.Scope{model.ScopeRead}, Tier: model.TierStandard},
+		},
+		{
+			name: "roles map to scopes",
+			cfg:  hs256,
+			token: func() string {
+				return sign(t, hsHeader, with(map[string]any{"roles": "viewer editor data-admin unknown", "tier": "partner"}), secret, nil)
+			},
+			want: model.Principal{Name: "jwt:alice", Scopes: []model.Scope{model.ScopeRead, model.ScopeWrite, model.ScopeAdmin}, Tier: "partner"},
+		},
+		{
+			name: "unlisted tier",
+			cfg:  hs256,
+			token: func() string {
+				return sign(t, hsHeader, with(map[string]any{"roles": "viewer", "tier": "premium"}), secret, nil)
+			},
+			want: model.Principal{Name: "jwt:alice", Scopes: []model.Scope{model.ScopeRead}, Tier: model.TierStandard},
+		},
+		{
+			name: "tier without a list",
+			cfg:  JWTConfig{Issuer: claimsCfg.Issuer, Audience: claimsCfg.Audience, HS256SecretFile: secretFile},
+			token: func() string {
+				return sign(t, hsHeader, with(map[string]any{"roles": "viewer", "tier": "partner"}), secret, nil)
+			},
+			want: model.Principal{Name: "jwt:alice", Scopes: []model.Scope{model.ScopeRead}, Tier: model.TierStandard},
+		},
+		{
+			name:  "unknown roles grant no scope",
+			cfg:   hs256,
+			token: func() string { return sign(t, hsHeader, with(map[string]any{"roles": []string{"owner"}}), secret, nil) },
+			want:  model.Principal{Name: "jwt:alice", Tier: model.TierStandard},
+		},
+		{
+			name: "exp within the clock skew",
+			cfg:  hs256,
+			token: func() string {
+				return sign(t, hsHeader, with(map[string]any{"exp": testNow.Add(-30 * time.Second).Unix()}), secret, nil)
+			},
+			want: model.Principal{Name: "jwt:alice", Scopes: []model.Scope{model.ScopeRead}, Tier: model.TierStandard},
+		},
+		{
+			name: "nbf within the clock skew",
+			cfg:  hs256,
+			token: func() string {
+				return sign(t, hsHeader, with(map[string]any{"nbf": testNow.Add(30 * time.Second).Unix()}), secret, nil)
+			},
+			want: model.Principal{Name: "jwt:alice", Scopes: []model.Scope{model.ScopeRead}, Tier: model.TierStandard},
+		},
+		{
+			name: "alg none",
+			cfg:  hs256,
+			token: func() string {
+				return sign(t, map[string]any{"alg": "none", "typ": "JWT"}, validClaims(), nil, nil)
+			},
+			errMsg: `unsupported alg "none"`,
+		},
+		{
+			// An HS256 token keyed with the RSA public key must not pass as
+			// signed by the issuer.
+			name:   "HS256 against an RS256 only config",
+			cfg:    rs256,
+			token:  func() string { return sign(t, hsHeader, validClaims(), keys.publicKeyPEM(t), nil) },
+			errMsg: "HS256 is not accepted",
+		},
+		{
+			name:   "HS256 with the wrong secret",
+			cfg:    hs256,
+			token:  func() string { return sign(t, hsHeader, validClaims(), []byte("guess"), nil) },
+			errMsg: "bad signature",
+		},
+		{
+			name:   "RS256 signed by another key",
+			cfg:    rs256,
+			token:  func() string { return sign(t, rsHeader, validClaims(), nil, keys.other) },
+			errMsg: "bad signature",
+		},
+		{
+			name: "claims changed after signing",
+			cfg:  hs256,
+			token: func() string {
+				token := sign(t, hsHeader, validClaims(), secret, nil)
+				parts := strings.Split(token, ".")
+				forged := sign(t, hsHeader, with(map[string]any{"roles": []string{"data-admin"}}), secret, nil)
+				return parts[0] + "." + strings.Split(forged, ".")[1] + "." + parts[2]
+			},
+			errMsg: "bad signature",
+		},
+		{
+			name:   "unknown kid",
+			cfg:    jwks,
+			token:  func() string { return sign(t, kidHeader("rotated"), validClaims(), nil, keys.primary) },
+			errMsg: `no RS256 key for kid "rotated"`,
+		},
+		{
+			name:   "no kid with several JWKS keys",
+			cfg:    jwks,
+			token:  func() string { return sign(t, rsHeader, validClaims(), nil, keys.primary) },
+			errMsg: `no RS256 key for kid ""`,
+		},
+		{
+			name:   "missing exp",
+			cfg:    hs256,
+			token:  func() string { return sign(t, hsHeader, with(map[string]any{"exp": nil}), secret, nil) },
+			errMsg: "missing exp",
+		},
+		{
+			name: "expired beyond the clock skew",
+			cfg:  hs256,
+			token: func() string {
+				return sign(t, hsHeader, with(map[string]any{"exp": testNow.Add(-2 * time.Minute).Unix()}), secret, nil)
+			},
+			errMsg: "expired",
+		},
+		{
+			name: "nbf beyond the clock skew",
+			cfg:  hs256,
+			token: func() string {
+				return sign(t, hsHeader, with(map[string]any{"nbf": testNow.Add(2 * time.Minute).Unix()}), secret, nil)
+			},
+			errMsg: "not valid yet",
+		},
+		{
+			name: "wrong issuer",
+			cfg:  hs256,
+			token: func() string {
+				return sign(t, hsHeader, with(map[string]any{"iss": "https://evil.example.com"}), secret, nil)
+			},
+			errMsg: "wrong issuer",
+		},
+		{
+			name:   "missing issuer",
+			cfg:    hs256,
+			token:  func() string { return sign(t, hsHeader, with(map[string]any{"iss": nil}), secret, nil) },
+			errMsg: "wrong issuer",
+		},
+		{
+			name:   "wrong audience",
+			cfg:    hs256,
+			token:  func() string { return sign(t, hsHeader, with(map[string]any{"aud": "other-api"}), secret, nil) },
+			errMsg: "wrong audience",
+		},
+		{
+			name:   "missing sub",
+			cfg:    hs256,
+			token:  func() string { return sign(t, hsHeader, with(map[string]any{"sub": nil}), secret, nil) },
+			errMsg: "missing sub",
+		},
+		{
+			name:   "malformed",
+			cfg:    hs256,
+			token:  func() string { return "not-a-token" },
+			errMsg: "malformed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewJWTVerifier(tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v.now = func() time.Time { return testNow }
+
+			got, err := v.Verify(tt.token())
+			if tt.errMsg != "" {
+				if !errors.Is(err, ErrInvalidToken) || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("Verify() error = %v, want ErrInvalidToken with %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if got.Name != tt.want.Name || got.Tier != tt.want.Tier || !slices.Equal(got.Scopes, tt.want.Scopes) {
+				t.Errorf("Verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+// Package config loads the typed configuration of the server. Values come
+// from, in increasing precedence, the defaults below, a YAML or TOML file,
+// environment variables and command line flags.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/cors"
+	"github.com/ChinmayNoob/f1/internal/logging"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+)
+
+// Every leaf field has a yaml tag naming it in files and in flags (joined
+// with dots, e.g. -server.port), an env tag naming its environment variable
+// and a usage tag. Fields tagged secret are redacted by Redacted.
+type Config struct {
+	Server    Server    `yaml:"server"`
+	Database  Database  `yaml:"database"`
+	Queries   Queries   `yaml:"queries"`
+	Log       Log       `yaml:"log"`
+	Auth      Auth      `yaml:"auth"`
+	RateLimit RateLimit `yaml:"rate_limit"`
+	API       API       `yaml:"api"`
+	GraphQL   GraphQL   `yaml:"graphql"`
+	CORS      CORS      `yaml:"cors"`
+	Tracing   Tracing   `yaml:"tracing"`
+	Features  Features  `yaml:"features"`
+}
+
+type Server struct {
+	Port              int           `yaml:"port" env:"PORT" usage:"HTTP port"`
+	GRPCPort          int           `yaml:"grpc_port" env:"GRPC_PORT" usage:"gRPC port"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"HTTP_READ_HEADER_TIMEOUT" usage:"time allowed to read request headers"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"HTTP_READ_TIMEOUT" usage:"time allowed to read a whole request"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"HTTP_WRITE_TIMEOUT" usage:"time allowed to write a response"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"HTTP_IDLE_TIMEOUT" usage:"how long idle keep-alive connections stay open"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" usage:"how long shutdown waits for in-flight requests"`
+	DrainDelay        time.Duration `yaml:"drain_delay" env:"READINESS_DRAIN_DELAY" usage:"how long the server reports not-ready before it stops listening"`
+}
+
+type Database struct {
+	URL               string        `yaml:"url" env:"DATABASE_URL" secret:"true" usage:"connection URL, used instead of host, port, user, password and name"`
+	Host              string        `yaml:"host" env:"POSTGRES_HOST" usage:"database host"`
+	Port              int           `yaml:"port" env:"POSTGRES_PORT" usage:"database port"`
+	User              string        `yaml:"user" env:"POSTGRES_USER" usage:"database user"`
+	Password          string        `yaml:"password" env:"POSTGRES_PASSWORD" secret:"true" usage:"database password"`
+	Name              string        `yaml:"name" env:"POSTGRES_DB" usage:"database name"`
+	SSLMode           string        `yaml:"sslmode" env:"POSTGRES_SSLMODE" usage:"disable, allow, prefer, require, verify-ca or verify-full"`
+	MaxConns          int           `yaml:"max_conns" env:"DB_MAX_CONNS" usage:"maximum pool size"`
+	MinConns          int           `yaml:"min_conns" env:"DB_MIN_CONNS" usage:"connections kept open when idle"`
+	MaxConnLifetime   time.Duration `yaml:"max_conn_lifetime" env:"DB_MAX_CONN_LIFETIME" usage:"age at which connections are replaced"`
+	MaxConnIdleTime   time.Duration `yaml:"max_conn_idle_time" env:"DB_MAX_CONN_IDLE_TIME" usage:"idle time after which connections are closed"`
+	HealthCheckPeriod time.Duration `yaml:"health_check_period" env:"DB_HEALTH_CHECK_PERIOD" usage:"how often idle connections are checked"`
+	ConnectTimeout    time.Duration `yaml:"connect_timeout" env:"DB_CONNECT_TIMEOUT" usage:"time allowed to open a connection"`
+}
+
+type Queries struct {
+	Timeout       time.Duration            `yaml:"timeout" env:"QUERY_TIMEOUT" usage:"deadline of each request's queries"`
+	RouteTimeouts map[string]time.Duration `yaml:"route_timeouts" env:"QUERY_TIMEOUT_ROUTES" usage:"per-route deadlines as \"METHOD pattern=duration,...\""`
+	StreamTimeout time.Duration            `yaml:"stream_timeout" env:"QUERY_STREAM_TIMEOUT" usage:"deadline of streamed lists, 0 for none"`
+	SlowThreshold time.Duration            `yaml:"slow_threshold" env:"SLOW_QUERY_THRESHOLD" usage:"queries at least this slow are logged, 0 turns the log off"`
+	LogSlowArgs   bool                     `yaml:"log_slow_args" env:"SLOW_QUERY_LOG_ARGS" usage:"log the arguments of slow queries, which may hold personal data"`
+}
+
+type Log struct {
+	Level string `yaml:"level" env:"LOG_LEVEL" usage:"debug, info, warn or error"`
+}
+
+type Auth struct {
+	AllowAnonymousRead bool `yaml:"allow_anonymous_read" env:"ALLOW_ANONYMOUS_READ" usage:"let unauthenticated callers read"`
+	JWT                JWT  `yaml:"jwt"`
+}
+
+type JWT struct {
+	HS256SecretFile    string   `yaml:"hs256_secret_file" env:"JWT_HS256_SECRET_FILE" usage:"file holding the HS256 shared secret"`
+	RS256PublicKeyFile string   `yaml:"rs256_public_key_file" env:"JWT_RS256_PUBLIC_KEY_FILE" usage:"PEM file holding the RS256 public key"`
+	JWKSFile           string   `yaml:"jwks_file" env:"JWT_JWKS_FILE" usage:"JWKS file holding RS256 public keys"`
+	Issuer             string   `yaml:"issuer" env:"JWT_ISSUER" usage:"required iss claim"`
+	Audience           string   `yaml:"audience" env:"JWT_AUDIENCE" usage:"required aud claim"`
+	RolesClaim         string   `yaml:"roles_claim" env:"JWT_ROLES_CLAIM" usage:"claim holding the caller's roles"`
+	Tiers              []string `yaml:"tiers" env:"JWT_TIERS" usage:"rate limit tiers a token's tier claim may select"`
+}
+
+type RateLimit struct {
+	Tiers string `yaml:"tiers" env:"RATE_LIMIT_TIERS" usage:"tier overrides as \"name=rate:burst:quota,...\""`
+}
+
+type API struct {
+	MaxPageSize    int           `yaml:"max_page_size" env:"MAX_PAGE_SIZE" usage:"largest page a list endpoint returns"`
+	IdempotencyTTL time.Duration `yaml:"idempotency_ttl" env:"IDEMPOTENCY_TTL" usage:"how long idempotent responses are kept"`
+}
+
+type GraphQL struct {
+	MaxDepth int `yaml:"max_depth" env:"GRAPHQL_MAX_DEPTH" usage:"deepest nesting of fields a GraphQL query may select"`
+	MaxCost  int `yaml:"max_cost" env:"GRAPHQL_MAX_COST" usage:"most objects a GraphQL query may resolve, estimated from its page sizes"`
+}
+
+type CORS struct {
+	AllowedOrigins []string      `yaml:"allowed_origins" env:"CORS_ALLOWED_ORIGINS" usage:"origins allowed to call the API, * for any; empty turns CORS off"`
+	AllowedMethods []string      `yaml:"allowed_methods" env:"CORS_ALLOWED_METHODS" usage:"methods allowed in preflight answers"`
+	AllowedHeaders []string      `yaml:"allowed_headers" env:"CORS_ALLOWED_HEADERS" usage:"headers allowed in preflight answers"`
+	MaxAge         time.Duration `yaml:"max_age" env:"CORS_MAX_AGE" usage:"how long browsers cache preflight answers"`
+}
+
+type Tracing struct {
+	Exporter string `yaml:"exporter" env:"TRACING_EXPORTER" usage:"otlp, stdout or none"`
+}
+
+// Features turn optional subsystems on and off.
+type Features struct {
+	Metrics        bool `yaml:"metrics" env:"FEATURE_METRICS" usage:"serve Prometheus metrics on /metrics"`
+	QueryStats     bool `yaml:"query_stats" env:"FEATURE_QUERY_STATS" usage:"keep per-statement query stats and serve /admin/queries"`
+	UsageAnalytics bool `yaml:"usage_analytics" env:"FEATURE_USAGE_ANALYTICS" usage:"record usage per caller and route"`
+	RateLimit      bool `yaml:"rate_limit" env:"FEATURE_RATE_LIMIT" usage:"enforce rate limits and daily quotas"`
+	Idempotency    bool `yaml:"idempotency" env:"FEATURE_IDEMPOTENCY" usage:"honour Idempotency-Key headers"`
+	GRPC           bool `yaml:"grpc" env:"FEATURE_GRPC" usage:"serve the gRPC API on server.grpc_port"`
+	GraphQL        bool `yaml:"graphql" env:"FEATURE_GRAPHQL" usage:"serve the GraphQL API on /v1/graphql"`
+}
+
+// bulkResources are the resources with a bulk upsert endpoint, which get a
+// longer query timeout by default.
+var bulkResources = []string{"constructors", "drivers", "circuits", "seasons", "races", "results", "driver-standings", "constructor-standings"}
+
+// Default returns the configuration used when nothing is overridden.
+func Default() Config {
+	// Routes are named by their pattern, so the versioned routes and their
+	// deprecated unversioned aliases are both listed.
+	routeTimeouts := make(map[string]time.Duration)
+	for _, prefix := range []string{"/v1", ""} {
+		routeTimeouts["POST "+prefix+"/batch"] = time.Minute
+		routeTimeouts["GET "+prefix+"/admin/usage"] = 30 * time.Second
+		for _, resource := range bulkResources {
+			routeTimeouts["POST "+prefix+"/"+resource+"/bulk"] = time.Minute
+		}
+	}
+	corsDefaults := cors.Default()
+
+	return Config{
+		Server: Server{
+			Port:              8080,
+			GRPCPort:          9090,
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			WriteTimeout:      90 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			ShutdownTimeout:   30 * time.Second,
+		},
+		Database: Database{
+			Port:              5432,
+			MaxConns:          10,
+			MaxConnLifetime:   time.Hour,
+			MaxConnIdleTime:   30 * time.Minute,
+			HealthCheckPeriod: time.Minute,
+			ConnectTimeout:    5 * time.Second,
+		},
+		Queries: Queries{
+			Timeout:       10 * time.Second,
+			RouteTimeouts: routeTimeouts,
+			StreamTimeout: 10 * time.Minute,
+			SlowThreshold: 200 * time.Millisecond,
+		},
+		Log: Log{Level: "info"},
+		API: API{
+			MaxPageSize:    100,
+			IdempotencyTTL: 24 * time.Hour,
+		},
+		GraphQL: GraphQL{
+			MaxDepth: 12,
+			MaxCost:  10000,
+		},
+		CORS: CORS{
+			AllowedMethods: corsDefaults.AllowedMethods,
+			AllowedHeaders: corsDefaults.AllowedHeaders,
+			MaxAge:         corsDefaults.MaxAge,
+		},
+		Tracing: Tracing{Exporter: tracing.ExporterNone},
+		Features: Features{
+			Metrics:        true,
+			QueryStats:     true,
+			UsageAnalytics: true,
+			RateLimit:      true,
+			Idempotency:    true,
+			GRPC:           true,
+			GraphQL:        true,
+		},
+	}
+}
+
+var sslModes = map[string]bool{"": true, "disable": true, "allow": true, "prefer": true, "require": true, "verify-ca": true, "verify-full": true}
+
+// Validate reports every invalid setting at once.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Server.Port > 0 && c.Server.Port < 65536, "server.port: %d is not a valid port", c.Server.Port)
+	if c.Features.GRPC {
+		check(c.Server.GRPCPort > 0 && c.Server.GRPCPort < 65536, "server.grpc_port: %d is not a valid port", c.Server.GRPCPort)
+		check(c.Server.GRPCPort != c.Server.Port, "server.grpc_port: must differ from server.port")
+	}
+	for name, d := range map[string]time.Duration{
+		"server.read_header_timeout":  c.Server.ReadHeaderTimeout,
+		"server.read_timeout":         c.Server.ReadTimeout,
+		"server.write_timeout":        c.Server.WriteTimeout,
+		"server.idle_timeout":         c.Server.IdleTimeout,
+		"server.shutdown_timeout":     c.Server.ShutdownTimeout,
+		"server.drain_delay":          c.Server.DrainDelay,
+		"database.max_conn_lifetime":  c.Database.MaxConnLifetime,
+		"database.max_conn_idle_time": c.Database.MaxConnIdleTime,
+		"database.connect_timeout":    c.Database.ConnectTimeout,
+		"queries.timeout":             c.Queries.Timeout,
+		"queries.stream_timeout":      c.Queries.StreamTimeout,
+		"queries.slow_threshold":      c.Queries.SlowThreshold,
+		"cors.max_age":                c.CORS.MaxAge,
+	} {
+		check(d >= 0, "%s: must not be negative", name)
+	}
+	check(c.Database.HealthCheckPeriod > 0, "database.health_check_period: must be positive")
+
+	if c.Database.URL != "" {
+		u, err := url.Parse(c.Database.URL)
+		check(err == nil && (u.Scheme == "postgres" || u.Scheme == "postgresql"), "database.url: must be a postgres:// URL")
+	} else {
+		check(c.Database.Host != "", "database.host: required unless database.url is set")
+		check(c.Database.User != "", "database.user: required unless database.url is set")
+		check(c.Database.Name != "", "database.name: required unless database.url is set")
+		check(c.Database.Port > 0 && c.Database.Port < 65536, "database.port: %d is not a valid port", c.Database.Port)
+	}
+	check(sslModes[c.Database.SSLMode], "database.sslmode: unknown mode %q", c.Database.SSLMode)
+	check(c.Database.MaxConns > 0, "database.max_conns: must be positive")
+	check(c.Database.MinConns >= 0 && c.Database.MinConns <= c.Database.MaxConns, "database.min_conns: must be between 0 and database.max_conns")
+
+	longest := c.Queries.Timeout
+	for route, d := range c.Queries.RouteTimeouts {
+		check(d >= 0, "queries.route_timeouts: %q must not be negative", route)
+		longest = max(longest, d)
+	}
+	check(c.Server.WriteTimeout == 0 || c.Server.WriteTimeout > longest,
+		"server.write_timeout: %s must be longer than the longest query timeout, %s", c.Server.WriteTimeout, longest)
+
+	if _, err := logging.ParseLevel(c.Log.Level); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
+	}
+	for name, path := range map[string]string{
+		"auth.jwt.hs256_secret_file":     c.Auth.JWT.HS256SecretFile,
+		"auth.jwt.rs256_public_key_file": c.Auth.JWT.RS256PublicKeyFile,
+		"auth.jwt.jwks_file":             c.Auth.JWT.JWKSFile,
+	} {
+		if path != "" {
+			_, err := os.Stat(path)
+			check(err == nil, "%s: %v", name, err)
+		}
+	}
+	if tiers, err := ratelimit.ParseTiers(c.RateLimit.Tiers); err != nil {
+		errs = append(errs, fmt.Errorf("rate_limit.tiers: %w", err))
+	} else {
+		for _, tier := range c.Auth.JWT.Tiers {
+			_, ok := tiers[tier]
+			check(ok, "auth.jwt.tiers: unknown tier %q", tier)
+		}
+	}
+	check(c.API.MaxPageSize > 0, "api.max_page_size: must be positive")
+	check(c.API.IdempotencyTTL > 0, "api.idempotency_ttl: must be positive")
+	if c.Features.GraphQL {
+		check(c.GraphQL.MaxDepth > 0, "graphql.max_depth: must be positive")
+		check(c.GraphQL.MaxCost > 0, "graphql.max_cost: must be positive")
+	}
+
+	switch c.Tracing.Exporter {
+	case "", tracing.ExporterNone, tracing.ExporterStdout, tracing.ExporterOTLP:
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter: unknown exporter %q", c.Tracing.Exporter))
+	}
+	return errors.Join(errs...)
+}
+
+// ConnString returns the URL to connect to the database with, built from
+// the individual settings unless database.url is set. database.sslmode
+// overrides the sslmode of the URL.
+func (d Database) ConnString() string {
+	u, err := url.Parse(d.URL)
+	if d.URL == "" || err != nil {
+		u = &url.URL{
+			Scheme: "postgresql",
+			User:   url.UserPassword(d.User, d.Password),
+			Host:   d.Host + ":" + strconv.Itoa(d.Port),
+			Path:   "/" + d.Name,
+		}
+	}
+	if d.SSLMode != "" {
+		query := u.Query()
+		query.Set("sslmode", d.SSLMode)
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	valid := Default()
+	valid.Database.URL = "postgres://f1@db/f1"
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate() of a valid config = %v", err)
+	}
+
+	cfg := valid
+	cfg.Server.Port = 0
+	cfg.Server.GRPCPort = 0
+	cfg.Server.IdleTimeout = -time.Second
+	cfg.Database.URL = ""
+	cfg.Database.MaxConns = 0
+	cfg.Log.Level = "loud"
+	cfg.RateLimit.Tiers = "standard=fast"
+	cfg.Tracing.Exporter = "zipkin"
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() = nil, want errors")
+	}
+	// Every problem is reported, not only the first one found.
+	for _, want := range []string{
+		"server.port:",
+		"server.grpc_port:",
+		"server.idle_timeout: must not be negative",
+		"database.host: required",
+		"database.user: required",
+		"database.name: required",
+		"database.max_conns: must be positive",
+		"log.level:",
+		"rate_limit.tiers:",
+		"tracing.exporter:",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error does not report %q:\n%v", want, err)
+		}
+	}
+}
+
+func TestValidateWriteTimeout(t *testing.T) {
+	cfg := Default()
+	cfg.Database.URL = "postgres://f1@db/f1"
+	cfg.Server.WriteTimeout = 30 * time.Second
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "server.write_timeout") {
+		t.Errorf("Validate() = %v, want the write timeout shorter than the bulk route timeouts reported", err)
+	}
+}
+
+func TestValidateJWTTiers(t *testing.T) {
+	cfg := Default()
+	cfg.Database.URL = "postgres://f1@db/f1"
+	cfg.RateLimit.Tiers = "partner=100:200:0"
+	cfg.Auth.JWT.Tiers = []string{"partner", "premium"}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() = %v, want configured tiers accepted", err)
+	}
+
+	cfg.Auth.JWT.Tiers = []string{"partner", "gold"}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), `auth.jwt.tiers: unknown tier "gold"`) {
+		t.Errorf("Validate() = %v, want the unknown tier reported", err)
+	}
+}
@@ -0,0 +1,237 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// FileEnv names the environment variable that points at the config file when
+// the -config flag is not given.
+const FileEnv = "CONFIG_FILE"
+
+// Load builds the configuration from the defaults, the config file named by
+// -config or CONFIG_FILE, the environment (including a .env file) and the
+// flags in args, in that order, and validates it.
+func Load(args []string) (Config, error) {
+	cfg := Default()
+	fields := leaves(reflect.ValueOf(&cfg).Elem(), "")
+
+	// Flags are parsed first to find the config file, but applied last.
+	type flagValue struct{ name, value string }
+	var flagValues []flagValue
+	fs := flag.NewFlagSet("f1", flag.ContinueOnError)
+	file := fs.String("config", os.Getenv(FileEnv), "YAML or TOML config file")
+	for _, f := range fields {
+		fs.Func(f.path, f.usage, func(v string) error {
+			flagValues = append(flagValues, flagValue{f.path, v})
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+	if fs.NArg() > 0 {
+		return Config{}, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	if *file != "" {
+		if err := loadFile(*file, fields); err != nil {
+			return Config{}, err
+		}
+	}
+
+	godotenv.Load()
+	for _, f := range fields {
+		if v, ok := os.LookupEnv(f.env); ok && f.env != "" {
+			if err := f.set(v); err != nil {
+				return Config{}, fmt.Errorf("%s: %w", f.env, err)
+			}
+		}
+	}
+
+	byPath := make(map[string]field, len(fields))
+	for _, f := range fields {
+		byPath[f.path] = f
+	}
+	for _, fv := range flagValues {
+		if err := byPath[fv.name].set(fv.value); err != nil {
+			return Config{}, fmt.Errorf("-%s: %w", fv.name, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// field is one setting of the configuration.
+type field struct {
+	path   string
+	env    string
+	usage  string
+	secret bool
+	value  reflect.Value
+}
+
+// leaves lists the settings of the struct v, naming each by the dotted path
+// of its yaml tags.
+func leaves(v reflect.Value, prefix string) []field {
+	var fields []field
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		path := prefix + sf.Tag.Get("yaml")
+		if sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, leaves(v.Field(i), path+".")...)
+			continue
+		}
+		fields = append(fields, field{
+			path:   path,
+			env:    sf.Tag.Get("env"),
+			usage:  sf.Tag.Get("usage"),
+			secret: sf.Tag.Get("secret") == "true",
+			value:  v.Field(i),
+		})
+	}
+	return fields
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// set parses s into the field. Lists are comma separated and replace the
+// current list; maps are comma separated key=value pairs.
+func (f field) set(s string) error {
+	switch {
+	case f.value.Type() == durationType:
+		d, err := time.ParseDuration(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		f.value.SetInt(int64(d))
+	case f.value.Kind() == reflect.String:
+		f.value.SetString(s)
+	case f.value.Kind() == reflect.Int:
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		f.value.SetInt(int64(n))
+	case f.value.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		f.value.SetBool(b)
+	case f.value.Kind() == reflect.Slice:
+		f.value.Set(reflect.ValueOf(splitList(s)))
+	case f.value.Kind() == reflect.Map:
+		// Entries are added to the ones already set, so a single route
+		// can be overridden without repeating the defaults.
+		m := make(map[string]time.Duration)
+		for key, d := range f.value.Interface().(map[string]time.Duration) {
+			m[key] = d
+		}
+		for _, item := range splitList(s) {
+			key, value, ok := strings.Cut(item, "=")
+			if !ok {
+				return fmt.Errorf("%q is not key=duration", item)
+			}
+			d, err := time.ParseDuration(strings.TrimSpace(value))
+			if err != nil {
+				return fmt.Errorf("%q: %w", key, err)
+			}
+			m[strings.TrimSpace(key)] = d
+		}
+		f.value.Set(reflect.ValueOf(m))
+	default:
+		return fmt.Errorf("unsupported setting type %s", f.value.Type())
+	}
+	return nil
+}
+
+// setFromFile sets the field from a decoded YAML or TOML value.
+func (f field) setFromFile(v any) error {
+	switch v := v.(type) {
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+		return f.set(strings.Join(items, ","))
+	case map[string]any:
+		items := make([]string, 0, len(v))
+		for key, value := range v {
+			items = append(items, fmt.Sprintf("%s=%v", key, value))
+		}
+		return f.set(strings.Join(items, ","))
+	default:
+		return f.set(fmt.Sprint(v))
+	}
+}
+
+// loadFile applies a YAML (.yaml, .yml) or TOML (.toml) file. Unknown keys
+// are rejected so that typos do not go unnoticed.
+func loadFile(path string, fields []field) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var doc map[string]any
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &doc)
+	case ".toml":
+		err = toml.Unmarshal(data, &doc)
+	default:
+		return fmt.Errorf("%s: unknown config format %q, expected .yaml, .yml or .toml", path, ext)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	byPath := make(map[string]field, len(fields))
+	for _, f := range fields {
+		byPath[f.path] = f
+	}
+	return applyDocument(path, doc, "", byPath)
+}
+
+func applyDocument(file string, doc map[string]any, prefix string, fields map[string]field) error {
+	for key, value := range doc {
+		path := prefix + key
+		if f, ok := fields[path]; ok {
+			if err := f.setFromFile(value); err != nil {
+				return fmt.Errorf("%s: %s: %w", file, path, err)
+			}
+			continue
+		}
+		section, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: unknown setting %s", file, path)
+		}
+		if err := applyDocument(file, section, path+".", fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitList splits a comma separated list, dropping empty entries.
+func splitList(s string) []string {
+	items := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
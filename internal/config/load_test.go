@@ -0,0 +1,87 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadPrecedence(t *testing.T) {
+	files := map[string]string{
+		"config.yaml": `
+server:
+  port: 1000
+  write_timeout: 2m
+database:
+  url: postgres://f1@db/f1
+  max_conns: 20
+log:
+  level: debug
+queries:
+  route_timeouts:
+    GET /v1/drivers: 30s
+`,
+		"config.toml": `
+[server]
+port = 1000
+write_timeout = "2m"
+
+[database]
+url = "postgres://f1@db/f1"
+max_conns = 20
+
+[log]
+level = "debug"
+
+[queries.route_timeouts]
+"GET /v1/drivers" = "30s"
+`,
+	}
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			t.Setenv("PORT", "2000")
+			t.Setenv("LOG_LEVEL", "warn")
+
+			cfg, err := Load([]string{"-config", path, "-server.port", "3000"})
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			// A flag overrides the environment, which overrides the file,
+			// which overrides the defaults.
+			if cfg.Server.Port != 3000 {
+				t.Errorf("server.port = %d, want 3000 from the flag", cfg.Server.Port)
+			}
+			if cfg.Log.Level != "warn" {
+				t.Errorf("log.level = %q, want warn from the environment", cfg.Log.Level)
+			}
+			if cfg.Database.MaxConns != 20 || cfg.Server.WriteTimeout != 2*time.Minute {
+				t.Errorf("database.max_conns = %d, server.write_timeout = %s, want 20 and 2m from the file", cfg.Database.MaxConns, cfg.Server.WriteTimeout)
+			}
+			if cfg.Server.GRPCPort != 9090 || cfg.Queries.Timeout != 10*time.Second {
+				t.Errorf("server.grpc_port = %d, queries.timeout = %s, want the defaults", cfg.Server.GRPCPort, cfg.Queries.Timeout)
+			}
+			// Route timeouts from the file are added to the default ones.
+			if d := cfg.Queries.RouteTimeouts["GET /v1/drivers"]; d != 30*time.Second {
+				t.Errorf("route timeout of GET /v1/drivers = %s, want 30s", d)
+			}
+			if d := cfg.Queries.RouteTimeouts["POST /v1/batch"]; d != time.Minute {
+				t.Errorf("route timeout of POST /v1/batch = %s, want the default 1m", d)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsUnknownSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("server:\n  prot: 8080\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load([]string{"-config", path}); err == nil {
+		t.Error("Load() accepted the unknown setting server.prot")
+	}
+}
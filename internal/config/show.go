@@ -0,0 +1,71 @@
+package config
+
+import (
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "REDACTED"
+
+// secretParams are the parts of query parameter names, matched without
+// regard to case, that mark a parameter of a URL as a secret.
+var secretParams = []string{"pass", "secret", "token", "key"}
+
+// Redacted returns a copy of the configuration with secrets replaced, safe
+// to print or log. Secret settings holding a URL keep everything but the
+// password of their userinfo and their secret-looking query parameters.
+func (c Config) Redacted() Config {
+	out := c
+	for _, f := range leaves(reflect.ValueOf(&out).Elem(), "") {
+		if !f.secret || f.value.String() == "" {
+			continue
+		}
+		if u, err := url.Parse(f.value.String()); err == nil && u.Scheme != "" && u.Host != "" {
+			f.value.SetString(redactURL(u))
+			continue
+		}
+		f.value.SetString(redacted)
+	}
+	return out
+}
+
+func redactURL(u *url.URL) string {
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	query := u.Query()
+	changed := false
+	for name := range query {
+		if secretParam(name) {
+			query.Set(name, redacted)
+			changed = true
+		}
+	}
+	if changed {
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
+
+func secretParam(name string) bool {
+	name = strings.ToLower(name)
+	for _, part := range secretParams {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// Write prints the configuration as YAML with secrets redacted, in the
+// format Load reads.
+func (c Config) Write(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	defer enc.Close()
+	return enc.Encode(c.Redacted())
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRedacted(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		password string
+		wantURL  string
+		wantPass string
+	}{
+		{
+			name:    "userinfo password",
+			url:     "postgres://f1:pw@db/f1",
+			wantURL: "postgres://f1:REDACTED@db/f1",
+		},
+		{
+			name:    "password parameter",
+			url:     "postgres://f1@db/f1?password=hunter2&sslmode=require",
+			wantURL: "postgres://f1@db/f1?password=REDACTED&sslmode=require",
+		},
+		{
+			name:    "secret-looking parameters",
+			url:     "postgres://f1@db/f1?sslpassword=a&auth_token=b&apiKey=c&application_name=api",
+			wantURL: "postgres://f1@db/f1?apiKey=REDACTED&application_name=api&auth_token=REDACTED&sslpassword=REDACTED",
+		},
+		{
+			name:    "nothing secret",
+			url:     "postgres://f1@db/f1?sslmode=disable",
+			wantURL: "postgres://f1@db/f1?sslmode=disable",
+		},
+		{
+			name:    "not a URL",
+			url:     "host=db user=f1 password=pw",
+			wantURL: "REDACTED",
+		},
+		{
+			name:     "password setting",
+			password: "pw",
+			wantPass: "REDACTED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.Database.URL = tt.url
+			cfg.Database.Password = tt.password
+
+			got := cfg.Redacted()
+			if got.Database.URL != tt.wantURL {
+				t.Errorf("database.url = %q, want %q", got.Database.URL, tt.wantURL)
+			}
+			if got.Database.Password != tt.wantPass {
+				t.Errorf("database.password = %q, want %q", got.Database.Password, tt.wantPass)
+			}
+			if cfg.Database.URL != tt.url {
+				t.Errorf("Redacted() changed the original to %q", cfg.Database.URL)
+			}
+		})
+	}
+}
+
+func TestWriteRedacts(t *testing.T) {
+	cfg := Default()
+	cfg.Database.URL = "postgres://f1:pw@db/f1?password=hunter2"
+	var buf bytes.Buffer
+	if err := cfg.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if out := buf.String(); strings.Contains(out, "hunter2") || strings.Contains(out, ":pw@") {
+		t.Errorf("Write() printed a secret:\n%s", out)
+	}
+}
@@ -0,0 +1,35 @@
+// Package cors holds the settings of cross-origin requests, apart from the
+// middleware that applies them, so that the configuration can default them
+// without depending on HTTP handling.
+package cors
+
+import (
+	"net/http"
+	"time"
+)
+
+// Config lists what cross-origin browsers are allowed to do. CORS is
+// disabled when AllowedOrigins is empty; "*" allows every origin.
+type Config struct {
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposedHeaders []string
+	MaxAge         time.Duration
+}
+
+// Default allows the methods and headers the API uses and exposes the
+// request ID and rate limit headers, but no origins.
+func Default() Config {
+	return Config{
+		AllowedMethods: []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowedHeaders: []string{"Authorization", "Content-Type", "X-API-Key", "Idempotency-Key", "X-Request-ID"},
+		ExposedHeaders: []string{
+			"X-Request-ID", "Idempotent-Replayed", "Retry-After",
+			"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset",
+			"X-Daily-Quota-Limit", "X-Daily-Quota-Remaining",
+			"Deprecation", "Link",
+		},
+		MaxAge: 10 * time.Minute,
+	}
+}
@@ -0,0 +1,164 @@
+package graphqlapi
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/internal/utils"
+	"github.com/graphql-go/graphql"
+)
+
+// Lists are Relay connections, paged forward with first and after. Cursors
+// are opaque to clients; they hold the offset of an item in its list.
+
+const cursorPrefix = "offset:"
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(b), cursorPrefix))
+	if err != nil || !strings.HasPrefix(string(b), cursorPrefix) || offset < 0 {
+		return 0, errInvalidCursor
+	}
+	return offset, nil
+}
+
+// page is the part of a list a connection field asks for.
+type page struct {
+	offset int
+	first  int
+}
+
+var pageArgs = graphql.FieldConfigArgument{
+	"first": {Type: graphql.Int, Description: "Number of items to return, up to the maximum page size."},
+	"after": {Type: graphql.String, Description: "Cursor of the item to start after."},
+}
+
+// pageOf reads the page args, of which first may be up to maxPageSize.
+func pageOf(args map[string]interface{}, maxPageSize int) (page, error) {
+	p := page{first: utils.DEFAULT_PAGE_SIZE}
+	if first, ok := args["first"].(int); ok {
+		if first < 0 || first > maxPageSize {
+			return page{}, fmt.Errorf("first must be between 0 and %d", maxPageSize)
+		}
+		p.first = first
+	}
+	if after, ok := args["after"].(string); ok {
+		offset, err := decodeCursor(after)
+		if err != nil {
+			return page{}, err
+		}
+		p.offset = offset + 1
+	}
+	return p, nil
+}
+
+type connection struct {
+	Edges    []edge
+	PageInfo pageInfo
+	// TotalCount is nil for lists that are not read whole.
+	TotalCount *int
+}
+
+type edge struct {
+	Cursor string
+	Node   interface{}
+}
+
+type pageInfo struct {
+	HasNextPage     bool
+	HasPreviousPage bool
+	StartCursor     *string
+	EndCursor       *string
+}
+
+// listConnection returns page p of a list read whole.
+func listConnection[T any](all []T, p page) *connection {
+	total := len(all)
+	start := min(p.offset, total)
+	end := min(start+p.first+1, total)
+	return newConnection(all[start:end], p, &total)
+}
+
+// streamConnection returns page p of a streamed list, reading it only as
+// far as the end of the page.
+func streamConnection[T any](seq iter.Seq2[T, error], p page) (*connection, error) {
+	var items []*T
+	offset := 0
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		if offset >= p.offset {
+			items = append(items, &item)
+			if len(items) > p.first {
+				break
+			}
+		}
+		offset++
+	}
+	return newConnection(items, p, nil), nil
+}
+
+// newConnection builds page p from items, the items from p.offset on. One
+// more item than the page holds tells that another page follows.
+func newConnection[T any](items []T, p page, total *int) *connection {
+	c := &connection{
+		PageInfo:   pageInfo{HasPreviousPage: p.offset > 0},
+		TotalCount: total,
+	}
+	if len(items) > p.first {
+		items = items[:p.first]
+		c.PageInfo.HasNextPage = true
+	}
+	c.Edges = make([]edge, len(items))
+	for i, item := range items {
+		c.Edges[i] = edge{Cursor: encodeCursor(p.offset + i), Node: item}
+	}
+	if len(c.Edges) > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[len(c.Edges)-1].Cursor
+	}
+	return c
+}
+
+var pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"hasNextPage":     {Type: graphql.NewNonNull(graphql.Boolean)},
+		"hasPreviousPage": {Type: graphql.NewNonNull(graphql.Boolean)},
+		"startCursor":     {Type: graphql.String},
+		"endCursor":       {Type: graphql.String},
+	},
+})
+
+// connectionOf returns the connection type of lists of node.
+func connectionOf(node *graphql.Object) *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: node.Name() + "Edge",
+		Fields: graphql.Fields{
+			"cursor": {Type: graphql.NewNonNull(graphql.String)},
+			"node":   {Type: graphql.NewNonNull(node)},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: node.Name() + "Connection",
+		Fields: graphql.Fields{
+			"edges":      {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edge)))},
+			"pageInfo":   {Type: graphql.NewNonNull(pageInfoType)},
+			"totalCount": {Type: graphql.Int, Description: "Number of items in the list, null when it is not known without reading the whole list."},
+		},
+	})
+}
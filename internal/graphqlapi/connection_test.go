@@ -0,0 +1,160 @@
+package graphqlapi
+
+import (
+	"encoding/base64"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		name    string
+		cursor  string
+		want    int
+		wantErr bool
+	}{
+		{name: "first item", cursor: encodeCursor(0), want: 0},
+		{name: "later item", cursor: encodeCursor(41), want: 41},
+		{name: "not base64", cursor: "not a cursor!", wantErr: true},
+		{name: "padded base64", cursor: base64.URLEncoding.EncodeToString([]byte("offset:1")), wantErr: true},
+		{name: "empty", cursor: "", wantErr: true},
+		{name: "no offset", cursor: encode("offset:"), wantErr: true},
+		{name: "offset not a number", cursor: encode("offset:ten"), wantErr: true},
+		{name: "negative offset", cursor: encode("offset:-1"), wantErr: true},
+		{name: "bare offset", cursor: encode("3"), wantErr: true},
+		{name: "Relay array cursor", cursor: encode("arrayconnection:3"), wantErr: true},
+		{name: "other prefix", cursor: encode("id:7d4c1f40-0000-4000-8000-000000000001"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeCursor(tt.cursor)
+			if tt.wantErr {
+				if !errors.Is(err, errInvalidCursor) {
+					t.Errorf("decodeCursor(%q) = %d, %v, want errInvalidCursor", tt.cursor, got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("decodeCursor(%q) = %d, %v, want %d", tt.cursor, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageOf(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]interface{}
+		maxPageSize int
+		want        page
+		wantErr     bool
+	}{
+		{name: "defaults", args: map[string]interface{}{}, want: page{first: 10}},
+		{name: "first", args: map[string]interface{}{"first": 3}, want: page{first: 3}},
+		{name: "after", args: map[string]interface{}{"after": encodeCursor(4)}, want: page{offset: 5, first: 10}},
+		{name: "negative first", args: map[string]interface{}{"first": -1}, wantErr: true},
+		{name: "first over the maximum", args: map[string]interface{}{"first": 101}, wantErr: true},
+		{name: "first over a configured maximum", args: map[string]interface{}{"first": 21}, maxPageSize: 20, wantErr: true},
+		{name: "first at a configured maximum", args: map[string]interface{}{"first": 20}, maxPageSize: 20, want: page{first: 20}},
+		{name: "malformed after", args: map[string]interface{}{"after": "garbage"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxPageSize := tt.maxPageSize
+			if maxPageSize == 0 {
+				maxPageSize = 100
+			}
+			got, err := pageOf(tt.args, maxPageSize)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("pageOf() = %+v, %v, want %+v (error %t)", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConnectionPageInfo(t *testing.T) {
+	all := []int{0, 1, 2, 3, 4}
+	tests := []struct {
+		name         string
+		page         page
+		wantNodes    []int
+		wantNext     bool
+		wantPrevious bool
+	}{
+		{name: "first page", page: page{first: 2}, wantNodes: []int{0, 1}, wantNext: true},
+		{name: "middle page", page: page{offset: 2, first: 2}, wantNodes: []int{2, 3}, wantNext: true, wantPrevious: true},
+		{name: "last page", page: page{offset: 4, first: 2}, wantNodes: []int{4}, wantPrevious: true},
+		{name: "page ending at the last item", page: page{offset: 3, first: 2}, wantNodes: []int{3, 4}, wantPrevious: true},
+		{name: "whole list", page: page{first: 5}, wantNodes: []int{0, 1, 2, 3, 4}},
+		{name: "page larger than the list", page: page{first: 10}, wantNodes: []int{0, 1, 2, 3, 4}},
+		{name: "past the end", page: page{offset: 7, first: 2}, wantPrevious: true},
+		{name: "empty page", page: page{first: 0}, wantNext: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			read := 0
+			stream := func(yield func(int, error) bool) {
+				for _, n := range all {
+					read++
+					if !yield(n, nil) {
+						return
+					}
+				}
+			}
+			streamed, err := streamConnection(stream, tt.page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// A stream is read one item past the page, to tell whether
+			// another page follows, and no further.
+			if want := min(tt.page.offset+tt.page.first+1, len(all)); read != want {
+				t.Errorf("stream read %d items, want %d", read, want)
+			}
+
+			for kind, c := range map[string]*connection{"list": listConnection(all, tt.page), "stream": streamed} {
+				var nodes []int
+				for i, e := range c.Edges {
+					nodes = append(nodes, derefInt(e.Node))
+					if offset, err := decodeCursor(e.Cursor); err != nil || offset != tt.page.offset+i {
+						t.Errorf("%s: cursor of edge %d = offset %d, %v, want %d", kind, i, offset, err, tt.page.offset+i)
+					}
+				}
+				if !slices.Equal(nodes, tt.wantNodes) {
+					t.Errorf("%s: nodes = %v, want %v", kind, nodes, tt.wantNodes)
+				}
+				if c.PageInfo.HasNextPage != tt.wantNext || c.PageInfo.HasPreviousPage != tt.wantPrevious {
+					t.Errorf("%s: hasNextPage = %t, hasPreviousPage = %t, want %t and %t", kind,
+						c.PageInfo.HasNextPage, c.PageInfo.HasPreviousPage, tt.wantNext, tt.wantPrevious)
+				}
+				if len(c.Edges) == 0 {
+					if c.PageInfo.StartCursor != nil || c.PageInfo.EndCursor != nil {
+						t.Errorf("%s: cursors of an empty page = %v, %v, want nil", kind, c.PageInfo.StartCursor, c.PageInfo.EndCursor)
+					}
+				} else if *c.PageInfo.StartCursor != c.Edges[0].Cursor || *c.PageInfo.EndCursor != c.Edges[len(c.Edges)-1].Cursor {
+					t.Errorf("%s: start and end cursors do not match the edges", kind)
+				}
+			}
+		})
+	}
+}
+
+// derefInt returns the node of a list's edge, which streams hold by pointer.
+func derefInt(node interface{}) int {
+	if p, ok := node.(*int); ok {
+		return *p
+	}
+	return node.(int)
+}
+
+func TestListConnectionTotalCount(t *testing.T) {
+	c := listConnection([]int{0, 1, 2}, page{first: 1})
+	if c.TotalCount == nil || *c.TotalCount != 3 {
+		t.Errorf("totalCount = %v, want 3", c.TotalCount)
+	}
+	streamed, _ := streamConnection(func(yield func(int, error) bool) { yield(0, nil) }, page{first: 1})
+	if streamed.TotalCount != nil {
+		t.Errorf("totalCount of a stream = %d, want null", *streamed.TotalCount)
+	}
+}
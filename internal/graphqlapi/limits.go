@@ -0,0 +1,200 @@
+package graphqlapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/internal/utils"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Limits bound the queries the schema runs. They are checked before a
+// query runs, so that a query over them costs no database work.
+type Limits struct {
+	// MaxDepth is the deepest nesting of fields a query may select.
+	MaxDepth int
+	// MaxCost is the most objects a query may resolve, estimated from the
+	// page sizes it asks for.
+	MaxCost int
+	// MaxPageSize is the largest page a connection field returns.
+	MaxPageSize int
+}
+
+// checkLimits reports an operation of doc that exceeds limits.
+func checkLimits(schema *graphql.Schema, doc *ast.Document, operationName string, variables map[string]interface{}, limits Limits) error {
+	a := analysis{
+		schema:        schema,
+		fragments:     make(map[string]*ast.FragmentDefinition),
+		variables:     make(map[string]interface{}),
+		maxCost:       limits.MaxCost,
+		maxPageSize:   limits.MaxPageSize,
+		fragmentCost:  make(map[string]int),
+		fragmentDepth: make(map[string]int),
+	}
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				operation = def
+			}
+		case *ast.FragmentDefinition:
+			a.fragments[def.Name.Value] = def
+		}
+	}
+	// Execution reports a missing operation, and anything but a query is
+	// rejected by the schema.
+	if operation == nil || operation.Operation != ast.OperationTypeQuery {
+		return nil
+	}
+
+	for _, def := range operation.VariableDefinitions {
+		a.variables[def.Variable.Name.Value] = def.DefaultValue
+	}
+	for name, value := range variables {
+		a.variables[name] = value
+	}
+
+	if depth := a.depth(operation.SelectionSet); depth > limits.MaxDepth {
+		return fmt.Errorf("query depth %d exceeds the limit of %d", depth, limits.MaxDepth)
+	}
+	if a.cost(schema.QueryType(), operation.SelectionSet) > limits.MaxCost {
+		return fmt.Errorf("query cost exceeds the limit of %d objects", limits.MaxCost)
+	}
+	return nil
+}
+
+type analysis struct {
+	schema      *graphql.Schema
+	fragments   map[string]*ast.FragmentDefinition
+	variables   map[string]interface{}
+	maxCost     int
+	maxPageSize int
+
+	// Fragments are measured once however often they are spread.
+	fragmentCost  map[string]int
+	fragmentDepth map[string]int
+}
+
+// depth returns how deeply fields are nested in set. Introspection fields
+// are not counted.
+func (a *analysis) depth(set *ast.SelectionSet) int {
+	if set == nil {
+		return 0
+	}
+	deepest := 0
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if !introspection(selection) {
+				deepest = max(deepest, 1+a.depth(selection.SelectionSet))
+			}
+		case *ast.InlineFragment:
+			deepest = max(deepest, a.depth(selection.SelectionSet))
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			if _, ok := a.fragmentDepth[name]; !ok {
+				if fragment, ok := a.fragments[name]; ok {
+					a.fragmentDepth[name] = a.depth(fragment.SelectionSet)
+				}
+			}
+			deepest = max(deepest, a.fragmentDepth[name])
+		}
+	}
+	return deepest
+}
+
+// cost estimates how many objects set resolves on parent: each object field
+// counts once, times the page sizes of the connections it is in. The count
+// stops growing past maxCost, so that nested pages cannot overflow it.
+func (a *analysis) cost(parent *graphql.Object, set *ast.SelectionSet) int {
+	if set == nil {
+		return 0
+	}
+	total := 0
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if introspection(selection) {
+				continue
+			}
+			def := parent.Fields()[selection.Name.Value]
+			if def == nil {
+				continue
+			}
+			object, ok := graphql.GetNamed(def.Type).(*graphql.Object)
+			if !ok {
+				continue
+			}
+			children := a.cost(object, selection.SelectionSet)
+			if isConnection(def) {
+				children *= a.pageSize(selection)
+			}
+			total += 1 + children
+		case *ast.InlineFragment:
+			total += a.cost(a.typeCondition(parent, selection.TypeCondition), selection.SelectionSet)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			if _, ok := a.fragmentCost[name]; !ok {
+				if fragment, ok := a.fragments[name]; ok {
+					a.fragmentCost[name] = a.cost(a.typeCondition(parent, fragment.TypeCondition), fragment.SelectionSet)
+				}
+			}
+			total += a.fragmentCost[name]
+		}
+		total = min(total, a.maxCost+1)
+	}
+	return total
+}
+
+// typeCondition returns the type a fragment applies to, parent when it
+// names none.
+func (a *analysis) typeCondition(parent *graphql.Object, condition *ast.Named) *graphql.Object {
+	if condition == nil {
+		return parent
+	}
+	if object, ok := a.schema.Type(condition.Name.Value).(*graphql.Object); ok {
+		return object
+	}
+	return parent
+}
+
+// pageSize returns the number of items a connection field asks for.
+func (a *analysis) pageSize(field *ast.Field) int {
+	first := utils.DEFAULT_PAGE_SIZE
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+		value := interface{}(arg.Value)
+		if variable, ok := value.(*ast.Variable); ok {
+			value = a.variables[variable.Name.Value]
+		}
+		switch v := value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(v.Value); err == nil {
+				first = n
+			}
+		case float64:
+			first = int(v)
+		case int:
+			first = v
+		}
+	}
+	return max(0, min(first, a.maxPageSize))
+}
+
+func isConnection(def *graphql.FieldDefinition) bool {
+	for _, arg := range def.Args {
+		if arg.Name() == "first" {
+			return true
+		}
+	}
+	return false
+}
+
+func introspection(field *ast.Field) bool {
+	return strings.HasPrefix(field.Name.Value, "__")
+}
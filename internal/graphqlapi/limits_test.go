@@ -0,0 +1,119 @@
+package graphqlapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+func TestCheckLimits(t *testing.T) {
+	schema, _ := newTestSchema(t, nil, Limits{MaxPageSize: 100})
+	limits := Limits{MaxDepth: 7, MaxCost: 100, MaxPageSize: 100}
+
+	// A driver's constructor costs 1 object, the driver 1 more and its edge
+	// 1 more: 3 per item of the page, plus 1 for the connection.
+	tests := []struct {
+		name      string
+		query     string
+		operation string
+		variables map[string]interface{}
+		wantErr   string
+	}{
+		{
+			name:  "at the cost limit",
+			query: `{ drivers(first: 33) { edges { node { constructor { name } } } } }`,
+		},
+		{
+			name:  "default page size",
+			query: `{ drivers { edges { node { constructor { name } } } } }`,
+		},
+		{
+			name:    "first over the cost",
+			query:   `{ drivers(first: 34) { edges { node { constructor { name } } } } }`,
+			wantErr: "query cost exceeds the limit of 100 objects",
+		},
+		{
+			name:      "first from a variable",
+			query:     `query($n: Int) { drivers(first: $n) { edges { node { constructor { name } } } } }`,
+			variables: map[string]interface{}{"n": float64(34)},
+			wantErr:   "query cost exceeds the limit of 100 objects",
+		},
+		{
+			name:    "first from a variable's default",
+			query:   `query($n: Int = 34) { drivers(first: $n) { edges { node { constructor { name } } } } }`,
+			wantErr: "query cost exceeds the limit of 100 objects",
+		},
+		{
+			name:  "fragment without objects",
+			query: `{ drivers(first: 34) { edges { node { ...names } } } } fragment names on Driver { firstName lastName }`,
+		},
+		{
+			name:    "fragment spread",
+			query:   `{ drivers(first: 34) { edges { node { ...team } } } } fragment team on Driver { constructor { name } }`,
+			wantErr: "query cost exceeds the limit of 100 objects",
+		},
+		{
+			name:    "inline fragment",
+			query:   `{ drivers(first: 34) { edges { node { ... on Driver { constructor { name } } } } } }`,
+			wantErr: "query cost exceeds the limit of 100 objects",
+		},
+		{
+			name:    "fields of every page",
+			query:   `{ a: drivers(first: 17) { edges { node { constructor { name } } } } b: drivers(first: 17) { edges { node { constructor { name } } } } }`,
+			wantErr: "query cost exceeds the limit of 100 objects",
+		},
+		{
+			name:    "nested pages",
+			query:   `{ seasons(first: 10) { edges { node { races(first: 10) { edges { node { name } } } } } } }`,
+			wantErr: "query cost exceeds the limit of 100 objects",
+		},
+		{
+			name:  "at the depth limit",
+			query: `{ seasons(first: 1) { edges { node { races(first: 1) { edges { node { name } } } } } } }`,
+		},
+		{
+			name:    "over the depth limit",
+			query:   `{ seasons(first: 1) { edges { node { races(first: 1) { edges { node { circuit { name } } } } } } } }`,
+			wantErr: "query depth 8 exceeds the limit of 7",
+		},
+		{
+			name:    "depth of a fragment",
+			query:   `{ seasons(first: 1) { edges { node { ...races } } } } fragment races on Season { races(first: 1) { edges { node { circuit { name } } } } }`,
+			wantErr: "query depth 8 exceeds the limit of 7",
+		},
+		{
+			name:  "introspection",
+			query: `{ __schema { types { fields { type { ofType { ofType { name } } } } } } }`,
+		},
+		{
+			name:      "only the operation run",
+			query:     `query small { drivers(first: 1) { edges { node { ref } } } } query large { drivers(first: 100) { edges { node { ref } } } }`,
+			operation: "small",
+		},
+		{
+			name:      "the named operation",
+			query:     `query small { drivers(first: 1) { edges { node { ref } } } } query large { drivers(first: 100) { edges { node { ref } } } }`,
+			operation: "large",
+			wantErr:   "query cost exceeds the limit of 100 objects",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.Parse(parser.ParseParams{Source: tt.query})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = checkLimits(&schema.schema, doc, tt.operation, tt.variables, limits)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkLimits() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkLimits() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/google/uuid"
+)
+
+// loader batches the lookups resolvers make while a query runs. load queues
+// a key and returns a thunk. The executor calls thunks only once it has
+// resolved every field of the current level, so the first thunk called
+// fetches all keys queued by then in one call. Values are kept for the rest
+// of the request.
+type loader[K comparable, V any] struct {
+	ctx   context.Context
+	what  string
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending []K
+	loaded  map[K]*loaded[V]
+}
+
+type loaded[V any] struct {
+	value V
+	err   error
+	done  bool
+}
+
+// newLoader returns a loader for one request. what names the records in
+// the error a failed fetch is reported with.
+func newLoader[K comparable, V any](ctx context.Context, what string, fetch func(context.Context, []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		ctx:    ctx,
+		what:   what,
+		fetch:  fetch,
+		loaded: make(map[K]*loaded[V]),
+	}
+}
+
+func (l *loader[K, V]) load(key K) func() (V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.loaded[key]; !ok {
+		l.loaded[key] = &loaded[V]{}
+		l.pending = append(l.pending, key)
+	}
+	return func() (V, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		entry := l.loaded[key]
+		if !entry.done {
+			l.dispatch()
+		}
+		return entry.value, entry.err
+	}
+}
+
+// dispatch fetches every pending key. It is called with l.mu held.
+func (l *loader[K, V]) dispatch() {
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.fetch(l.ctx, keys)
+	if err != nil {
+		err = failed(l.ctx, l.what, err)
+	}
+	for _, key := range keys {
+		entry := l.loaded[key]
+		entry.value, entry.err, entry.done = values[key], err, true
+	}
+}
+
+// loaders are the loaders of one request.
+type loaders struct {
+	seasons            *loader[uuid.UUID, *model.Season]
+	races              *loader[uuid.UUID, *model.Race]
+	circuits           *loader[uuid.UUID, *model.Circuit]
+	drivers            *loader[uuid.UUID, *model.Driver]
+	constructors       *loader[uuid.UUID, *model.Constructor]
+	driverConstructors *loader[uuid.UUID, *model.Constructor]
+
+	// The lists below are keyed by the season or race they belong to.
+	seasonRaces                *loader[uuid.UUID, []*model.Race]
+	raceResults                *loader[uuid.UUID, []*model.Result]
+	seasonDriverStandings      *loader[uuid.UUID, []*model.DriverStanding]
+	seasonConstructorStandings *loader[uuid.UUID, []*model.ConstructorStanding]
+}
+
+func newLoaders(ctx context.Context, s Services) *loaders {
+	return &loaders{
+		seasons:      newLoader(ctx, "seasons", byID(s.Seasons.GetSeasonsByIDs, func(s *model.Season) uuid.UUID { return s.ID })),
+		races:        newLoader(ctx, "races", byID(s.Races.GetRacesByIDs, func(r *model.Race) uuid.UUID { return r.ID })),
+		circuits:     newLoader(ctx, "circuits", byID(pointers(s.Circuits.GetCircuitsByIDs), func(c *model.Circuit) uuid.UUID { return c.ID })),
+		drivers:      newLoader(ctx, "drivers", byID(pointers(s.Drivers.GetDriversByIDs), func(d *model.Driver) uuid.UUID { return d.ID })),
+		constructors: newLoader(ctx, "constructors", byID(pointers(s.Constructors.GetConstructorsByIDs), func(c *model.Constructor) uuid.UUID { return c.ID })),
+		driverConstructors: newLoader(ctx, "constructors", func(ctx context.Context, driverIDs []uuid.UUID) (map[uuid.UUID]*model.Constructor, error) {
+			constructors, err := s.Constructors.GetDriverConstructors(ctx, driverIDs)
+			if err != nil {
+				return nil, err
+			}
+			byDriver := make(map[uuid.UUID]*model.Constructor, len(constructors))
+			for driverID, constructor := range constructors {
+				byDriver[driverID] = &constructor
+			}
+			return byDriver, nil
+		}),
+
+		seasonRaces:                newLoader(ctx, "races", groupBy(s.Races.GetRacesBySeasonIDs, func(r *model.Race) uuid.UUID { return r.SeasonID })),
+		raceResults:                newLoader(ctx, "results", groupBy(s.Results.GetResultsByRaceIDs, func(r *model.Result) uuid.UUID { return r.RaceID })),
+		seasonDriverStandings:      newLoader(ctx, "driver standings", groupBy(s.Standings.GetDriverStandingsBySeasonIDs, func(s *model.DriverStanding) uuid.UUID { return s.SeasonID })),
+		seasonConstructorStandings: newLoader(ctx, "constructor standings", groupBy(s.Standings.GetConstructorStandingsBySeasonIDs, func(s *model.ConstructorStanding) uuid.UUID { return s.SeasonID })),
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+type batchFetch[T any] func(ctx context.Context, ids []uuid.UUID) ([]T, error)
+
+// byID indexes the records fetch returns by their ID.
+func byID[T any](fetch batchFetch[T], id func(T) uuid.UUID) func(context.Context, []uuid.UUID) (map[uuid.UUID]T, error) {
+	return func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]T, error) {
+		records, err := fetch(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		indexed := make(map[uuid.UUID]T, len(records))
+		for _, record := range records {
+			indexed[id(record)] = record
+		}
+		return indexed, nil
+	}
+}
+
+// groupBy groups the records fetch returns by the record they belong to,
+// keeping their order.
+func groupBy[T any](fetch batchFetch[T], parent func(T) uuid.UUID) func(context.Context, []uuid.UUID) (map[uuid.UUID][]T, error) {
+	return func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]T, error) {
+		records, err := fetch(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		grouped := make(map[uuid.UUID][]T, len(ids))
+		for _, record := range records {
+			grouped[parent(record)] = append(grouped[parent(record)], record)
+		}
+		return grouped, nil
+	}
+}
+
+// pointers adapts a fetch of values to return pointers, as the other
+// fetches do.
+func pointers[T any](fetch batchFetch[T]) batchFetch[*T] {
+	return func(ctx context.Context, ids []uuid.UUID) ([]*T, error) {
+		records, err := fetch(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		ptrs := make([]*T, len(records))
+		for i := range records {
+			ptrs[i] = &records[i]
+		}
+		return ptrs, nil
+	}
+}
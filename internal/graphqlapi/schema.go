@@ -0,0 +1,338 @@
+// Package graphqlapi serves the API as a read-only GraphQL schema over the
+// same services as the REST and gRPC APIs.
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Services are the services the schema reads from.
+type Services struct {
+	Constructors service.ConstructorService
+	Drivers      service.DriverService
+	Circuits     service.CircuitService
+	Seasons      service.SeasonService
+	Races        service.RaceService
+	Results      service.ResultService
+	Standings    service.StandingService
+}
+
+type Schema struct {
+	schema   graphql.Schema
+	services Services
+	limits   Limits
+}
+
+func NewSchema(services Services, limits Limits) (*Schema, error) {
+	t := newObjectTypes(limits.MaxPageSize)
+	q := query{services: services, maxPageSize: limits.MaxPageSize}
+
+	root := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"season": {
+				Type:    t.season,
+				Args:    graphql.FieldConfigArgument{"year": {Type: graphql.NewNonNull(graphql.Int)}},
+				Resolve: q.season,
+			},
+			"seasons": {
+				Type:    graphql.NewNonNull(t.connectionOf(t.season)),
+				Args:    pageArgs,
+				Resolve: q.seasons,
+			},
+			"race": {
+				Type:    t.race,
+				Args:    graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: q.race,
+			},
+			"circuit": {
+				Type:        t.circuit,
+				Description: "A circuit by ID or ref.",
+				Args:        graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve:     q.circuit,
+			},
+			"circuits": {
+				Type: graphql.NewNonNull(t.connectionOf(t.circuit)),
+				Args: withPageArgs(graphql.FieldConfigArgument{
+					"name":     {Type: graphql.String},
+					"location": {Type: graphql.String},
+					"country":  {Type: graphql.String},
+					"current":  {Type: graphql.Boolean},
+				}),
+				Resolve: q.circuits,
+			},
+			"driver": {
+				Type:        t.driver,
+				Description: "A driver by ID or ref.",
+				Args:        graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve:     q.driver,
+			},
+			"drivers": {
+				Type: graphql.NewNonNull(t.connectionOf(t.driver)),
+				Args: withPageArgs(graphql.FieldConfigArgument{
+					"firstName":   {Type: graphql.String},
+					"lastName":    {Type: graphql.String},
+					"team":        {Type: graphql.String, Description: "Name of the driver's constructor."},
+					"nationality": {Type: graphql.String},
+					"status":      {Type: graphql.String},
+					"code":        {Type: graphql.String},
+					"number":      {Type: graphql.Int},
+				}),
+				Resolve: q.drivers,
+			},
+			"constructor": {
+				Type:        t.constructor,
+				Description: "A constructor by ID or ref.",
+				Args:        graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve:     q.constructor,
+			},
+			"constructors": {
+				Type: graphql.NewNonNull(t.connectionOf(t.constructor)),
+				Args: withPageArgs(graphql.FieldConfigArgument{
+					"name":        {Type: graphql.String},
+					"nationality": {Type: graphql.String},
+				}),
+				Resolve: q.constructors,
+			},
+		},
+	})
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: root})
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{
+		schema:   schema,
+		services: services,
+		limits:   limits,
+	}, nil
+}
+
+// Execute runs a query. Like GraphQL servers do, it reports every failure,
+// from syntax errors to failed fields, in the response rather than as an
+// error.
+func (s *Schema) Execute(ctx context.Context, req model.GraphQLRequest) model.GraphQLResponse {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return response(nil, gqlerrors.FormatErrors(err))
+	}
+	if validation := graphql.ValidateDocument(&s.schema, doc, nil); !validation.IsValid {
+		return response(nil, validation.Errors)
+	}
+	if err := checkLimits(&s.schema, doc, req.OperationName, req.Variables, s.limits); err != nil {
+		return response(nil, gqlerrors.FormatErrors(err))
+	}
+
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withLoaders(ctx, newLoaders(ctx, s.services)),
+	})
+	return response(result.Data, result.Errors)
+}
+
+func response(data interface{}, errs []gqlerrors.FormattedError) model.GraphQLResponse {
+	resp := model.GraphQLResponse{Data: data}
+	for _, e := range errs {
+		gqlErr := model.GraphQLError{Message: e.Message, Path: e.Path}
+		for _, l := range e.Locations {
+			gqlErr.Locations = append(gqlErr.Locations, model.GraphQLLocation{Line: l.Line, Column: l.Column})
+		}
+		resp.Errors = append(resp.Errors, gqlErr)
+	}
+	return resp
+}
+
+// query resolves the root fields. Records are fetched by ID through the
+// loaders, so that the same record is read once per request.
+type query struct {
+	services    Services
+	maxPageSize int
+}
+
+type resolver func(ctx context.Context, ref string) (uuid.UUID, error)
+
+func (q query) season(p graphql.ResolveParams) (interface{}, error) {
+	year, _ := p.Args["year"].(int)
+	id, err := q.services.Seasons.ResolveSeasonYear(p.Context, year)
+	if err != nil {
+		return nil, notFoundOrFailed(p.Context, "season", err)
+	}
+	return byKey(loadersFrom(p.Context).seasons, id), nil
+}
+
+func (q query) seasons(p graphql.ResolveParams) (interface{}, error) {
+	page, err := pageOf(p.Args, q.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	seasons, err := q.services.Seasons.GetAllSeasons(p.Context)
+	if err != nil {
+		return nil, failed(p.Context, "seasons", err)
+	}
+	return listConnection(seasons, page), nil
+}
+
+func (q query) race(p graphql.ResolveParams) (interface{}, error) {
+	id, err := resolveID(p, "race", nil)
+	if err != nil {
+		return nil, err
+	}
+	return byKey(loadersFrom(p.Context).races, id), nil
+}
+
+func (q query) circuit(p graphql.ResolveParams) (interface{}, error) {
+	id, err := resolveID(p, "circuit", q.services.Circuits.ResolveCircuitRef)
+	if err != nil || id == uuid.Nil {
+		return nil, err
+	}
+	return byKey(loadersFrom(p.Context).circuits, id), nil
+}
+
+func (q query) driver(p graphql.ResolveParams) (interface{}, error) {
+	id, err := resolveID(p, "driver", q.services.Drivers.ResolveDriverRef)
+	if err != nil || id == uuid.Nil {
+		return nil, err
+	}
+	return byKey(loadersFrom(p.Context).drivers, id), nil
+}
+
+func (q query) constructor(p graphql.ResolveParams) (interface{}, error) {
+	id, err := resolveID(p, "constructor", q.services.Constructors.ResolveConstructorRef)
+	if err != nil || id == uuid.Nil {
+		return nil, err
+	}
+	return byKey(loadersFrom(p.Context).constructors, id), nil
+}
+
+func (q query) circuits(p graphql.ResolveParams) (interface{}, error) {
+	page, err := pageOf(p.Args, q.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	filter := model.CircuitFilter{
+		Name:     stringArg(p.Args, "name"),
+		Location: stringArg(p.Args, "location"),
+		Country:  stringArg(p.Args, "country"),
+	}
+	if current, ok := p.Args["current"].(bool); ok {
+		filter.Current = &current
+	}
+	c, err := streamConnection(q.services.Circuits.StreamCircuits(p.Context, filter), page)
+	if err != nil {
+		return nil, failed(p.Context, "circuits", err)
+	}
+	return c, nil
+}
+
+func (q query) drivers(p graphql.ResolveParams) (interface{}, error) {
+	page, err := pageOf(p.Args, q.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	filter := model.DriverFilter{
+		FirstName:   stringArg(p.Args, "firstName"),
+		LastName:    stringArg(p.Args, "lastName"),
+		Team:        stringArg(p.Args, "team"),
+		Nationality: stringArg(p.Args, "nationality"),
+		Status:      stringArg(p.Args, "status"),
+		Code:        stringArg(p.Args, "code"),
+	}
+	if number, ok := p.Args["number"].(int); ok {
+		filter.Number = &number
+	}
+	c, err := streamConnection(q.services.Drivers.StreamDrivers(p.Context, filter), page)
+	if err != nil {
+		return nil, failed(p.Context, "drivers", err)
+	}
+	return c, nil
+}
+
+func (q query) constructors(p graphql.ResolveParams) (interface{}, error) {
+	page, err := pageOf(p.Args, q.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+	filter := model.ConstructorFilter{
+		Name:        stringArg(p.Args, "name"),
+		Nationality: stringArg(p.Args, "nationality"),
+	}
+	c, err := streamConnection(q.services.Constructors.StreamConstructors(p.Context, filter), page)
+	if err != nil {
+		return nil, failed(p.Context, "constructors", err)
+	}
+	return c, nil
+}
+
+// resolveID reads the id argument, a UUID or, when resolve is given, a ref.
+// A ref that names no record resolves to uuid.Nil, so that the field is null.
+func resolveID(p graphql.ResolveParams, what string, resolve resolver) (uuid.UUID, error) {
+	arg, _ := p.Args["id"].(string)
+	if id, err := uuid.Parse(arg); err == nil {
+		return id, nil
+	}
+	if resolve == nil {
+		return uuid.Nil, fmt.Errorf("invalid %s ID", what)
+	}
+	id, err := resolve(p.Context, arg)
+	if err != nil {
+		return uuid.Nil, notFoundOrFailed(p.Context, what, err)
+	}
+	return id, nil
+}
+
+// byKey returns a thunk of the record with key, null when there is none.
+func byKey[T any](l *loader[uuid.UUID, *T], key uuid.UUID) func() (interface{}, error) {
+	thunk := l.load(key)
+	return func() (interface{}, error) {
+		record, err := thunk()
+		if err != nil || record == nil {
+			return nil, err
+		}
+		return record, nil
+	}
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+// withPageArgs adds the connection arguments to args.
+func withPageArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	for name, arg := range pageArgs {
+		args[name] = arg
+	}
+	return args
+}
+
+// notFoundOrFailed is nil for a record that does not exist, which the field
+// reports as null, and otherwise masks err as failed does.
+func notFoundOrFailed(ctx context.Context, what string, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	return failed(ctx, what, err)
+}
+
+// failed logs err and returns the error the client sees in its place.
+func failed(ctx context.Context, what string, err error) error {
+	slog.ErrorContext(ctx, "GraphQL error: Failed to fetch "+what, "error", err)
+	return errors.New("Failed to fetch " + what)
+}
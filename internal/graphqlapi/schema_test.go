@@ -0,0 +1,197 @@
+package graphqlapi
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/google/uuid"
+)
+
+// fetches records the repository calls a query makes, with the IDs each
+// batched call asked for.
+type fetches struct {
+	mu    sync.Mutex
+	calls map[string][][]uuid.UUID
+}
+
+func (f *fetches) add(method string, ids []uuid.UUID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.calls == nil {
+		f.calls = make(map[string][][]uuid.UUID)
+	}
+	f.calls[method] = append(f.calls[method], ids)
+}
+
+func (f *fetches) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, calls := range f.calls {
+		n += len(calls)
+	}
+	return n
+}
+
+type driverRepo struct {
+	repository.DriverRepository
+	fetches *fetches
+	drivers []model.Driver
+}
+
+func (r driverRepo) StreamDrivers(context.Context, model.DriverFilter) iter.Seq2[model.Driver, error] {
+	r.fetches.add("StreamDrivers", nil)
+	return func(yield func(model.Driver, error) bool) {
+		for _, driver := range r.drivers {
+			if !yield(driver, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (r driverRepo) GetDriversByIDs(_ context.Context, ids []uuid.UUID) ([]model.Driver, error) {
+	r.fetches.add("GetDriversByIDs", ids)
+	var drivers []model.Driver
+	for _, driver := range r.drivers {
+		if slices.Contains(ids, driver.ID) {
+			drivers = append(drivers, driver)
+		}
+	}
+	return drivers, nil
+}
+
+type constructorRepo struct {
+	repository.ConstructorRepository
+	fetches *fetches
+}
+
+func (r constructorRepo) GetDriverConstructors(_ context.Context, driverIDs []uuid.UUID) (map[uuid.UUID]model.Constructor, error) {
+	r.fetches.add("GetDriverConstructors", driverIDs)
+	constructors := make(map[uuid.UUID]model.Constructor, len(driverIDs))
+	for _, id := range driverIDs {
+		constructors[id] = model.Constructor{ID: uuid.New(), Name: "Ferrari"}
+	}
+	return constructors, nil
+}
+
+// newTestSchema returns a schema over drivers whose repository calls are
+// recorded in fetches.
+func newTestSchema(t *testing.T, drivers []model.Driver, limits Limits) (*Schema, *fetches) {
+	t.Helper()
+	f := &fetches{}
+	schema, err := NewSchema(Services{
+		Constructors: service.NewConstructorService(constructorRepo{fetches: f}, nil),
+		Drivers:      service.NewDriverService(driverRepo{fetches: f, drivers: drivers}, nil, nil, nil),
+		Circuits:     service.NewCircuitService(nil, nil),
+		Seasons:      service.NewSeasonService(nil, nil),
+		Races:        service.NewRaceService(nil, nil, nil, nil),
+		Results:      service.NewResultService(nil, nil),
+		Standings:    service.NewStandingService(nil, nil),
+	}, limits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema, f
+}
+
+func testDrivers(n int) []model.Driver {
+	drivers := make([]model.Driver, n)
+	for i := range drivers {
+		drivers[i] = model.Driver{ID: uuid.New(), Ref: "driver-" + string(rune('a'+i))}
+	}
+	return drivers
+}
+
+func TestSiblingLookupsAreBatched(t *testing.T) {
+	drivers := testDrivers(5)
+	tests := []struct {
+		name   string
+		query  string
+		method string
+		want   []uuid.UUID
+	}{
+		{
+			name:   "root fields",
+			query:  `{ a: driver(id: "` + drivers[0].ID.String() + `") { ref } b: driver(id: "` + drivers[1].ID.String() + `") { ref } c: driver(id: "` + drivers[2].ID.String() + `") { ref } }`,
+			method: "GetDriversByIDs",
+			want:   []uuid.UUID{drivers[0].ID, drivers[1].ID, drivers[2].ID},
+		},
+		{
+			name:   "fields of a list",
+			query:  `{ drivers(first: 5) { edges { node { ref constructor { name } } } } }`,
+			method: "GetDriverConstructors",
+			want:   []uuid.UUID{drivers[0].ID, drivers[1].ID, drivers[2].ID, drivers[3].ID, drivers[4].ID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, f := newTestSchema(t, drivers, Limits{MaxDepth: 10, MaxCost: 1000, MaxPageSize: 100})
+			resp := schema.Execute(context.Background(), model.GraphQLRequest{Query: tt.query})
+			if len(resp.Errors) > 0 {
+				t.Fatalf("Execute() errors = %+v", resp.Errors)
+			}
+
+			calls := f.calls[tt.method]
+			if len(calls) != 1 {
+				t.Fatalf("%s called %d times, want once", tt.method, len(calls))
+			}
+			if !sameIDs(calls[0], tt.want) {
+				t.Errorf("%s(%v), want the IDs %v", tt.method, calls[0], tt.want)
+			}
+		})
+	}
+}
+
+func sameIDs(got, want []uuid.UUID) bool {
+	sorted := func(ids []uuid.UUID) []string {
+		s := make([]string, len(ids))
+		for i, id := range ids {
+			s[i] = id.String()
+		}
+		slices.Sort(s)
+		return s
+	}
+	return slices.Equal(sorted(got), sorted(want))
+}
+
+func TestQueriesOverTheLimitsFetchNothing(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr string
+	}{
+		{
+			name:    "too deep",
+			query:   `{ drivers { edges { node { constructor { name } } } } }`,
+			wantErr: "query depth 5 exceeds the limit of 4",
+		},
+		{
+			name:    "too costly",
+			query:   `{ drivers(first: 100) { edges { node { ref } } } }`,
+			wantErr: "query cost exceeds the limit of 100 objects",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, f := newTestSchema(t, testDrivers(3), Limits{MaxDepth: 4, MaxCost: 100, MaxPageSize: 100})
+			resp := schema.Execute(context.Background(), model.GraphQLRequest{Query: tt.query})
+			if len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Message, tt.wantErr) {
+				t.Errorf("Execute() errors = %+v, want %q", resp.Errors, tt.wantErr)
+			}
+			if resp.Data != nil {
+				t.Errorf("Execute() data = %v, want none", resp.Data)
+			}
+			if n := f.count(); n != 0 {
+				t.Errorf("the rejected query made %d repository calls: %v", n, f.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package graphqlapi
+
+import (
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+)
+
+// Scalar fields are read from the model structs by name. Related records
+// and lists are read through the request's loaders, so that a list of
+// races loads its circuits, results and drivers in one query each.
+
+type objectTypes struct {
+	season              *graphql.Object
+	race                *graphql.Object
+	circuit             *graphql.Object
+	driver              *graphql.Object
+	constructor         *graphql.Object
+	result              *graphql.Object
+	driverStanding      *graphql.Object
+	constructorStanding *graphql.Object
+
+	connections map[string]*graphql.Object
+}
+
+// newObjectTypes returns the object types, whose connection fields return at
+// most maxPageSize items.
+func newObjectTypes(maxPageSize int) *objectTypes {
+	t := &objectTypes{connections: make(map[string]*graphql.Object)}
+
+	t.constructor = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Constructor",
+		Fields: graphql.Fields{
+			"id":          {Type: graphql.NewNonNull(graphql.ID)},
+			"ref":         {Type: graphql.NewNonNull(graphql.String)},
+			"name":        {Type: graphql.NewNonNull(graphql.String)},
+			"nationality": {Type: graphql.NewNonNull(graphql.String)},
+			"url":         {Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	t.driver = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Driver",
+		Fields: graphql.Fields{
+			"id":          {Type: graphql.NewNonNull(graphql.ID)},
+			"ref":         {Type: graphql.NewNonNull(graphql.String)},
+			"code":        {Type: graphql.String},
+			"number":      {Type: graphql.Int},
+			"firstName":   {Type: graphql.NewNonNull(graphql.String)},
+			"lastName":    {Type: graphql.NewNonNull(graphql.String)},
+			"dateOfBirth": {Type: graphql.NewNonNull(graphql.DateTime)},
+			"nationality": {Type: graphql.NewNonNull(graphql.String)},
+			"status":      {Type: graphql.NewNonNull(graphql.String)},
+			"url":         {Type: graphql.NewNonNull(graphql.String)},
+			"constructor": {
+				Type:    t.constructor,
+				Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Constructor] { return l.driverConstructors }, func(d *model.Driver) uuid.UUID { return d.ID }),
+			},
+		},
+	})
+
+	t.circuit = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Circuit",
+		Fields: graphql.Fields{
+			"id":       {Type: graphql.NewNonNull(graphql.ID)},
+			"ref":      {Type: graphql.NewNonNull(graphql.String)},
+			"name":     {Type: graphql.NewNonNull(graphql.String)},
+			"location": {Type: graphql.NewNonNull(graphql.String)},
+			"country":  {Type: graphql.NewNonNull(graphql.String)},
+			"current":  {Type: graphql.NewNonNull(graphql.Boolean)},
+			"url":      {Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	// Seasons and races refer to each other, so their fields are built
+	// once both types exist.
+	t.season = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Season",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":   {Type: graphql.NewNonNull(graphql.ID)},
+				"year": {Type: graphql.NewNonNull(graphql.Int)},
+				"url":  {Type: graphql.NewNonNull(graphql.String)},
+				"races": {
+					Type:    graphql.NewNonNull(t.connectionOf(t.race)),
+					Args:    pageArgs,
+					Resolve: list(maxPageSize, func(l *loaders) *loader[uuid.UUID, []*model.Race] { return l.seasonRaces }, func(s *model.Season) uuid.UUID { return s.ID }),
+				},
+				"race": {
+					Type: t.race,
+					Args: graphql.FieldConfigArgument{
+						"round": {Type: graphql.NewNonNull(graphql.Int)},
+					},
+					Resolve: seasonRace,
+				},
+				"driverStandings": {
+					Type:    graphql.NewNonNull(t.connectionOf(t.driverStanding)),
+					Args:    pageArgs,
+					Resolve: list(maxPageSize, func(l *loaders) *loader[uuid.UUID, []*model.DriverStanding] { return l.seasonDriverStandings }, func(s *model.Season) uuid.UUID { return s.ID }),
+				},
+				"constructorStandings": {
+					Type:    graphql.NewNonNull(t.connectionOf(t.constructorStanding)),
+					Args:    pageArgs,
+					Resolve: list(maxPageSize, func(l *loaders) *loader[uuid.UUID, []*model.ConstructorStanding] { return l.seasonConstructorStandings }, func(s *model.Season) uuid.UUID { return s.ID }),
+				},
+			}
+		}),
+	})
+
+	t.race = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Race",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":    {Type: graphql.NewNonNull(graphql.ID)},
+				"round": {Type: graphql.NewNonNull(graphql.Int)},
+				"name":  {Type: graphql.NewNonNull(graphql.String)},
+				"date":  {Type: graphql.NewNonNull(graphql.DateTime)},
+				"url":   {Type: graphql.NewNonNull(graphql.String)},
+				"season": {
+					Type:    t.season,
+					Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Season] { return l.seasons }, func(r *model.Race) uuid.UUID { return r.SeasonID }),
+				},
+				"circuit": {
+					Type:    t.circuit,
+					Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Circuit] { return l.circuits }, func(r *model.Race) uuid.UUID { return r.CircuitID }),
+				},
+				"results": {
+					Type:    graphql.NewNonNull(t.connectionOf(t.result)),
+					Args:    pageArgs,
+					Resolve: list(maxPageSize, func(l *loaders) *loader[uuid.UUID, []*model.Result] { return l.raceResults }, func(r *model.Race) uuid.UUID { return r.ID }),
+				},
+			}
+		}),
+	})
+
+	t.result = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Result",
+		Fields: graphql.Fields{
+			"id":           {Type: graphql.NewNonNull(graphql.ID)},
+			"number":       {Type: graphql.NewNonNull(graphql.Int)},
+			"grid":         {Type: graphql.NewNonNull(graphql.Int)},
+			"position":     {Type: graphql.Int},
+			"positionText": {Type: graphql.NewNonNull(graphql.String)},
+			"points":       {Type: graphql.NewNonNull(graphql.Float)},
+			"laps":         {Type: graphql.NewNonNull(graphql.Int)},
+			"time":         {Type: graphql.NewNonNull(graphql.String)},
+			"status":       {Type: graphql.NewNonNull(graphql.String)},
+			"race": {
+				Type:    t.race,
+				Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Race] { return l.races }, func(r *model.Result) uuid.UUID { return r.RaceID }),
+			},
+			"driver": {
+				Type:    t.driver,
+				Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Driver] { return l.drivers }, func(r *model.Result) uuid.UUID { return r.DriverID }),
+			},
+			"constructor": {
+				Type:    t.constructor,
+				Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Constructor] { return l.constructors }, func(r *model.Result) uuid.UUID { return r.ConstructorID }),
+			},
+		},
+	})
+
+	t.driverStanding = graphql.NewObject(graphql.ObjectConfig{
+		Name: "DriverStanding",
+		Fields: graphql.Fields{
+			"id":       {Type: graphql.NewNonNull(graphql.ID)},
+			"position": {Type: graphql.NewNonNull(graphql.Int)},
+			"points":   {Type: graphql.NewNonNull(graphql.Float)},
+			"wins":     {Type: graphql.NewNonNull(graphql.Int)},
+			"season": {
+				Type:    t.season,
+				Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Season] { return l.seasons }, func(s *model.DriverStanding) uuid.UUID { return s.SeasonID }),
+			},
+			"driver": {
+				Type:    t.driver,
+				Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Driver] { return l.drivers }, func(s *model.DriverStanding) uuid.UUID { return s.DriverID }),
+			},
+		},
+	})
+
+	t.constructorStanding = graphql.NewObject(graphql.ObjectConfig{
+		Name: "ConstructorStanding",
+		Fields: graphql.Fields{
+			"id":       {Type: graphql.NewNonNull(graphql.ID)},
+			"position": {Type: graphql.NewNonNull(graphql.Int)},
+			"points":   {Type: graphql.NewNonNull(graphql.Float)},
+			"wins":     {Type: graphql.NewNonNull(graphql.Int)},
+			"season": {
+				Type:    t.season,
+				Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Season] { return l.seasons }, func(s *model.ConstructorStanding) uuid.UUID { return s.SeasonID }),
+			},
+			"constructor": {
+				Type:    t.constructor,
+				Resolve: related(func(l *loaders) *loader[uuid.UUID, *model.Constructor] { return l.constructors }, func(s *model.ConstructorStanding) uuid.UUID { return s.ConstructorID }),
+			},
+		},
+	})
+
+	return t
+}
+
+// connectionOf returns the connection type of lists of node.
+func (t *objectTypes) connectionOf(node *graphql.Object) *graphql.Object {
+	if c, ok := t.connections[node.Name()]; ok {
+		return c
+	}
+	c := connectionOf(node)
+	t.connections[node.Name()] = c
+	return c
+}
+
+// related resolves a field holding the record the source refers to by key,
+// null when there is none.
+func related[S, T any](loaderOf func(*loaders) *loader[uuid.UUID, *T], key func(S) uuid.UUID) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		return byKey(loaderOf(loadersFrom(p.Context)), key(p.Source.(S))), nil
+	}
+}
+
+// list resolves a connection field holding the records that belong to the
+// source, by key, up to maxPageSize at a time.
+func list[S, T any](maxPageSize int, loaderOf func(*loaders) *loader[uuid.UUID, []T], key func(S) uuid.UUID) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		page, err := pageOf(p.Args, maxPageSize)
+		if err != nil {
+			return nil, err
+		}
+		thunk := loaderOf(loadersFrom(p.Context)).load(key(p.Source.(S)))
+		return func() (interface{}, error) {
+			records, err := thunk()
+			if err != nil {
+				return nil, err
+			}
+			return listConnection(records, page), nil
+		}, nil
+	}
+}
+
+// seasonRace resolves one round of a season, from the season's races.
+func seasonRace(p graphql.ResolveParams) (interface{}, error) {
+	round, _ := p.Args["round"].(int)
+	thunk := loadersFrom(p.Context).seasonRaces.load(p.Source.(*model.Season).ID)
+	return func() (interface{}, error) {
+		races, err := thunk()
+		if err != nil {
+			return nil, err
+		}
+		for _, race := range races {
+			if race.Round == round {
+				return race, nil
+			}
+		}
+		return nil, nil
+	}, nil
+}
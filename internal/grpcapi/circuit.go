@@ -0,0 +1,161 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/service"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type circuitServer struct {
+	f1v1.UnimplementedCircuitServiceServer
+	service     service.CircuitService
+	history     *historyServer
+	maxPageSize int
+}
+
+func (s *circuitServer) CreateCircuit(ctx context.Context, req *f1v1.Circuit) (*f1v1.Circuit, error) {
+	circuit, err := circuitFromPB(req)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	created, err := s.service.CreateCircuit(ctx, circuit)
+	if err != nil {
+		return nil, statusError(ctx, "CreateCircuit", err, "Failed to create circuit")
+	}
+	return circuitToPB(created), nil
+}
+
+func (s *circuitServer) GetCircuit(ctx context.Context, req *f1v1.GetRequest) (*f1v1.Circuit, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveCircuitRef)
+	if err != nil {
+		return nil, statusError(ctx, "GetCircuit", err, "Failed to get circuit")
+	}
+
+	circuit, err := s.service.GetCircuitByID(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, "GetCircuit", err, "Failed to get circuit")
+	}
+	if circuit.ID == (uuid.UUID{}) {
+		return nil, notFound("Circuit")
+	}
+	return circuitToPB(circuit), nil
+}
+
+func (s *circuitServer) ListCircuits(ctx context.Context, req *f1v1.ListCircuitsRequest) (*f1v1.ListCircuitsResponse, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	page, limit := pagination(req.GetPage(), req.GetLimit(), s.maxPageSize)
+
+	var circuits []model.Circuit
+	switch filter := req.GetFilter().(type) {
+	case *f1v1.ListCircuitsRequest_Name:
+		circuits, err = s.service.GetCircuitByName(ctx, filter.Name, page, limit)
+	case *f1v1.ListCircuitsRequest_Location:
+		circuits, err = s.service.GetCircuitByLocation(ctx, filter.Location, page, limit)
+	case *f1v1.ListCircuitsRequest_Country:
+		circuits, err = s.service.GetCircuitByCountry(ctx, filter.Country, page, limit)
+	case *f1v1.ListCircuitsRequest_Current:
+		circuits, err = s.service.GetCircuitByCurrent(ctx, filter.Current)
+	default:
+		circuits, err = s.service.GetAllCircuits(ctx, page, limit)
+	}
+	if err != nil {
+		return nil, statusError(ctx, "ListCircuits", err, "Failed to fetch circuits")
+	}
+	return &f1v1.ListCircuitsResponse{Circuits: toPB(circuits, circuitToPB)}, nil
+}
+
+func (s *circuitServer) StreamCircuits(req *f1v1.StreamCircuitsRequest, stream f1v1.CircuitService_StreamCircuitsServer) error {
+	ctx, err := readContext(stream.Context(), req.GetIncludeDeleted())
+	if err != nil {
+		return err
+	}
+	filter := model.CircuitFilter{
+		Name:     req.GetName(),
+		Location: req.GetLocation(),
+		Country:  req.GetCountry(),
+		Current:  req.Current,
+		Ref:      req.GetRef(),
+		URL:      req.GetUrl(),
+	}
+
+	for circuit, err := range s.service.StreamCircuits(ctx, filter) {
+		if err != nil {
+			return statusError(ctx, "StreamCircuits", err, "Failed to fetch circuits")
+		}
+		if err := stream.Send(circuitToPB(circuit)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *circuitServer) UpdateCircuit(ctx context.Context, req *f1v1.UpdateCircuitRequest) (*f1v1.Circuit, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveCircuitRef)
+	if err != nil {
+		return nil, statusError(ctx, "UpdateCircuit", err, "Failed to update circuit")
+	}
+	circuit, err := circuitFromPB(req.GetCircuit())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	updated, err := s.service.UpdateCircuit(ctx, id, circuit)
+	if err != nil {
+		return nil, statusError(ctx, "UpdateCircuit", err, "Failed to update circuit")
+	}
+	return circuitToPB(updated), nil
+}
+
+func (s *circuitServer) DeleteCircuit(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "DeleteCircuit", req.GetId(), s.service.ResolveCircuitRef, s.service.DeleteCircuit, "Failed to delete circuit")
+}
+
+func (s *circuitServer) RestoreCircuit(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "RestoreCircuit", req.GetId(), s.service.ResolveCircuitRef, s.service.RestoreCircuit, "Failed to restore circuit")
+}
+
+func (s *circuitServer) PurgeCircuit(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "PurgeCircuit", req.GetId(), s.service.ResolveCircuitRef, s.service.PurgeCircuit, "Failed to purge circuit")
+}
+
+func (s *circuitServer) BulkUpsertCircuits(ctx context.Context, req *f1v1.BulkUpsertCircuitsRequest) (*f1v1.BulkResult, error) {
+	circuits, err := bulkItems(req.GetCircuits(), circuitFromPB)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	mode, err := bulkModeFromPB(req.GetMode())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	result, err := s.service.BulkUpsertCircuits(ctx, circuits, mode)
+	return bulkResult(ctx, "BulkUpsertCircuits", result, err, "Failed to upsert circuits")
+}
+
+func (s *circuitServer) GetCircuitHistory(ctx context.Context, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveCircuitRef)
+	if err != nil {
+		return nil, statusError(ctx, "GetCircuitHistory", err, "Failed to get history")
+	}
+	return s.history.history(ctx, "GetCircuitHistory", "circuits", id, req)
+}
+
+func (s *circuitServer) RevertCircuit(ctx context.Context, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveCircuitRef)
+	if err != nil {
+		return nil, statusError(ctx, "RevertCircuit", err, "Failed to revert")
+	}
+	return s.history.revert(ctx, "RevertCircuit", "circuits", id, req)
+}
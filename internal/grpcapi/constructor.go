@@ -0,0 +1,154 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/service"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type constructorServer struct {
+	f1v1.UnimplementedConstructorServiceServer
+	service     service.ConstructorService
+	history     *historyServer
+	maxPageSize int
+}
+
+func (s *constructorServer) CreateConstructor(ctx context.Context, req *f1v1.Constructor) (*f1v1.Constructor, error) {
+	constructor, err := constructorFromPB(req)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	created, err := s.service.CreateConstructor(ctx, constructor)
+	if err != nil {
+		return nil, statusError(ctx, "CreateConstructor", err, "Failed to create constructor")
+	}
+	return constructorToPB(created), nil
+}
+
+func (s *constructorServer) GetConstructor(ctx context.Context, req *f1v1.GetRequest) (*f1v1.Constructor, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveConstructorRef)
+	if err != nil {
+		return nil, statusError(ctx, "GetConstructor", err, "Failed to get constructor")
+	}
+
+	constructor, err := s.service.GetConstructorByID(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, "GetConstructor", err, "Failed to get constructor")
+	}
+	if constructor.ID == (uuid.UUID{}) {
+		return nil, notFound("Constructor")
+	}
+	return constructorToPB(constructor), nil
+}
+
+func (s *constructorServer) ListConstructors(ctx context.Context, req *f1v1.ListConstructorsRequest) (*f1v1.ListConstructorsResponse, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	page, limit := pagination(req.GetPage(), req.GetLimit(), s.maxPageSize)
+
+	var constructors []model.Constructor
+	switch filter := req.GetFilter().(type) {
+	case *f1v1.ListConstructorsRequest_Name:
+		constructors, err = s.service.GetConstructorByName(ctx, filter.Name, page, limit)
+	case *f1v1.ListConstructorsRequest_Nationality:
+		constructors, err = s.service.GetConstructorByNationality(ctx, filter.Nationality, page, limit)
+	default:
+		constructors, err = s.service.GetAllConstructors(ctx, page, limit)
+	}
+	if err != nil {
+		return nil, statusError(ctx, "ListConstructors", err, "Failed to fetch constructors")
+	}
+	return &f1v1.ListConstructorsResponse{Constructors: toPB(constructors, constructorToPB)}, nil
+}
+
+func (s *constructorServer) StreamConstructors(req *f1v1.StreamConstructorsRequest, stream f1v1.ConstructorService_StreamConstructorsServer) error {
+	ctx, err := readContext(stream.Context(), req.GetIncludeDeleted())
+	if err != nil {
+		return err
+	}
+	filter := model.ConstructorFilter{
+		Name:        req.GetName(),
+		Nationality: req.GetNationality(),
+		Ref:         req.GetRef(),
+	}
+
+	for constructor, err := range s.service.StreamConstructors(ctx, filter) {
+		if err != nil {
+			return statusError(ctx, "StreamConstructors", err, "Failed to fetch constructors")
+		}
+		if err := stream.Send(constructorToPB(constructor)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *constructorServer) UpdateConstructor(ctx context.Context, req *f1v1.UpdateConstructorRequest) (*f1v1.Constructor, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveConstructorRef)
+	if err != nil {
+		return nil, statusError(ctx, "UpdateConstructor", err, "Failed to update constructor")
+	}
+	constructor, err := constructorFromPB(req.GetConstructor())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	updated, err := s.service.UpdateConstructor(ctx, id, constructor)
+	if err != nil {
+		return nil, statusError(ctx, "UpdateConstructor", err, "Failed to update constructor")
+	}
+	return constructorToPB(updated), nil
+}
+
+func (s *constructorServer) DeleteConstructor(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "DeleteConstructor", req.GetId(), s.service.ResolveConstructorRef, s.service.DeleteConstructor, "Failed to delete constructor")
+}
+
+func (s *constructorServer) RestoreConstructor(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "RestoreConstructor", req.GetId(), s.service.ResolveConstructorRef, s.service.RestoreConstructor, "Failed to restore constructor")
+}
+
+func (s *constructorServer) PurgeConstructor(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "PurgeConstructor", req.GetId(), s.service.ResolveConstructorRef, s.service.PurgeConstructor, "Failed to purge constructor")
+}
+
+func (s *constructorServer) BulkUpsertConstructors(ctx context.Context, req *f1v1.BulkUpsertConstructorsRequest) (*f1v1.BulkResult, error) {
+	constructors, err := bulkItems(req.GetConstructors(), constructorFromPB)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	mode, err := bulkModeFromPB(req.GetMode())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	result, err := s.service.BulkUpsertConstructors(ctx, constructors, mode)
+	return bulkResult(ctx, "BulkUpsertConstructors", result, err, "Failed to upsert constructors")
+}
+
+func (s *constructorServer) GetConstructorHistory(ctx context.Context, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveConstructorRef)
+	if err != nil {
+		return nil, statusError(ctx, "GetConstructorHistory", err, "Failed to get history")
+	}
+	return s.history.history(ctx, "GetConstructorHistory", "constructors", id, req)
+}
+
+func (s *constructorServer) RevertConstructor(ctx context.Context, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveConstructorRef)
+	if err != nil {
+		return nil, statusError(ctx, "RevertConstructor", err, "Failed to revert")
+	}
+	return s.history.revert(ctx, "RevertConstructor", "constructors", id, req)
+}
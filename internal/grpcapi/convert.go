@@ -0,0 +1,413 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The converters map the model structs to their messages and back. Messages
+// coming in may leave IDs empty, which is the zero UUID.
+
+func constructorToPB(c model.Constructor) *f1v1.Constructor {
+	return &f1v1.Constructor{
+		Id:          c.ID.String(),
+		Ref:         c.Ref,
+		Name:        c.Name,
+		Nationality: c.Nationality,
+		Url:         c.URL,
+		DeletedAt:   timestamp(c.DeletedAt),
+	}
+}
+
+func constructorFromPB(c *f1v1.Constructor) (model.Constructor, error) {
+	id, err := parseID("id", c.GetId())
+	if err != nil {
+		return model.Constructor{}, err
+	}
+	return model.Constructor{
+		ID:          id,
+		Ref:         c.GetRef(),
+		Name:        c.GetName(),
+		Nationality: c.GetNationality(),
+		URL:         c.GetUrl(),
+	}, nil
+}
+
+func driverToPB(d model.Driver) *f1v1.Driver {
+	return &f1v1.Driver{
+		Id:          d.ID.String(),
+		Constructor: d.Constructor,
+		Ref:         d.Ref,
+		Code:        d.Code,
+		Number:      int32Ptr(d.Number),
+		FirstName:   d.FirstName,
+		LastName:    d.LastName,
+		DateOfBirth: timestamppb.New(d.DateOfBirth),
+		Nationality: d.Nationality,
+		Status:      d.Status,
+		Url:         d.URL,
+		DeletedAt:   timestamp(d.DeletedAt),
+	}
+}
+
+func driverFromPB(d *f1v1.Driver) (model.Driver, error) {
+	id, err := parseID("id", d.GetId())
+	if err != nil {
+		return model.Driver{}, err
+	}
+	return model.Driver{
+		ID:          id,
+		Constructor: d.GetConstructor(),
+		Ref:         d.GetRef(),
+		Code:        d.Code,
+		Number:      intPtr(d.Number),
+		FirstName:   d.GetFirstName(),
+		LastName:    d.GetLastName(),
+		DateOfBirth: timeOf(d.GetDateOfBirth()),
+		Nationality: d.GetNationality(),
+		Status:      d.GetStatus(),
+		URL:         d.GetUrl(),
+	}, nil
+}
+
+func circuitToPB(c model.Circuit) *f1v1.Circuit {
+	return &f1v1.Circuit{
+		Id:        c.ID.String(),
+		Ref:       c.Ref,
+		Name:      c.Name,
+		Location:  c.Location,
+		Country:   c.Country,
+		Current:   c.Current,
+		Url:       c.URL,
+		DeletedAt: timestamp(c.DeletedAt),
+	}
+}
+
+func circuitFromPB(c *f1v1.Circuit) (model.Circuit, error) {
+	id, err := parseID("id", c.GetId())
+	if err != nil {
+		return model.Circuit{}, err
+	}
+	return model.Circuit{
+		ID:       id,
+		Ref:      c.GetRef(),
+		Name:     c.GetName(),
+		Location: c.GetLocation(),
+		Country:  c.GetCountry(),
+		Current:  c.GetCurrent(),
+		URL:      c.GetUrl(),
+	}, nil
+}
+
+func seasonFromPB(s *f1v1.Season) (model.Season, error) {
+	id, err := parseID("id", s.GetId())
+	if err != nil {
+		return model.Season{}, err
+	}
+	return model.Season{ID: id, Year: int(s.GetYear()), URL: s.GetUrl()}, nil
+}
+
+func raceToPB(r *model.Race) *f1v1.Race {
+	return &f1v1.Race{
+		Id:        r.ID.String(),
+		SeasonId:  r.SeasonID.String(),
+		CircuitId: r.CircuitID.String(),
+		Round:     int32(r.Round),
+		Name:      r.Name,
+		Date:      timestamppb.New(r.Date),
+		Url:       r.URL,
+		DeletedAt: timestamp(r.DeletedAt),
+	}
+}
+
+func raceFromPB(r *f1v1.Race) (model.Race, error) {
+	ids, err := parseIDs(map[string]string{"id": r.GetId(), "season_id": r.GetSeasonId(), "circuit_id": r.GetCircuitId()})
+	if err != nil {
+		return model.Race{}, err
+	}
+	return model.Race{
+		ID:        ids["id"],
+		SeasonID:  ids["season_id"],
+		CircuitID: ids["circuit_id"],
+		Round:     int(r.GetRound()),
+		Name:      r.GetName(),
+		Date:      timeOf(r.GetDate()),
+		URL:       r.GetUrl(),
+	}, nil
+}
+
+func resultToPB(r *model.Result) *f1v1.Result {
+	return &f1v1.Result{
+		Id:            r.ID.String(),
+		RaceId:        r.RaceID.String(),
+		DriverId:      r.DriverID.String(),
+		ConstructorId: r.ConstructorID.String(),
+		Number:        int32(r.Number),
+		Grid:          int32(r.Grid),
+		Position:      int32Ptr(r.Position),
+		PositionText:  r.PositionText,
+		Points:        r.Points,
+		Laps:          int32(r.Laps),
+		Time:          r.Time,
+		Status:        r.Status,
+		DeletedAt:     timestamp(r.DeletedAt),
+	}
+}
+
+func resultFromPB(r *f1v1.Result) (model.Result, error) {
+	ids, err := parseIDs(map[string]string{"id": r.GetId(), "race_id": r.GetRaceId(), "driver_id": r.GetDriverId(), "constructor_id": r.GetConstructorId()})
+	if err != nil {
+		return model.Result{}, err
+	}
+	return model.Result{
+		ID:            ids["id"],
+		RaceID:        ids["race_id"],
+		DriverID:      ids["driver_id"],
+		ConstructorID: ids["constructor_id"],
+		Number:        int(r.GetNumber()),
+		Grid:          int(r.GetGrid()),
+		Position:      intPtr(r.Position),
+		PositionText:  r.GetPositionText(),
+		Points:        r.GetPoints(),
+		Laps:          int(r.GetLaps()),
+		Time:          r.GetTime(),
+		Status:        r.GetStatus(),
+	}, nil
+}
+
+func driverStandingToPB(s *model.DriverStanding) *f1v1.DriverStanding {
+	return &f1v1.DriverStanding{
+		Id:        s.ID.String(),
+		SeasonId:  s.SeasonID.String(),
+		DriverId:  s.DriverID.String(),
+		Position:  int32(s.Position),
+		Points:    s.Points,
+		Wins:      int32(s.Wins),
+		DeletedAt: timestamp(s.DeletedAt),
+	}
+}
+
+func driverStandingFromPB(s *f1v1.DriverStanding) (model.DriverStanding, error) {
+	ids, err := parseIDs(map[string]string{"id": s.GetId(), "season_id": s.GetSeasonId(), "driver_id": s.GetDriverId()})
+	if err != nil {
+		return model.DriverStanding{}, err
+	}
+	return model.DriverStanding{
+		ID:       ids["id"],
+		SeasonID: ids["season_id"],
+		DriverID: ids["driver_id"],
+		Position: int(s.GetPosition()),
+		Points:   s.GetPoints(),
+		Wins:     int(s.GetWins()),
+	}, nil
+}
+
+func constructorStandingToPB(s *model.ConstructorStanding) *f1v1.ConstructorStanding {
+	return &f1v1.ConstructorStanding{
+		Id:            s.ID.String(),
+		SeasonId:      s.SeasonID.String(),
+		ConstructorId: s.ConstructorID.String(),
+		Position:      int32(s.Position),
+		Points:        s.Points,
+		Wins:          int32(s.Wins),
+		DeletedAt:     timestamp(s.DeletedAt),
+	}
+}
+
+func constructorStandingFromPB(s *f1v1.ConstructorStanding) (model.ConstructorStanding, error) {
+	ids, err := parseIDs(map[string]string{"id": s.GetId(), "season_id": s.GetSeasonId(), "constructor_id": s.GetConstructorId()})
+	if err != nil {
+		return model.ConstructorStanding{}, err
+	}
+	return model.ConstructorStanding{
+		ID:            ids["id"],
+		SeasonID:      ids["season_id"],
+		ConstructorID: ids["constructor_id"],
+		Position:      int(s.GetPosition()),
+		Points:        s.GetPoints(),
+		Wins:          int(s.GetWins()),
+	}, nil
+}
+
+func auditEntryToPB(e model.AuditEntry) (*f1v1.AuditEntry, error) {
+	entry := &f1v1.AuditEntry{
+		Id:         e.ID,
+		EntityType: e.EntityType,
+		EntityId:   e.EntityID.String(),
+		Action:     string(e.Action),
+		Actor:      e.Actor,
+		RequestId:  e.RequestID,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+	var err error
+	if entry.Before, err = structOf(e.Before); err != nil {
+		return nil, err
+	}
+	if entry.After, err = structOf(e.After); err != nil {
+		return nil, err
+	}
+	if entry.Changes, err = structOf(e.Changes); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+var bulkModes = map[f1v1.BulkMode]model.BulkMode{
+	f1v1.BulkMode_BULK_MODE_UNSPECIFIED: model.BulkModeAtomic,
+	f1v1.BulkMode_BULK_MODE_ATOMIC:      model.BulkModeAtomic,
+	f1v1.BulkMode_BULK_MODE_BEST_EFFORT: model.BulkModeBestEffort,
+}
+
+func bulkModeFromPB(mode f1v1.BulkMode) (model.BulkMode, error) {
+	m, ok := bulkModes[mode]
+	if !ok {
+		return "", fmt.Errorf("invalid mode %v", mode)
+	}
+	return m, nil
+}
+
+func bulkResultToPB(r model.BulkResult) (*f1v1.BulkResult, error) {
+	result := &f1v1.BulkResult{
+		Mode:    f1v1.BulkMode_BULK_MODE_ATOMIC,
+		Created: int32(r.Created),
+		Updated: int32(r.Updated),
+		Failed:  int32(r.Failed),
+		Items:   make([]*f1v1.BulkItemResult, len(r.Items)),
+	}
+	if r.Mode == model.BulkModeBestEffort {
+		result.Mode = f1v1.BulkMode_BULK_MODE_BEST_EFFORT
+	}
+	for i, item := range r.Items {
+		result.Items[i] = &f1v1.BulkItemResult{
+			Index:  int32(item.Index),
+			Status: string(item.Status),
+			Error:  item.Error,
+		}
+		if item.Data == nil {
+			continue
+		}
+		data, err := json.Marshal(item.Data)
+		if err != nil {
+			return nil, err
+		}
+		if result.Items[i].Data, err = structOf(data); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// structOf converts a JSON object to a Struct. JSON null is nil.
+func structOf(data json.RawMessage) (*structpb.Struct, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// maxBulkItems matches the limit of the REST bulk endpoints.
+const maxBulkItems = 1000
+
+// bulkItems converts the messages of a bulk request, naming the first one
+// that does not convert.
+func bulkItems[M any, T any](messages []M, convert func(M) (T, error)) ([]T, error) {
+	if len(messages) == 0 {
+		return nil, errors.New("no items to process")
+	}
+	if len(messages) > maxBulkItems {
+		return nil, fmt.Errorf("too many items, the limit is %d", maxBulkItems)
+	}
+
+	items := make([]T, len(messages))
+	for i, m := range messages {
+		item, err := convert(m)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+func toPB[T any, M any](items []T, convert func(T) M) []M {
+	messages := make([]M, len(items))
+	for i, item := range items {
+		messages[i] = convert(item)
+	}
+	return messages
+}
+
+func parseID(field, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.UUID{}, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("invalid %s %q", field, value)
+	}
+	return id, nil
+}
+
+func parseIDs(values map[string]string) (map[string]uuid.UUID, error) {
+	ids := make(map[string]uuid.UUID, len(values))
+	for field, value := range values {
+		id, err := parseID(field, value)
+		if err != nil {
+			return nil, err
+		}
+		ids[field] = id
+	}
+	return ids, nil
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timeOf(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func int32Ptr(n *int) *int32 {
+	if n == nil {
+		return nil
+	}
+	v := int32(*n)
+	return &v
+}
+
+func intPtr(n *int32) *int {
+	if n == nil {
+		return nil
+	}
+	v := int(*n)
+	return &v
+}
+
+// pointers adapts converted items for services that work on pointers.
+func pointers[T any](items []T) []*T {
+	ptrs := make([]*T, len(items))
+	for i := range items {
+		ptrs[i] = &items[i]
+	}
+	return ptrs
+}
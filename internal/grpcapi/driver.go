@@ -0,0 +1,185 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/service"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type driverServer struct {
+	f1v1.UnimplementedDriverServiceServer
+	service     service.DriverService
+	history     *historyServer
+	maxPageSize int
+}
+
+func (s *driverServer) CreateDriver(ctx context.Context, req *f1v1.Driver) (*f1v1.Driver, error) {
+	driver, err := driverFromPB(req)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	created, err := s.service.CreateDriver(ctx, driver)
+	if err != nil {
+		return nil, statusError(ctx, "CreateDriver", err, "Failed to create driver")
+	}
+	return driverToPB(created), nil
+}
+
+func (s *driverServer) GetDriver(ctx context.Context, req *f1v1.GetRequest) (*f1v1.Driver, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveDriverRef)
+	if err != nil {
+		return nil, statusError(ctx, "GetDriver", err, "Failed to get driver")
+	}
+
+	driver, err := s.service.GetDriverByID(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, "GetDriver", err, "Failed to get driver")
+	}
+	if driver.ID == (uuid.UUID{}) {
+		return nil, notFound("Driver")
+	}
+	return driverToPB(driver), nil
+}
+
+func (s *driverServer) ListDrivers(ctx context.Context, req *f1v1.ListDriversRequest) (*f1v1.ListDriversResponse, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	page, limit := pagination(req.GetPage(), req.GetLimit(), s.maxPageSize)
+
+	var drivers []model.Driver
+	switch filter := req.GetFilter().(type) {
+	case *f1v1.ListDriversRequest_FirstName:
+		drivers, err = s.service.GetDriverByFirstName(ctx, filter.FirstName, page, limit)
+	case *f1v1.ListDriversRequest_LastName:
+		drivers, err = s.service.GetDriverByLastName(ctx, filter.LastName, page, limit)
+	case *f1v1.ListDriversRequest_Team:
+		drivers, err = s.service.GetDriverByTeam(ctx, filter.Team, page, limit)
+	case *f1v1.ListDriversRequest_Nationality:
+		drivers, err = s.service.GetDriverByNationality(ctx, filter.Nationality, page, limit)
+	case *f1v1.ListDriversRequest_Status:
+		drivers, err = s.service.GetDriverByStatus(ctx, filter.Status, page, limit)
+	default:
+		drivers, err = s.service.GetAllDrivers(ctx, page, limit)
+	}
+	if err != nil {
+		return nil, statusError(ctx, "ListDrivers", err, "Failed to fetch drivers")
+	}
+	return &f1v1.ListDriversResponse{Drivers: toPB(drivers, driverToPB)}, nil
+}
+
+func (s *driverServer) StreamDrivers(req *f1v1.StreamDriversRequest, stream f1v1.DriverService_StreamDriversServer) error {
+	ctx, err := readContext(stream.Context(), req.GetIncludeDeleted())
+	if err != nil {
+		return err
+	}
+	filter := model.DriverFilter{
+		FirstName:   req.GetFirstName(),
+		LastName:    req.GetLastName(),
+		Team:        req.GetTeam(),
+		Nationality: req.GetNationality(),
+		Status:      req.GetStatus(),
+		Ref:         req.GetRef(),
+		Code:        req.GetCode(),
+		Number:      intPtr(req.Number),
+		URL:         req.GetUrl(),
+	}
+
+	for driver, err := range s.service.StreamDrivers(ctx, filter) {
+		if err != nil {
+			return statusError(ctx, "StreamDrivers", err, "Failed to fetch drivers")
+		}
+		if err := stream.Send(driverToPB(driver)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *driverServer) UpdateDriver(ctx context.Context, req *f1v1.UpdateDriverRequest) (*f1v1.Driver, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveDriverRef)
+	if err != nil {
+		return nil, statusError(ctx, "UpdateDriver", err, "Failed to update driver")
+	}
+	driver, err := driverFromPB(req.GetDriver())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	updated, err := s.service.UpdateDriver(ctx, id, driver)
+	if err != nil {
+		return nil, statusError(ctx, "UpdateDriver", err, "Failed to update driver")
+	}
+	return driverToPB(updated), nil
+}
+
+func (s *driverServer) DeleteDriver(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "DeleteDriver", req.GetId(), s.service.ResolveDriverRef, s.service.DeleteDriver, "Failed to delete driver")
+}
+
+func (s *driverServer) RestoreDriver(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "RestoreDriver", req.GetId(), s.service.ResolveDriverRef, s.service.RestoreDriver, "Failed to restore driver")
+}
+
+func (s *driverServer) PurgeDriver(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "PurgeDriver", req.GetId(), s.service.ResolveDriverRef, s.service.PurgeDriver, "Failed to purge driver")
+}
+
+func (s *driverServer) MergeDrivers(ctx context.Context, req *f1v1.MergeDriversRequest) (*f1v1.Driver, error) {
+	sourceID, err := resolveID(ctx, req.GetId(), s.service.ResolveDriverRef)
+	if err != nil {
+		return nil, statusError(ctx, "MergeDrivers", err, "Failed to merge drivers")
+	}
+	targetID, err := uuid.Parse(req.GetInto())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid request: invalid into")
+	}
+
+	driver, err := s.service.MergeDrivers(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, statusError(ctx, "MergeDrivers", err, "Failed to merge drivers")
+	}
+	return driverToPB(driver), nil
+}
+
+func (s *driverServer) BulkUpsertDrivers(ctx context.Context, req *f1v1.BulkUpsertDriversRequest) (*f1v1.BulkResult, error) {
+	drivers, err := bulkItems(req.GetDrivers(), driverFromPB)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	mode, err := bulkModeFromPB(req.GetMode())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	result, err := s.service.BulkUpsertDrivers(ctx, drivers, mode)
+	return bulkResult(ctx, "BulkUpsertDrivers", result, err, "Failed to upsert drivers")
+}
+
+func (s *driverServer) GetDriverHistory(ctx context.Context, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveDriverRef)
+	if err != nil {
+		return nil, statusError(ctx, "GetDriverHistory", err, "Failed to get history")
+	}
+	return s.history.history(ctx, "GetDriverHistory", "drivers", id, req)
+}
+
+func (s *driverServer) RevertDriver(ctx context.Context, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), s.service.ResolveDriverRef)
+	if err != nil {
+		return nil, statusError(ctx, "RevertDriver", err, "Failed to revert")
+	}
+	return s.history.revert(ctx, "RevertDriver", "drivers", id, req)
+}
@@ -0,0 +1,265 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"path"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Metadata keys are lower case in gRPC.
+	APIKeyMetadata     = "x-api-key"
+	RequestIDMetadata  = "x-request-id"
+	maxRequestIDLength = 128
+
+	// apiPrefix starts the full method names of the API's own services.
+	// Health checks and reflection skip authentication.
+	apiPrefix = "/f1.v1."
+)
+
+// interceptors give every call what the HTTP middleware gives a request: a
+// request ID, a server span, an authenticated principal with the scope the
+// method needs, an access log record and recovery from panics.
+type interceptors struct {
+	authenticator *auth.Authenticator
+	failures      *ratelimit.Failures
+}
+
+func (i *interceptors) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	err = i.serve(ctx, info.FullMethod, func(ctx context.Context) error {
+		resp, err = handler(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+func (i *interceptors) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return i.serve(ss.Context(), info.FullMethod, func(ctx context.Context) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	})
+}
+
+// serve runs call for method with the context it is served with.
+func (i *interceptors) serve(ctx context.Context, method string, call func(ctx context.Context) error) (err error) {
+	start := time.Now()
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := first(md, RequestIDMetadata)
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = uuid.NewString()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadata, requestID))
+	ctx = requestctx.WithRequestID(ctx, requestID)
+
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	ctx, span := tracing.Start(ctx, strings.TrimPrefix(method, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.RPCSystemNameGRPC,
+			semconv.RPCMethod(strings.TrimPrefix(method, "/")),
+			attribute.String("request.id", requestID),
+		),
+	)
+	defer span.End()
+
+	defer func() {
+		if v := recover(); v != nil {
+			slog.ErrorContext(ctx, "Panic serving call", "panic", fmt.Sprint(v), "stack", string(debug.Stack()))
+			err = status.Error(codes.Internal, "The server hit an unexpected error")
+		}
+
+		code := status.Code(err)
+		span.SetAttributes(semconv.RPCResponseStatusCode(code.String()))
+		level := slog.LevelInfo
+		if serverFault(code) {
+			span.SetStatus(otelcodes.Error, code.String())
+			level = slog.LevelError
+		}
+		var addr string
+		if p, ok := peer.FromContext(ctx); ok {
+			addr = p.Addr.String()
+		}
+		slog.Log(ctx, level, "call",
+			"method", method,
+			"code", code.String(),
+			"duration_ms", float64(time.Since(start).Microseconds())/1000,
+			"remote_addr", addr,
+			"user_agent", first(md, "user-agent"),
+		)
+	}()
+
+	if strings.HasPrefix(method, apiPrefix) {
+		if ctx, err = i.authenticate(ctx, md); err != nil {
+			return err
+		}
+		if err := authorize(ctx, methodScope(method)); err != nil {
+			return err
+		}
+	}
+	return call(ctx)
+}
+
+// authenticate stores the caller as the principal and actor of ctx, from an
+// API key in the x-api-key metadata or from an authorization metadata
+// "Bearer" value holding an API key or a signed JWT. Failed attempts count
+// against the peer IP as they do over HTTP.
+func (i *interceptors) authenticate(ctx context.Context, md metadata.MD) (context.Context, error) {
+	principal := i.authenticator.Anonymous()
+	if secret, bearer := credentials(md); secret != "" {
+		client := "ip:" + peerIP(ctx)
+		if retryAfter := i.failures.RetryAfter(client); retryAfter > 0 {
+			slog.WarnContext(ctx, "Authenticate error: Throttled failed authentications", "client", client)
+			return ctx, status.Errorf(codes.ResourceExhausted, "Too many failed authentications, retry in %ds", int(retryAfter.Seconds()))
+		}
+
+		p, err := i.authenticator.Authenticate(ctx, secret, bearer)
+		if err != nil {
+			switch {
+			case errors.Is(err, auth.ErrInvalidToken):
+				i.failures.Add(client)
+				slog.WarnContext(ctx, "Authenticate error: Rejected token", "client", client, "error", err)
+				return ctx, status.Error(codes.Unauthenticated, "Invalid token")
+			case errors.Is(err, service.ErrInvalidAPIKey):
+				i.failures.Add(client)
+				slog.WarnContext(ctx, "Authenticate error: Rejected API key", "client", client)
+				return ctx, status.Error(codes.Unauthenticated, "Invalid API key")
+			default:
+				slog.ErrorContext(ctx, "Authenticate error: Failed to check API key", "error", err)
+				return ctx, status.Error(codes.Internal, "Failed to authenticate")
+			}
+		}
+		principal = p
+	}
+
+	ctx = requestctx.WithPrincipal(ctx, principal)
+	return requestctx.WithActor(ctx, principal.Name), nil
+}
+
+// authorize fails calls whose principal lacks scope: with Unauthenticated
+// when the caller is anonymous, so it knows to authenticate, and
+// PermissionDenied otherwise.
+func authorize(ctx context.Context, scope model.Scope) error {
+	principal, ok := requestctx.Principal(ctx)
+	if ok && principal.HasScope(scope) {
+		return nil
+	}
+	if !ok || principal.Name == auth.AnonymousName {
+		return status.Error(codes.Unauthenticated, "Authentication required")
+	}
+	return status.Error(codes.PermissionDenied, "Missing scope: "+string(scope))
+}
+
+// methodScope returns the scope a method needs, from the verb its name
+// starts with, matching the scopes of the REST routes. Methods with other
+// verbs, such as Restore, Purge and Revert, need the admin scope.
+func methodScope(fullMethod string) model.Scope {
+	method := path.Base(fullMethod)
+	for _, verb := range []string{"Get", "List", "Stream"} {
+		if strings.HasPrefix(method, verb) {
+			return model.ScopeRead
+		}
+	}
+	for _, verb := range []string{"Create", "Update", "Delete", "Merge", "BulkUpsert"} {
+		if strings.HasPrefix(method, verb) {
+			return model.ScopeWrite
+		}
+	}
+	return model.ScopeAdmin
+}
+
+// credentials returns the API key or token of the call and whether it came
+// from the authorization metadata.
+func credentials(md metadata.MD) (string, bool) {
+	if key := first(md, APIKeyMetadata); key != "" {
+		return key, false
+	}
+	scheme, token, ok := strings.Cut(first(md, "authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token), true
+	}
+	return "", false
+}
+
+// serverFault reports whether code means the server failed, rather than the
+// call being refused.
+func serverFault(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.Unimplemented:
+		return true
+	}
+	return false
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// peerIP returns the IP address of the caller of ctx.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// serverStream replaces the context of a stream with the one it is served
+// with.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// metadataCarrier lets the trace context propagator read gRPC metadata.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	return first(metadata.MD(c), key)
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,155 @@
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/analytics"
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/metrics"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// limits give every call of the API's services what the HTTP middleware that
+// also runs for each operation of a batch gives a request: a count in usage
+// analytics, a deadline for the queries run for it and the rate limits and
+// daily quota of the caller's tier. They run after the interceptors that
+// authenticate the call.
+type limits struct {
+	limiter       *ratelimit.Limiter
+	usage         *analytics.Recorder
+	timeout       time.Duration
+	streamTimeout time.Duration
+}
+
+func (l *limits) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	err = l.serve(ctx, info.FullMethod, l.timeout, func(ctx context.Context) error {
+		resp, err = handler(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+func (l *limits) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return l.serve(ss.Context(), info.FullMethod, l.streamTimeout, func(ctx context.Context) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	})
+}
+
+// serve runs call for method with a deadline of timeout, none when it is 0,
+// once the caller's limits let it through.
+func (l *limits) serve(ctx context.Context, method string, timeout time.Duration, call func(ctx context.Context) error) error {
+	if !strings.HasPrefix(method, apiPrefix) {
+		return call(ctx)
+	}
+
+	start := time.Now()
+	err := l.limit(ctx, func() error {
+		if timeout <= 0 {
+			return call(ctx)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return call(ctx)
+	})
+
+	if l.usage != nil {
+		principal, _ := requestctx.Principal(ctx)
+		client := principal.Name
+		if client == "" {
+			client = auth.AnonymousName
+		}
+		l.usage.Record(client, "GRPC "+method, httpStatus(status.Code(err)), time.Since(start))
+	}
+	return err
+}
+
+// limit runs call if the caller has requests left. Authenticated callers are
+// limited per principal, anonymous ones per peer IP. The limits are sent in
+// the ratelimit-* and x-daily-quota-* header metadata, and refused calls fail
+// with ResourceExhausted and a retry-after. Calls are let through if the
+// quota store is unavailable.
+func (l *limits) limit(ctx context.Context, call func() error) error {
+	if l.limiter == nil {
+		return call()
+	}
+
+	principal, _ := requestctx.Principal(ctx)
+	client := principal.Name
+	if client == "" || client == auth.AnonymousName {
+		client = "ip:" + peerIP(ctx)
+	}
+
+	tier := l.limiter.Tier(principal.Tier)
+	decision, err := l.limiter.Allow(ctx, client, tier)
+	if err != nil {
+		slog.ErrorContext(ctx, "Rate limit error: Failed to check limits", "client", client, "error", err)
+		metrics.ObserveRateLimitFailOpen(tier.Name)
+		return call()
+	}
+
+	md := metadata.Pairs(
+		"ratelimit-limit", strconv.Itoa(decision.Limit),
+		"ratelimit-remaining", strconv.Itoa(decision.Remaining),
+		"ratelimit-reset", formatSeconds(decision.Reset),
+	)
+	if decision.QuotaLimit > 0 {
+		md.Set("x-daily-quota-limit", strconv.FormatInt(decision.QuotaLimit, 10))
+		md.Set("x-daily-quota-remaining", strconv.FormatInt(decision.QuotaRemaining, 10))
+	}
+
+	if !decision.Allowed {
+		md.Set("retry-after", formatSeconds(decision.RetryAfter))
+		grpc.SetHeader(ctx, md)
+		if decision.QuotaExceeded {
+			return status.Error(codes.ResourceExhausted, "Daily quota exceeded")
+		}
+		return status.Error(codes.ResourceExhausted, "Rate limit exceeded")
+	}
+	grpc.SetHeader(ctx, md)
+	return call()
+}
+
+func formatSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// httpStatus returns the HTTP status matching code, so that calls count in
+// usage analytics as the requests of the REST API do.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,97 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/service"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type raceServer struct {
+	f1v1.UnimplementedRaceServiceServer
+	service service.RaceService
+	results service.ResultService
+	history *historyServer
+}
+
+func (s *raceServer) CreateRace(ctx context.Context, req *f1v1.RaceWithResults) (*f1v1.RaceWithResults, error) {
+	race, err := raceFromPB(req.GetRace())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	results := make([]*model.Result, len(req.GetResults()))
+	for i, r := range req.GetResults() {
+		result, err := resultFromPB(r)
+		if err != nil {
+			return nil, invalidArgument(fmt.Errorf("result %d: %w", i, err))
+		}
+		results[i] = &result
+	}
+
+	if err := s.service.CreateRaceWithResults(ctx, &race, results); err != nil {
+		return nil, statusError(ctx, "CreateRace", err, "Failed to create race")
+	}
+	return &f1v1.RaceWithResults{Race: raceToPB(&race), Results: toPB(results, resultToPB)}, nil
+}
+
+func (s *raceServer) ListRaceResults(ctx context.Context, req *f1v1.GetRequest) (*f1v1.ListResultsResponse, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.results.GetRaceResults(ctx, id)
+	if err != nil {
+		return nil, statusError(ctx, "ListRaceResults", err, "Failed to get results")
+	}
+	return &f1v1.ListResultsResponse{Results: toPB(results, resultToPB)}, nil
+}
+
+func (s *raceServer) DeleteRace(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "DeleteRace", req.GetId(), nil, s.service.DeleteRace, "Failed to delete race")
+}
+
+func (s *raceServer) RestoreRace(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "RestoreRace", req.GetId(), nil, s.service.RestoreRace, "Failed to restore race")
+}
+
+func (s *raceServer) PurgeRace(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "PurgeRace", req.GetId(), nil, s.service.PurgeRace, "Failed to purge race")
+}
+
+func (s *raceServer) BulkUpsertRaces(ctx context.Context, req *f1v1.BulkUpsertRacesRequest) (*f1v1.BulkResult, error) {
+	races, err := bulkItems(req.GetRaces(), raceFromPB)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	mode, err := bulkModeFromPB(req.GetMode())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	result, err := s.service.BulkUpsertRaces(ctx, pointers(races), mode)
+	return bulkResult(ctx, "BulkUpsertRaces", result, err, "Failed to upsert races")
+}
+
+func (s *raceServer) GetRaceHistory(ctx context.Context, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.history.history(ctx, "GetRaceHistory", "races", id, req)
+}
+
+func (s *raceServer) RevertRace(ctx context.Context, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.history.revert(ctx, "RevertRace", "races", id, req)
+}
@@ -0,0 +1,57 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/service"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type resultServer struct {
+	f1v1.UnimplementedResultServiceServer
+	service service.ResultService
+	history *historyServer
+}
+
+func (s *resultServer) DeleteResult(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "DeleteResult", req.GetId(), nil, s.service.DeleteResult, "Failed to delete result")
+}
+
+func (s *resultServer) RestoreResult(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "RestoreResult", req.GetId(), nil, s.service.RestoreResult, "Failed to restore result")
+}
+
+func (s *resultServer) PurgeResult(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "PurgeResult", req.GetId(), nil, s.service.PurgeResult, "Failed to purge result")
+}
+
+func (s *resultServer) BulkUpsertResults(ctx context.Context, req *f1v1.BulkUpsertResultsRequest) (*f1v1.BulkResult, error) {
+	results, err := bulkItems(req.GetResults(), resultFromPB)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	mode, err := bulkModeFromPB(req.GetMode())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	result, err := s.service.BulkUpsertResults(ctx, pointers(results), mode)
+	return bulkResult(ctx, "BulkUpsertResults", result, err, "Failed to upsert results")
+}
+
+func (s *resultServer) GetResultHistory(ctx context.Context, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.history.history(ctx, "GetResultHistory", "results", id, req)
+}
+
+func (s *resultServer) RevertResult(ctx context.Context, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.history.revert(ctx, "RevertResult", "results", id, req)
+}
@@ -0,0 +1,110 @@
+package grpcapi
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/service"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type seasonServer struct {
+	f1v1.UnimplementedSeasonServiceServer
+	service service.SeasonService
+	races   service.RaceService
+	results service.ResultService
+	history *historyServer
+}
+
+func (s *seasonServer) ListSeasonRaces(ctx context.Context, req *f1v1.SeasonRequest) (*f1v1.ListRacesResponse, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	races, err := s.races.GetSeasonRaces(ctx, int(req.GetYear()))
+	if err != nil {
+		return nil, statusError(ctx, "ListSeasonRaces", err, "Failed to get races")
+	}
+	return &f1v1.ListRacesResponse{Races: toPB(races, raceToPB)}, nil
+}
+
+func (s *seasonServer) GetSeasonRace(ctx context.Context, req *f1v1.SeasonRaceRequest) (*f1v1.Race, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	race, err := s.races.GetSeasonRace(ctx, int(req.GetYear()), int(req.GetRound()))
+	if err != nil {
+		return nil, statusError(ctx, "GetSeasonRace", err, "Failed to get race")
+	}
+	return raceToPB(race), nil
+}
+
+func (s *seasonServer) ListSeasonRaceResults(ctx context.Context, req *f1v1.SeasonRaceRequest) (*f1v1.ListResultsResponse, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.results.GetSeasonRaceResults(ctx, int(req.GetYear()), int(req.GetRound()))
+	if err != nil {
+		return nil, statusError(ctx, "ListSeasonRaceResults", err, "Failed to get results")
+	}
+	return &f1v1.ListResultsResponse{Results: toPB(results, resultToPB)}, nil
+}
+
+func (s *seasonServer) DeleteSeason(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "DeleteSeason", req.GetId(), s.resolveYear, s.service.DeleteSeason, "Failed to delete season")
+}
+
+func (s *seasonServer) RestoreSeason(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "RestoreSeason", req.GetId(), s.resolveYear, s.service.RestoreSeason, "Failed to restore season")
+}
+
+func (s *seasonServer) PurgeSeason(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "PurgeSeason", req.GetId(), s.resolveYear, s.service.PurgeSeason, "Failed to purge season")
+}
+
+func (s *seasonServer) BulkUpsertSeasons(ctx context.Context, req *f1v1.BulkUpsertSeasonsRequest) (*f1v1.BulkResult, error) {
+	seasons, err := bulkItems(req.GetSeasons(), seasonFromPB)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	mode, err := bulkModeFromPB(req.GetMode())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	result, err := s.service.BulkUpsertSeasons(ctx, pointers(seasons), mode)
+	return bulkResult(ctx, "BulkUpsertSeasons", result, err, "Failed to upsert seasons")
+}
+
+func (s *seasonServer) GetSeasonHistory(ctx context.Context, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), s.resolveYear)
+	if err != nil {
+		return nil, statusError(ctx, "GetSeasonHistory", err, "Failed to get history")
+	}
+	return s.history.history(ctx, "GetSeasonHistory", "seasons", id, req)
+}
+
+func (s *seasonServer) RevertSeason(ctx context.Context, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), s.resolveYear)
+	if err != nil {
+		return nil, statusError(ctx, "RevertSeason", err, "Failed to revert")
+	}
+	return s.history.revert(ctx, "RevertSeason", "seasons", id, req)
+}
+
+// resolveYear returns the ID of the season held in the year ref.
+func (s *seasonServer) resolveYear(ctx context.Context, ref string) (uuid.UUID, error) {
+	year, err := strconv.Atoi(ref)
+	if err != nil {
+		return uuid.UUID{}, repository.ErrNotFound
+	}
+	return s.service.ResolveSeasonYear(ctx, year)
+}
@@ -0,0 +1,245 @@
+// Package grpcapi serves the API over gRPC, with the services defined in
+// proto/f1/v1. The servers call the same services as the REST handlers and
+// authenticate, authorize and limit calls the same way.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ChinmayNoob/f1/internal/analytics"
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/ChinmayNoob/f1/internal/utils"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Services are the services the gRPC servers call.
+type Services struct {
+	Constructors service.ConstructorService
+	Drivers      service.DriverService
+	Circuits     service.CircuitService
+	Seasons      service.SeasonService
+	Races        service.RaceService
+	Results      service.ResultService
+	Standings    service.StandingService
+	Audit        service.AuditService
+	Health       service.HealthService
+}
+
+// Options are the limits a server applies to the calls of the API's
+// services. Nil and zero fields leave a limit off, apart from MaxPageSize.
+type Options struct {
+	// MaxPageSize is the largest page a list call returns.
+	MaxPageSize int
+	// Failures throttles failed authentications.
+	Failures *ratelimit.Failures
+	// Limiter applies the rate limits and daily quota of the caller's tier.
+	Limiter *ratelimit.Limiter
+	// Usage counts calls in usage analytics.
+	Usage *analytics.Recorder
+	// Timeout is the deadline of unary calls and StreamTimeout the one of
+	// streaming calls, unless the client sets an earlier one.
+	Timeout       time.Duration
+	StreamTimeout time.Duration
+}
+
+// NewServer returns a gRPC server with the API's services, the standard
+// health service and server reflection registered, limited by opts.
+func NewServer(services Services, authenticator *auth.Authenticator, opts Options) *grpc.Server {
+	i := &interceptors{authenticator: authenticator, failures: opts.Failures}
+	l := &limits{limiter: opts.Limiter, usage: opts.Usage, timeout: opts.Timeout, streamTimeout: opts.StreamTimeout}
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(i.unary, l.unary),
+		grpc.ChainStreamInterceptor(i.stream, l.stream),
+	)
+
+	history := &historyServer{service: services.Audit, maxPageSize: opts.MaxPageSize}
+	f1v1.RegisterConstructorServiceServer(s, &constructorServer{service: services.Constructors, history: history, maxPageSize: opts.MaxPageSize})
+	f1v1.RegisterDriverServiceServer(s, &driverServer{service: services.Drivers, history: history, maxPageSize: opts.MaxPageSize})
+	f1v1.RegisterCircuitServiceServer(s, &circuitServer{service: services.Circuits, history: history, maxPageSize: opts.MaxPageSize})
+	f1v1.RegisterSeasonServiceServer(s, &seasonServer{service: services.Seasons, races: services.Races, results: services.Results, history: history})
+	f1v1.RegisterRaceServiceServer(s, &raceServer{service: services.Races, results: services.Results, history: history})
+	f1v1.RegisterResultServiceServer(s, &resultServer{service: services.Results, history: history})
+	f1v1.RegisterStandingServiceServer(s, &standingServer{service: services.Standings, history: history})
+
+	health := &healthServer{service: services.Health, services: map[string]bool{"": true}}
+	for name := range s.GetServiceInfo() {
+		health.services[name] = true
+	}
+	healthpb.RegisterHealthServer(s, health)
+	reflection.Register(s)
+	return s
+}
+
+// healthServer answers health checks with the readiness of the server,
+// which every service shares.
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+	service  service.HealthService
+	services map[string]bool
+}
+
+func (h *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !h.services[req.GetService()] {
+		return nil, status.Error(codes.NotFound, "Unknown service")
+	}
+	if h.service.Ready(ctx).Status != model.HealthReady {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+// statusErrors are the service errors a caller can act on, with the codes
+// they are reported with.
+var statusErrors = []struct {
+	err  error
+	code codes.Code
+}{
+	{repository.ErrNotFound, codes.NotFound},
+	{service.ErrDriverNotFound, codes.NotFound},
+	{repository.ErrConstructorNotFound, codes.InvalidArgument},
+	{service.ErrSelfMerge, codes.InvalidArgument},
+	{repository.ErrInUse, codes.FailedPrecondition},
+	{repository.ErrDriverResultsOverlap, codes.FailedPrecondition},
+	{service.ErrNoVersionState, codes.FailedPrecondition},
+	{repository.ErrVersionConflict, codes.Aborted},
+}
+
+// statusError turns an error of method into the status of the call. Errors
+// the caller can act on are reported as they are; the others are logged and
+// reported as message.
+func statusError(ctx context.Context, method string, err error, message string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	for _, e := range statusErrors {
+		if errors.Is(err, e.err) {
+			return status.Error(e.code, capitalize(e.err.Error()))
+		}
+	}
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	slog.ErrorContext(ctx, method+" error: "+message, "error", err)
+	return status.Error(codes.Internal, message)
+}
+
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, "Invalid request: "+err.Error())
+}
+
+func notFound(what string) error {
+	return status.Error(codes.NotFound, what+" not found")
+}
+
+// readContext widens ctx to soft-deleted rows when the caller asks for them
+// with include_deleted, which requires the admin scope.
+func readContext(ctx context.Context, includeDeleted bool) (context.Context, error) {
+	if !includeDeleted {
+		return ctx, nil
+	}
+	if err := authorize(ctx, model.ScopeAdmin); err != nil {
+		return ctx, err
+	}
+	return repository.WithDeleted(ctx), nil
+}
+
+// resolver returns the ID of the record named by ref, or
+// repository.ErrNotFound.
+type resolver func(ctx context.Context, ref string) (uuid.UUID, error)
+
+// resolveID parses id as a UUID or, when resolve is not nil, resolves it as
+// a ref.
+func resolveID(ctx context.Context, id string, resolve resolver) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err == nil {
+		return parsed, nil
+	}
+	if resolve == nil || id == "" {
+		return uuid.UUID{}, status.Error(codes.InvalidArgument, "Invalid ID format")
+	}
+	return resolve(ctx, id)
+}
+
+// apply runs op, such as a delete, on the record id names.
+func apply(ctx context.Context, method, id string, resolve resolver, op func(context.Context, uuid.UUID) error, message string) (*emptypb.Empty, error) {
+	recordID, err := resolveID(ctx, id, resolve)
+	if err == nil {
+		err = op(ctx, recordID)
+	}
+	if err != nil {
+		return nil, statusError(ctx, method, err, message)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// pagination applies the defaults and limits of the REST query parameters.
+func pagination(page, limit int32, maxPageSize int) (int, int) {
+	return utils.ParsePagination(strconv.Itoa(int(page)), strconv.Itoa(int(limit)), maxPageSize)
+}
+
+func bulkResult(ctx context.Context, method string, result model.BulkResult, err error, message string) (*f1v1.BulkResult, error) {
+	if err != nil {
+		return nil, statusError(ctx, method, err, message)
+	}
+	pb, err := bulkResultToPB(result)
+	if err != nil {
+		return nil, statusError(ctx, method, err, message)
+	}
+	return pb, nil
+}
+
+func capitalize(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
+
+// historyServer serves the change history of records, for every service.
+type historyServer struct {
+	service     service.AuditService
+	maxPageSize int
+}
+
+func (h *historyServer) history(ctx context.Context, method, entityType string, id uuid.UUID, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	page, limit := pagination(req.GetPage(), req.GetLimit(), h.maxPageSize)
+	entries, err := h.service.GetHistory(ctx, entityType, id, page, limit)
+	if err != nil {
+		return nil, statusError(ctx, method, err, "Failed to get history")
+	}
+
+	resp := &f1v1.HistoryResponse{Entries: make([]*f1v1.AuditEntry, len(entries))}
+	for i, entry := range entries {
+		if resp.Entries[i], err = auditEntryToPB(entry); err != nil {
+			return nil, statusError(ctx, method, err, "Failed to get history")
+		}
+	}
+	return resp, nil
+}
+
+func (h *historyServer) revert(ctx context.Context, method, entityType string, id uuid.UUID, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	state, err := h.service.RevertToVersion(ctx, entityType, id, req.GetVersion())
+	if err != nil {
+		return nil, statusError(ctx, method, err, "Failed to revert")
+	}
+	record, err := structOf(state)
+	if err != nil {
+		return nil, statusError(ctx, method, err, "Failed to revert")
+	}
+	return &f1v1.RevertResponse{Record: record}, nil
+}
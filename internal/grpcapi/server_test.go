@@ -0,0 +1,433 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/analytics"
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/service"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const driverID = "7d4c1f40-0000-4000-8000-000000000001"
+
+// apiKeys accepts the secrets "read", "write" and "admin", each holding the
+// scope it names.
+type apiKeys struct {
+	service.APIKeyService
+}
+
+func (apiKeys) Authenticate(_ context.Context, secret string) (model.APIKey, error) {
+	switch scope := model.Scope(secret); scope {
+	case model.ScopeRead, model.ScopeWrite, model.ScopeAdmin:
+		return model.APIKey{Name: secret, Scopes: []model.Scope{scope}}, nil
+	}
+	return model.APIKey{}, service.ErrInvalidAPIKey
+}
+
+// driverService fails deletes with err, blocks gets until their context is
+// done when block is set, and streams drivers until the context of the
+// stream is done, which it then reports on streamDone.
+type driverService struct {
+	service.DriverService
+	err        error
+	block      bool
+	streamDone chan error
+}
+
+func (s *driverService) GetDriverByID(ctx context.Context, id uuid.UUID) (model.Driver, error) {
+	if s.block {
+		<-ctx.Done()
+		return model.Driver{}, ctx.Err()
+	}
+	return model.Driver{ID: id, Ref: "hamilton"}, nil
+}
+
+func (s *driverService) DeleteDriver(context.Context, uuid.UUID) error {
+	return s.err
+}
+
+func (s *driverService) StreamDrivers(ctx context.Context, _ model.DriverFilter) iter.Seq2[model.Driver, error] {
+	return func(yield func(model.Driver, error) bool) {
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				s.streamDone <- ctx.Err()
+				yield(model.Driver{}, ctx.Err())
+				return
+			case <-time.After(time.Millisecond):
+			}
+			if !yield(model.Driver{ID: uuid.New(), Ref: fmt.Sprint("driver-", i)}, nil) {
+				s.streamDone <- ctx.Err()
+				return
+			}
+		}
+	}
+}
+
+// dial serves drivers over an in-memory connection and returns a client of
+// the driver service.
+func dial(t *testing.T, drivers *driverService, opts Options) f1v1.DriverServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	server := NewServer(Services{Drivers: drivers}, auth.NewAuthenticator(apiKeys{}, nil, false), opts)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return f1v1.NewDriverServiceClient(conn)
+}
+
+func withKey(key string) context.Context {
+	ctx := context.Background()
+	if key == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, APIKeyMetadata, key)
+}
+
+func TestStatusError(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{name: "not found", err: fmt.Errorf("get driver: %w", repository.ErrNotFound), wantCode: codes.NotFound},
+		{name: "driver not found", err: service.ErrDriverNotFound, wantCode: codes.NotFound},
+		{name: "unknown constructor", err: repository.ErrConstructorNotFound, wantCode: codes.InvalidArgument},
+		{name: "self merge", err: service.ErrSelfMerge, wantCode: codes.InvalidArgument},
+		{name: "in use", err: fmt.Errorf("delete: %w", repository.ErrInUse), wantCode: codes.FailedPrecondition},
+		{name: "overlapping results", err: repository.ErrDriverResultsOverlap, wantCode: codes.FailedPrecondition},
+		{name: "version without state", err: service.ErrNoVersionState, wantCode: codes.FailedPrecondition},
+		{name: "version conflict", err: repository.ErrVersionConflict, wantCode: codes.Aborted},
+		{name: "status kept", err: status.Error(codes.InvalidArgument, "Invalid ID format"), wantCode: codes.InvalidArgument, wantMsg: "Invalid ID format"},
+		{name: "cancelled call", ctx: cancelled, err: errors.New("conn closed"), wantCode: codes.Canceled},
+		{name: "other error", err: errors.New(`pq: relation "drivers" does not exist`), wantCode: codes.Internal, wantMsg: "Failed to delete driver"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			s, _ := status.FromError(statusError(ctx, "DeleteDriver", tt.err, "Failed to delete driver"))
+			if s.Code() != tt.wantCode {
+				t.Errorf("code = %s, want %s", s.Code(), tt.wantCode)
+			}
+			if tt.wantMsg != "" && s.Message() != tt.wantMsg {
+				t.Errorf("message = %q, want %q", s.Message(), tt.wantMsg)
+			}
+			if strings.Contains(s.Message(), "pq:") {
+				t.Errorf("message %q leaks the database error", s.Message())
+			}
+		})
+	}
+}
+
+func TestDeleteDriverStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{"deleted", nil, codes.OK},
+		{"not found", repository.ErrNotFound, codes.NotFound},
+		{"in use", repository.ErrInUse, codes.FailedPrecondition},
+		{"failure", errors.New("conn closed"), codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := dial(t, &driverService{err: tt.err}, Options{})
+			_, err := client.DeleteDriver(withKey("write"), &f1v1.RecordRequest{Id: driverID})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("DeleteDriver() code = %s, want %s: %v", code, tt.wantCode, err)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptors(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		call     func(ctx context.Context, client f1v1.DriverServiceClient) error
+		wantCode codes.Code
+	}{
+		{
+			name:     "read without credentials",
+			call:     getDriver(false),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "read with an invalid key",
+			key:      "guess",
+			call:     getDriver(false),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "read with the read scope",
+			key:      "read",
+			call:     getDriver(false),
+			wantCode: codes.OK,
+		},
+		{
+			name:     "deleted rows with the write scope",
+			key:      "write",
+			call:     getDriver(true),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "deleted rows with the admin scope",
+			key:      "admin",
+			call:     getDriver(true),
+			wantCode: codes.OK,
+		},
+		{
+			name:     "delete with the read scope",
+			key:      "read",
+			call:     deleteDriver,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "delete with the write scope",
+			key:      "write",
+			call:     deleteDriver,
+			wantCode: codes.OK,
+		},
+		{
+			name: "purge with the write scope",
+			key:  "write",
+			call: func(ctx context.Context, client f1v1.DriverServiceClient) error {
+				_, err := client.PurgeDriver(ctx, &f1v1.RecordRequest{Id: driverID})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "stream with the read scope",
+			key:  "read",
+			call: func(ctx context.Context, client f1v1.DriverServiceClient) error {
+				stream, err := client.StreamDrivers(ctx, &f1v1.StreamDriversRequest{})
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "stream without credentials",
+			call: func(ctx context.Context, client f1v1.DriverServiceClient) error {
+				stream, err := client.StreamDrivers(ctx, &f1v1.StreamDriversRequest{})
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := dial(t, &driverService{streamDone: make(chan error, 1)}, Options{})
+			ctx, cancel := context.WithCancel(withKey(tt.key))
+			defer cancel()
+			if code := status.Code(tt.call(ctx, client)); code != tt.wantCode {
+				t.Errorf("code = %s, want %s", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func getDriver(includeDeleted bool) func(ctx context.Context, client f1v1.DriverServiceClient) error {
+	return func(ctx context.Context, client f1v1.DriverServiceClient) error {
+		_, err := client.GetDriver(ctx, &f1v1.GetRequest{Id: driverID, IncludeDeleted: includeDeleted})
+		return err
+	}
+}
+
+func deleteDriver(ctx context.Context, client f1v1.DriverServiceClient) error {
+	_, err := client.DeleteDriver(ctx, &f1v1.RecordRequest{Id: driverID})
+	return err
+}
+
+func TestAuthInterceptorThrottlesFailures(t *testing.T) {
+	failures := ratelimit.NewFailures(ratelimit.Tier{Name: "test", Rate: 1.0 / 3600, Burst: 1})
+	client := dial(t, &driverService{}, Options{Failures: failures})
+
+	for _, step := range []struct {
+		key      string
+		wantCode codes.Code
+	}{
+		{"read", codes.OK},
+		{"guess", codes.Unauthenticated},
+		{"guess", codes.ResourceExhausted},
+		{"read", codes.ResourceExhausted},
+	} {
+		if code := status.Code(getDriver(false)(withKey(step.key), client)); code != step.wantCode {
+			t.Errorf("key %q: code = %s, want %s", step.key, code, step.wantCode)
+		}
+	}
+}
+
+func TestStreamDriversCancellation(t *testing.T) {
+	drivers := &driverService{streamDone: make(chan error, 1)}
+	client := dial(t, drivers, Options{})
+
+	ctx, cancel := context.WithCancel(withKey("read"))
+	defer cancel()
+	stream, err := client.StreamDrivers(ctx, &f1v1.StreamDriversRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		if _, err := stream.Recv(); err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+	}
+	cancel()
+
+	select {
+	case err := <-drivers.streamDone:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("stream stopped with %v, want its context cancelled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stream kept reading after the call was cancelled")
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			if code := status.Code(err); code != codes.Canceled {
+				t.Errorf("Recv() after cancelling: code = %s, want Canceled", code)
+			}
+			break
+		}
+	}
+}
+
+// quotas stores no daily usage.
+type quotas struct{}
+
+func (quotas) GetDailyUsage(context.Context, string, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (quotas) AddDailyUsage(context.Context, time.Time, map[string]int64) error {
+	return nil
+}
+
+// usage keeps the counters added to it.
+type usage struct {
+	repository.UsageRepository
+	counters []model.UsageCounter
+}
+
+func (u *usage) AddUsage(_ context.Context, counters []model.UsageCounter) error {
+	u.counters = append(u.counters, counters...)
+	return nil
+}
+
+func TestLimitInterceptors(t *testing.T) {
+	limiter := ratelimit.NewLimiter(map[string]ratelimit.Tier{
+		model.TierStandard: {Name: model.TierStandard, Rate: 1.0 / 3600, Burst: 1},
+	}, quotas{})
+	stored := &usage{}
+	recorder := analytics.NewRecorder(stored)
+	client := dial(t, &driverService{}, Options{Limiter: limiter, Usage: recorder})
+
+	for _, step := range []struct {
+		wantCode      codes.Code
+		wantRemaining string
+		wantRetry     bool
+	}{
+		{codes.OK, "0", false},
+		{codes.ResourceExhausted, "0", true},
+	} {
+		var header metadata.MD
+		_, err := client.GetDriver(withKey("read"), &f1v1.GetRequest{Id: driverID}, grpc.Header(&header))
+		if code := status.Code(err); code != step.wantCode {
+			t.Errorf("code = %s, want %s", code, step.wantCode)
+		}
+		if got := first(header, "ratelimit-remaining"); got != step.wantRemaining {
+			t.Errorf("ratelimit-remaining = %q, want %q", got, step.wantRemaining)
+		}
+		if got := first(header, "retry-after"); (got != "") != step.wantRetry {
+			t.Errorf("retry-after = %q, want it set: %t", got, step.wantRetry)
+		}
+	}
+
+	recorder.Flush(context.Background())
+	statuses := make(map[int]int64)
+	for _, c := range stored.counters {
+		if c.Client != "api-key:read" || c.Route != "GRPC /f1.v1.DriverService/GetDriver" {
+			t.Errorf("call counted for %s on %s", c.Client, c.Route)
+		}
+		statuses[c.Status] += c.Requests
+	}
+	if want := map[int]int64{200: 1, 429: 1}; !maps.Equal(statuses, want) {
+		t.Errorf("counted statuses %v, want %v", statuses, want)
+	}
+}
+
+func TestDeadlineInterceptors(t *testing.T) {
+	t.Run("unary", func(t *testing.T) {
+		client := dial(t, &driverService{block: true}, Options{Timeout: 20 * time.Millisecond})
+		_, err := client.GetDriver(withKey("read"), &f1v1.GetRequest{Id: driverID})
+		if code := status.Code(err); code != codes.DeadlineExceeded {
+			t.Errorf("code = %s, want DeadlineExceeded", code)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		drivers := &driverService{streamDone: make(chan error, 1)}
+		client := dial(t, drivers, Options{Timeout: time.Millisecond, StreamTimeout: 50 * time.Millisecond})
+		stream, err := client.StreamDrivers(withKey("read"), &f1v1.StreamDriversRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The stream outlives the unary timeout.
+		if _, err := stream.Recv(); err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		select {
+		case err := <-drivers.streamDone:
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("stream stopped with %v, want its deadline exceeded", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("the stream kept reading past its timeout")
+		}
+	})
+}
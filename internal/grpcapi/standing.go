@@ -0,0 +1,125 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/service"
+	f1v1 "github.com/ChinmayNoob/f1/pkg/pb/f1/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type standingServer struct {
+	f1v1.UnimplementedStandingServiceServer
+	service service.StandingService
+	history *historyServer
+}
+
+func (s *standingServer) ListSeasonDriverStandings(ctx context.Context, req *f1v1.SeasonRequest) (*f1v1.ListDriverStandingsResponse, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := s.service.GetSeasonDriverStandings(ctx, int(req.GetYear()))
+	if err != nil {
+		return nil, statusError(ctx, "ListSeasonDriverStandings", err, "Failed to get driver standings")
+	}
+	return &f1v1.ListDriverStandingsResponse{Standings: toPB(standings, driverStandingToPB)}, nil
+}
+
+func (s *standingServer) ListSeasonConstructorStandings(ctx context.Context, req *f1v1.SeasonRequest) (*f1v1.ListConstructorStandingsResponse, error) {
+	ctx, err := readContext(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := s.service.GetSeasonConstructorStandings(ctx, int(req.GetYear()))
+	if err != nil {
+		return nil, statusError(ctx, "ListSeasonConstructorStandings", err, "Failed to get constructor standings")
+	}
+	return &f1v1.ListConstructorStandingsResponse{Standings: toPB(standings, constructorStandingToPB)}, nil
+}
+
+func (s *standingServer) DeleteDriverStanding(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "DeleteDriverStanding", req.GetId(), nil, s.service.DeleteDriverStanding, "Failed to delete driver standing")
+}
+
+func (s *standingServer) RestoreDriverStanding(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "RestoreDriverStanding", req.GetId(), nil, s.service.RestoreDriverStanding, "Failed to restore driver standing")
+}
+
+func (s *standingServer) PurgeDriverStanding(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "PurgeDriverStanding", req.GetId(), nil, s.service.PurgeDriverStanding, "Failed to purge driver standing")
+}
+
+func (s *standingServer) BulkUpsertDriverStandings(ctx context.Context, req *f1v1.BulkUpsertDriverStandingsRequest) (*f1v1.BulkResult, error) {
+	standings, err := bulkItems(req.GetStandings(), driverStandingFromPB)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	mode, err := bulkModeFromPB(req.GetMode())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	result, err := s.service.BulkUpsertDriverStandings(ctx, pointers(standings), mode)
+	return bulkResult(ctx, "BulkUpsertDriverStandings", result, err, "Failed to upsert driver standings")
+}
+
+func (s *standingServer) GetDriverStandingHistory(ctx context.Context, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.history.history(ctx, "GetDriverStandingHistory", "driver_standings", id, req)
+}
+
+func (s *standingServer) RevertDriverStanding(ctx context.Context, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.history.revert(ctx, "RevertDriverStanding", "driver_standings", id, req)
+}
+
+func (s *standingServer) DeleteConstructorStanding(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "DeleteConstructorStanding", req.GetId(), nil, s.service.DeleteConstructorStanding, "Failed to delete constructor standing")
+}
+
+func (s *standingServer) RestoreConstructorStanding(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "RestoreConstructorStanding", req.GetId(), nil, s.service.RestoreConstructorStanding, "Failed to restore constructor standing")
+}
+
+func (s *standingServer) PurgeConstructorStanding(ctx context.Context, req *f1v1.RecordRequest) (*emptypb.Empty, error) {
+	return apply(ctx, "PurgeConstructorStanding", req.GetId(), nil, s.service.PurgeConstructorStanding, "Failed to purge constructor standing")
+}
+
+func (s *standingServer) BulkUpsertConstructorStandings(ctx context.Context, req *f1v1.BulkUpsertConstructorStandingsRequest) (*f1v1.BulkResult, error) {
+	standings, err := bulkItems(req.GetStandings(), constructorStandingFromPB)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	mode, err := bulkModeFromPB(req.GetMode())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	result, err := s.service.BulkUpsertConstructorStandings(ctx, pointers(standings), mode)
+	return bulkResult(ctx, "BulkUpsertConstructorStandings", result, err, "Failed to upsert constructor standings")
+}
+
+func (s *standingServer) GetConstructorStandingHistory(ctx context.Context, req *f1v1.HistoryRequest) (*f1v1.HistoryResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.history.history(ctx, "GetConstructorStandingHistory", "constructor_standings", id, req)
+}
+
+func (s *standingServer) RevertConstructorStanding(ctx context.Context, req *f1v1.RevertRequest) (*f1v1.RevertResponse, error) {
+	id, err := resolveID(ctx, req.GetId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.history.revert(ctx, "RevertConstructorStanding", "constructor_standings", id, req)
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/ChinmayNoob/f1/internal/utils"
+)
+
+// auditedResources maps the path segment of a resource to the table its
+// history is recorded under.
+var auditedResources = map[string]string{
+	"constructors":          "constructors",
+	"drivers":               "drivers",
+	"circuits":              "circuits",
+	"seasons":               "seasons",
+	"races":                 "races",
+	"results":               "results",
+	"driver-standings":      "driver_standings",
+	"constructor-standings": "constructor_standings",
+}
+
+type AuditHandler struct {
+	service     service.AuditService
+	maxPageSize int
+}
+
+// NewAuditHandler returns a handler whose lists return at most maxPageSize
+// items a page.
+func NewAuditHandler(s service.AuditService, maxPageSize int) *AuditHandler {
+	return &AuditHandler{
+		service:     s,
+		maxPageSize: maxPageSize,
+	}
+}
+
+// GetHistory serves the change history of one record of resource, which is
+// the path segment the route is registered under.
+func (h *AuditHandler) GetHistory(resource string) http.HandlerFunc {
+	entityType := auditedResources[resource]
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+
+		query := r.URL.Query()
+		page, limit := utils.ParsePagination(query.Get("page"), query.Get("limit"), h.maxPageSize)
+
+		entries, err := h.service.GetHistory(r.Context(), entityType, id, page, limit)
+		if err != nil {
+			http.Error(w, "Failed to get history", http.StatusInternalServerError)
+			slog.ErrorContext(r.Context(), "GetHistory error: Failed to get history", "error", err)
+			return
+		}
+
+		respond(w, r, http.StatusOK, entries)
+	}
+}
+
+// RevertToVersion restores a record of resource to the state recorded by a
+// history entry.
+func (h *AuditHandler) RevertToVersion(resource string) http.HandlerFunc {
+	entityType := auditedResources[resource]
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		entryID, err := strconv.ParseInt(r.PathValue("version"), 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid version", http.StatusBadRequest)
+			return
+		}
+
+		state, err := h.service.RevertToVersion(r.Context(), entityType, id, entryID)
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrNotFound):
+				http.Error(w, "Version not found", http.StatusNotFound)
+			case errors.Is(err, service.ErrNoVersionState):
+				http.Error(w, "Version has no state to revert to, pick an earlier one", http.StatusConflict)
+			case errors.Is(err, repository.ErrVersionConflict):
+				http.Error(w, "Version conflicts with existing data", http.StatusConflict)
+			default:
+				http.Error(w, "Failed to revert", http.StatusInternalServerError)
+				slog.ErrorContext(r.Context(), "RevertToVersion error: Failed to revert", "error", err)
+				return
+			}
+			slog.WarnContext(r.Context(), "RevertToVersion error: Cannot revert", "error", err)
+			return
+		}
+
+		respond(w, r, http.StatusOK, state)
+	}
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/google/uuid"
+)
+
+type revertService struct {
+	service.AuditService
+	state json.RawMessage
+	err   error
+}
+
+func (s revertService) RevertToVersion(context.Context, string, uuid.UUID, int64) (json.RawMessage, error) {
+	return s.state, s.err
+}
+
+func TestRevertToVersion(t *testing.T) {
+	state := json.RawMessage(`{"id":"7d4c1f40-0000-4000-8000-000000000001","ref":"hamilton","number":44}`)
+	tests := []struct {
+		name            string
+		accept          string
+		err             error
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{"json", "", nil, http.StatusOK, "application/json", `"ref":"hamilton"`},
+		{"yaml", "application/yaml", nil, http.StatusOK, "application/yaml", "ref: hamilton"},
+		{"unknown version", "", repository.ErrNotFound, http.StatusNotFound, "text/plain", "Version not found"},
+		{"version without state", "", service.ErrNoVersionState, http.StatusConflict, "text/plain", "pick an earlier one"},
+		{"conflicting version", "", repository.ErrVersionConflict, http.StatusConflict, "text/plain", "conflicts with existing data"},
+		{"failure", "", errors.New("conn closed"), http.StatusInternalServerError, "text/plain", "Failed to revert"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewAuditHandler(revertService{state: state, err: tt.err}, 100).RevertToVersion("drivers")
+			req := httptest.NewRequest(http.MethodPost, "/v1/drivers/7d4c1f40-0000-4000-8000-000000000001/history/3/revert", nil)
+			req.SetPathValue("id", "7d4c1f40-0000-4000-8000-000000000001")
+			req.SetPathValue("version", "3")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			h(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, tt.wantContentType) {
+				t.Errorf("Content-Type = %q, want %s", ct, tt.wantContentType)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body = %q, want it to contain %q", rec.Body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/internal/middleware"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+)
+
+const maxBatchOperations = 100
+
+// batchReference matches ${id.field.field} references to earlier responses.
+var batchReference = regexp.MustCompile(`\$\{([A-Za-z0-9_-]+)((?:\.[A-Za-z0-9_-]+)*)\}`)
+
+var errBatchFailed = errors.New("batch operation failed")
+
+type BatchHandler struct {
+	mux      *http.ServeMux
+	dispatch http.Handler
+	uow      repository.UnitOfWork
+}
+
+// NewBatchHandler returns a handler that serves batch operations through
+// dispatch, the routes of mux wrapped in the middleware that runs for every
+// top-level request once it is authenticated, so that each operation is
+// rate limited, timed out and recorded on its own.
+func NewBatchHandler(mux *http.ServeMux, dispatch http.Handler, uow repository.UnitOfWork) *BatchHandler {
+	return &BatchHandler{
+		mux:      mux,
+		dispatch: dispatch,
+		uow:      uow,
+	}
+}
+
+// ExecuteBatch runs the operations of a batch. scopes maps the pattern of
+// every route to the scope it requires; a batch is refused before any of its
+// operations runs unless the caller has the highest scope among them.
+func (h *BatchHandler) ExecuteBatch(scopes map[string]model.Scope) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.executeBatch(w, r, scopes)
+	}
+}
+
+func (h *BatchHandler) executeBatch(w http.ResponseWriter, r *http.Request, scopes map[string]model.Scope) {
+	var req model.BatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "ExecuteBatch error: Invalid request body", "error", err)
+		return
+	}
+	if err := validateBatch(req); err != nil {
+		http.Error(w, "Invalid batch: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !middleware.Authorize(w, r, h.requiredScope(r, req.Operations, scopes)) {
+		return
+	}
+
+	resp := model.BatchResponse{
+		Atomic:  req.Atomic,
+		Results: make([]model.BatchResult, len(req.Operations)),
+	}
+
+	run := func(ctx context.Context) error {
+		bodies := make(map[string]interface{})
+		failed := false
+
+		for i, op := range req.Operations {
+			if failed && req.Atomic {
+				resp.Results[i] = model.BatchResult{
+					ID:     op.ID,
+					Status: http.StatusFailedDependency,
+					Error:  "skipped because an earlier operation failed",
+				}
+				continue
+			}
+
+			result := h.execute(ctx, r, op, bodies)
+			resp.Results[i] = result
+			if result.Status >= http.StatusBadRequest {
+				failed = true
+				continue
+			}
+
+			if op.ID != "" && len(result.Body) > 0 {
+				dec := json.NewDecoder(bytes.NewReader(result.Body))
+				dec.UseNumber()
+				var body interface{}
+				if err := dec.Decode(&body); err == nil {
+					bodies[op.ID] = body
+				}
+			}
+		}
+
+		if failed && req.Atomic {
+			return errBatchFailed
+		}
+		return nil
+	}
+
+	var err error
+	if req.Atomic {
+		err = h.uow.Do(r.Context(), run)
+	} else {
+		err = run(r.Context())
+	}
+	if err != nil {
+		if !errors.Is(err, errBatchFailed) {
+			http.Error(w, "Failed to execute batch", http.StatusInternalServerError)
+			slog.ErrorContext(r.Context(), "ExecuteBatch error: Failed to execute batch", "error", err)
+			return
+		}
+		resp.RolledBack = true
+	}
+
+	respond(w, r, http.StatusOK, resp)
+}
+
+// ------------------------
+// Private methods
+// ------------------------
+
+// requiredScope returns the highest scope the routes of ops require.
+func (h *BatchHandler) requiredScope(r *http.Request, ops []model.BatchOperation, scopes map[string]model.Scope) model.Scope {
+	required := model.ScopeRead
+	for _, op := range ops {
+		// References are resolved when the operation runs, but a value in
+		// their place finds the same route.
+		path := batchReference.ReplaceAllString(op.Path, "ref")
+		req, err := http.NewRequestWithContext(r.Context(), strings.ToUpper(op.Method), path, nil)
+		if err != nil {
+			// The operation fails when it runs.
+			continue
+		}
+		_, pattern := h.mux.Handler(req)
+		scope, ok := scopes[pattern]
+		if !ok {
+			continue
+		}
+		if scope = middleware.RequiredScope(scope, req); !required.Includes(scope) {
+			required = scope
+		}
+	}
+	return required
+}
+
+func (h *BatchHandler) execute(ctx context.Context, parent *http.Request, op model.BatchOperation, bodies map[string]interface{}) model.BatchResult {
+	result := model.BatchResult{ID: op.ID}
+
+	path, err := resolvePath(op.Path, bodies)
+	if err != nil {
+		result.Status = http.StatusFailedDependency
+		result.Error = err.Error()
+		return result
+	}
+	body, err := resolveBody(op.Body, bodies)
+	if err != nil {
+		result.Status = http.StatusFailedDependency
+		result.Error = err.Error()
+		return result
+	}
+
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(op.Method), path, bytes.NewReader(body))
+	if err != nil {
+		result.Status = http.StatusBadRequest
+		result.Error = err.Error()
+		return result
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range op.Headers {
+		req.Header.Set(name, value)
+	}
+	req.RemoteAddr = parent.RemoteAddr
+
+	rec := newBatchResponse()
+	h.dispatch.ServeHTTP(rec, req)
+
+	result.Status = rec.status
+	if header := rec.sentHeader(); len(header) > 0 {
+		result.Headers = make(map[string]string, len(header))
+		for name := range header {
+			result.Headers[name] = header.Get(name)
+		}
+	}
+
+	raw := bytes.TrimSpace(rec.body.Bytes())
+	switch {
+	case len(raw) == 0:
+	case json.Valid(raw):
+		result.Body = raw
+	default:
+		result.Body, _ = json.Marshal(string(raw))
+		if result.Status >= http.StatusBadRequest {
+			result.Error = string(raw)
+		}
+	}
+	return result
+}
+
+// batchResponse collects the status, headers and body of the response to an
+// operation of a batch.
+type batchResponse struct {
+	header      http.Header
+	sent        http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newBatchResponse() *batchResponse {
+	return &batchResponse{header: make(http.Header), status: http.StatusOK}
+}
+
+func (rec *batchResponse) Header() http.Header {
+	return rec.header
+}
+
+// WriteHeader keeps the headers as they are when the status is sent, as a
+// client would get them; headers set later, such as trailers, are dropped.
+func (rec *batchResponse) WriteHeader(status int) {
+	if rec.wroteHeader {
+		return
+	}
+	rec.status = status
+	rec.sent = rec.header.Clone()
+	rec.wroteHeader = true
+}
+
+func (rec *batchResponse) Write(b []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	return rec.body.Write(b)
+}
+
+// sentHeader returns the headers of the response.
+func (rec *batchResponse) sentHeader() http.Header {
+	if !rec.wroteHeader {
+		return rec.header
+	}
+	return rec.sent
+}
+
+func validateBatch(req model.BatchRequest) error {
+	if len(req.Operations) == 0 {
+		return errors.New("no operations")
+	}
+	if len(req.Operations) > maxBatchOperations {
+		return fmt.Errorf("too many operations, the limit is %d", maxBatchOperations)
+	}
+
+	ids := make(map[string]bool)
+	for i, op := range req.Operations {
+		switch strings.ToUpper(op.Method) {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			return fmt.Errorf("operation %d: unsupported method %q", i, op.Method)
+		}
+		if !strings.HasPrefix(op.Path, "/") {
+			return fmt.Errorf("operation %d: path must start with /", i)
+		}
+		if p, _, _ := strings.Cut(op.Path, "?"); strings.TrimSuffix(strings.TrimPrefix(p, "/v1"), "/") == "/batch" {
+			return fmt.Errorf("operation %d: batches cannot be nested", i)
+		}
+		if op.ID == "" {
+			continue
+		}
+		if ids[op.ID] {
+			return fmt.Errorf("operation %d: duplicate id %q", i, op.ID)
+		}
+		ids[op.ID] = true
+	}
+	return nil
+}
+
+func resolvePath(path string, bodies map[string]interface{}) (string, error) {
+	var resolveErr error
+	resolved := batchReference.ReplaceAllStringFunc(path, func(ref string) string {
+		value, err := lookupReference(ref, bodies)
+		if err != nil {
+			resolveErr = err
+			return ref
+		}
+		return url.PathEscape(referenceString(value))
+	})
+	return resolved, resolveErr
+}
+
+func resolveBody(body json.RawMessage, bodies map[string]interface{}) ([]byte, error) {
+	if len(body) == 0 || !batchReference.Match(body) {
+		return body, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var decoded interface{}
+	if err := dec.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	resolved, err := resolveValue(decoded, bodies)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resolved)
+}
+
+// resolveValue replaces references inside strings. A string that is exactly
+// one reference takes the referenced value as is, keeping its JSON type.
+func resolveValue(value interface{}, bodies map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			resolved, err := resolveValue(item, bodies)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolved
+		}
+		return v, nil
+	case []interface{}:
+		for i, item := range v {
+			resolved, err := resolveValue(item, bodies)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+		return v, nil
+	case string:
+		if loc := batchReference.FindStringIndex(v); loc != nil && loc[0] == 0 && loc[1] == len(v) {
+			return lookupReference(v, bodies)
+		}
+		var resolveErr error
+		resolved := batchReference.ReplaceAllStringFunc(v, func(ref string) string {
+			value, err := lookupReference(ref, bodies)
+			if err != nil {
+				resolveErr = err
+				return ref
+			}
+			return referenceString(value)
+		})
+		return resolved, resolveErr
+	default:
+		return v, nil
+	}
+}
+
+func lookupReference(ref string, bodies map[string]interface{}) (interface{}, error) {
+	match := batchReference.FindStringSubmatch(ref)
+	value, ok := bodies[match[1]]
+	if !ok {
+		return nil, fmt.Errorf("reference %s: no successful operation with id %q", ref, match[1])
+	}
+
+	for _, field := range strings.Split(strings.TrimPrefix(match[2], "."), ".") {
+		if field == "" {
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value, ok = v[field]
+		case []interface{}:
+			index, err := strconv.Atoi(field)
+			ok = err == nil && index >= 0 && index < len(v)
+			if ok {
+				value = v[index]
+			}
+		default:
+			ok = false
+		}
+		if !ok {
+			return nil, fmt.Errorf("reference %s: field %q not found", ref, field)
+		}
+	}
+	return value, nil
+}
+
+func referenceString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
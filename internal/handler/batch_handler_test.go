@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+)
+
+func TestExecuteBatchScopesAndDispatch(t *testing.T) {
+	mux := http.NewServeMux()
+	var served []string
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		served = append(served, r.Method+" "+r.URL.Path)
+		io.WriteString(w, `{"id":"1"}`)
+	}
+	mux.HandleFunc("GET /v1/drivers/{id}", ok)
+	mux.HandleFunc("POST /v1/drivers", ok)
+	mux.HandleFunc("POST /v1/drivers/{id}/purge", ok)
+	scopes := map[string]model.Scope{
+		"GET /v1/drivers/{id}":        model.ScopeRead,
+		"POST /v1/drivers":            model.ScopeWrite,
+		"POST /v1/drivers/{id}/purge": model.ScopeAdmin,
+	}
+
+	// dispatch stands in for the middleware every operation runs through.
+	var dispatched int
+	dispatch := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dispatched++
+		mux.ServeHTTP(w, r)
+	})
+	h := NewBatchHandler(mux, dispatch, nil).ExecuteBatch(scopes)
+
+	tests := []struct {
+		name       string
+		scope      model.Scope
+		operations string
+		wantStatus int
+		wantServed int
+	}{
+		{
+			name:       "reads with the read scope",
+			scope:      model.ScopeRead,
+			operations: `[{"method":"GET","path":"/v1/drivers/hamilton"},{"method":"GET","path":"/v1/drivers/russell"}]`,
+			wantStatus: http.StatusOK,
+			wantServed: 2,
+		},
+		{
+			name:       "a write with the read scope",
+			scope:      model.ScopeRead,
+			operations: `[{"method":"GET","path":"/v1/drivers/hamilton"},{"method":"POST","path":"/v1/drivers","body":{}}]`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "a reference to an admin route with the write scope",
+			scope:      model.ScopeWrite,
+			operations: `[{"id":"d","method":"POST","path":"/v1/drivers","body":{}},{"method":"POST","path":"/v1/drivers/${d.id}/purge"}]`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "deleted rows with the write scope",
+			scope:      model.ScopeWrite,
+			operations: `[{"method":"GET","path":"/v1/drivers/hamilton?include_deleted=true"}]`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "every scope with the admin scope",
+			scope:      model.ScopeAdmin,
+			operations: `[{"id":"d","method":"POST","path":"/v1/drivers","body":{}},{"method":"POST","path":"/v1/drivers/${d.id}/purge"}]`,
+			wantStatus: http.StatusOK,
+			wantServed: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			served, dispatched = nil, 0
+			req := httptest.NewRequest(http.MethodPost, "/v1/batch", strings.NewReader(`{"operations":`+tt.operations+`}`))
+			principal := model.Principal{Name: "key:ci", Scopes: []model.Scope{tt.scope}}
+			req = req.WithContext(requestctx.WithPrincipal(req.Context(), principal))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if len(served) != tt.wantServed || dispatched != tt.wantServed {
+				t.Fatalf("served %v through dispatch %d times, want %d operations", served, dispatched, tt.wantServed)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+			var resp model.BatchResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+			for i, result := range resp.Results {
+				if result.Status != http.StatusOK {
+					t.Errorf("operation %d: status %d, want 200", i, result.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestBatchResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		wantStatus int
+		wantHeader http.Header
+		wantBody   string
+	}{
+		{
+			name:       "nothing written",
+			handler:    func(w http.ResponseWriter, _ *http.Request) { w.Header().Set("ETag", `"v1"`) },
+			wantStatus: http.StatusOK,
+			wantHeader: http.Header{"Etag": {`"v1"`}},
+		},
+		{
+			name: "status and body",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Location", "/v1/drivers/hamilton")
+				w.WriteHeader(http.StatusCreated)
+				w.WriteHeader(http.StatusInternalServerError)
+				io.WriteString(w, `{"ref":"hamilton"}`)
+				w.Header().Set("Trailer:X-Stream-Error", "Internal Server Error")
+			},
+			wantStatus: http.StatusCreated,
+			wantHeader: http.Header{"Location": {"/v1/drivers/hamilton"}},
+			wantBody:   `{"ref":"hamilton"}`,
+		},
+		{
+			name: "body without a status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				io.WriteString(w, "ok")
+			},
+			wantStatus: http.StatusOK,
+			wantHeader: http.Header{},
+			wantBody:   "ok",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := newBatchResponse()
+			tt.handler(rec, httptest.NewRequest(http.MethodGet, "/v1/drivers", nil))
+
+			if rec.status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.status, tt.wantStatus)
+			}
+			if got := rec.sentHeader(); !reflect.DeepEqual(got, tt.wantHeader) {
+				t.Errorf("header = %v, want %v", got, tt.wantHeader)
+			}
+			if rec.body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", rec.body.String(), tt.wantBody)
+			}
+		})
+	}
+}
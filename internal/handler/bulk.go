@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+)
+
+const maxBulkItems = 1000
+
+// decodeBulk reads the items of a bulk request. The body is either a JSON
+// array or, when the Content-Type is application/x-ndjson, one JSON object
+// per line. The mode comes from ?mode= and defaults to atomic.
+func decodeBulk[T any](r *http.Request) ([]T, model.BulkMode, error) {
+	mode := model.BulkMode(r.URL.Query().Get("mode"))
+	switch mode {
+	case "":
+		mode = model.BulkModeAtomic
+	case model.BulkModeAtomic, model.BulkModeBestEffort:
+	default:
+		return nil, "", fmt.Errorf("invalid mode %q", mode)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	var items []T
+	switch mediaType {
+	case "application/x-ndjson", "application/ndjson":
+		dec := json.NewDecoder(r.Body)
+		for {
+			var item T
+			if err := dec.Decode(&item); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, "", fmt.Errorf("item %d: %w", len(items), err)
+			}
+			items = append(items, item)
+			if len(items) > maxBulkItems {
+				return nil, "", fmt.Errorf("too many items, the limit is %d", maxBulkItems)
+			}
+		}
+	default:
+		if err := json.NewDecoder(r.Body).Decode(&items); err != nil {
+			return nil, "", err
+		}
+		if len(items) > maxBulkItems {
+			return nil, "", fmt.Errorf("too many items, the limit is %d", maxBulkItems)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil, "", errors.New("no items to process")
+	}
+	return items, mode, nil
+}
+
+// pointers adapts decoded bulk items for services that work on pointers.
+func pointers[T any](items []T) []*T {
+	ptrs := make([]*T, len(items))
+	for i := range items {
+		ptrs[i] = &items[i]
+	}
+	return ptrs
+}
+
+// respondBulk writes a bulk result. A fully applied batch is 200, a
+// best-effort batch with failures is 207 and a rolled back atomic batch is
+// 422.
+func respondBulk(w http.ResponseWriter, r *http.Request, result model.BulkResult) {
+	status := http.StatusOK
+	if result.Failed > 0 {
+		if result.Mode == model.BulkModeAtomic {
+			status = http.StatusUnprocessableEntity
+		} else {
+			status = http.StatusMultiStatus
+		}
+	}
+
+	respond(w, r, status, result)
+}
@@ -3,237 +3,296 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
-	"strings"
+	"net/url"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
 	"github.com/ChinmayNoob/f1/internal/service"
 	"github.com/ChinmayNoob/f1/internal/utils"
 	"github.com/google/uuid"
 )
 
 type CircuitHandler struct {
-	service service.CircuitService
-	ctx     context.Context
+	service     service.CircuitService
+	maxPageSize int
 }
 
-func NewCircuitHandler(ctx context.Context, s service.CircuitService) *CircuitHandler {
+// NewCircuitHandler returns a handler whose lists return at most maxPageSize
+// items a page.
+func NewCircuitHandler(s service.CircuitService, maxPageSize int) *CircuitHandler {
 	return &CircuitHandler{
-		service: s,
-		ctx:     ctx,
+		service:     s,
+		maxPageSize: maxPageSize,
 	}
 }
 
 func (h *CircuitHandler) GetCircuit(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
-	page, limit := utils.ParsePagination(query.Get("page"), query.Get("limit"))
+	if streamed(r) {
+		h.stream(w, r, query)
+		return
+	}
+	page, limit := utils.ParsePagination(query.Get("page"), query.Get("limit"), h.maxPageSize)
 
 	switch {
 	case query.Has("name"):
-		h.getByName(w, query.Get("name"), page, limit)
+		h.getByName(readContext(r), w, r, query.Get("name"), page, limit)
 	case query.Has("location"):
-		h.getByLocation(w, query.Get("location"), page, limit)
+		h.getByLocation(readContext(r), w, r, query.Get("location"), page, limit)
 	case query.Has("country"):
-		h.getByCountry(w, query.Get("country"), page, limit)
+		h.getByCountry(readContext(r), w, r, query.Get("country"), page, limit)
 	case query.Has("current"):
-		h.getByCurrent(w, query.Get("current") == "true")
+		h.getByCurrent(readContext(r), w, r, query.Get("current") == "true")
 	case query.Has("ref"):
-		h.getByRef(w, query.Get("ref"))
+		h.getByRef(readContext(r), w, r, query.Get("ref"))
 	case query.Has("url"):
-		h.getByURL(w, query.Get("url"))
+		h.getByURL(readContext(r), w, r, query.Get("url"))
 	default:
-		h.getAll(w, page, limit)
+		h.getAll(readContext(r), w, r, page, limit)
 	}
 }
 
 func (h *CircuitHandler) GetCircuitByID(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
-		return
-	}
+	h.getByID(readContext(r), w, r, id)
+}
 
-	h.getByID(w, id)
+// ResolveRef returns the ID of the circuit with ref, for routes that accept
+// a ref in place of the ID.
+func (h *CircuitHandler) ResolveRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	return h.service.ResolveCircuitRef(ctx, ref)
 }
 
 func (h *CircuitHandler) CreateCircuit(w http.ResponseWriter, r *http.Request) {
 	var circuit model.Circuit
 	if err := json.NewDecoder(r.Body).Decode(&circuit); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		log.Printf("CreateCircuit error: Invalid request body: %v", err)
+		slog.WarnContext(r.Context(), "CreateCircuit error: Invalid request body", "error", err)
 		return
 	}
 
-	createdCircuit, err := h.service.CreateCircuit(h.ctx, circuit)
+	createdCircuit, err := h.service.CreateCircuit(r.Context(), circuit)
 	if err != nil {
 		http.Error(w, "Failed to create circuit", http.StatusInternalServerError)
-		log.Printf("CreateCircuit error: Failed to create circuit: %v", err)
+		slog.ErrorContext(r.Context(), "CreateCircuit error: Failed to create circuit", "error", err)
 		return
 	}
 
-	h.respond(w, createdCircuit)
+	respond(w, r, http.StatusOK, createdCircuit)
 }
 
 func (h *CircuitHandler) UpdateCircuit(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
-		return
-	}
-
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
 	var circuit model.Circuit
 	if err := json.NewDecoder(r.Body).Decode(&circuit); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		log.Printf("UpdateCircuit error: Invalid request body: %v", err)
+		slog.WarnContext(r.Context(), "UpdateCircuit error: Invalid request body", "error", err)
 		return
 	}
 
-	updatedCircuit, err := h.service.UpdateCircuit(h.ctx, id, circuit)
+	updatedCircuit, err := h.service.UpdateCircuit(r.Context(), id, circuit)
 	if err != nil {
 		http.Error(w, "Failed to update circuit", http.StatusInternalServerError)
-		log.Printf("UpdateCircuit error: Failed to update circuit: %v", err)
+		slog.ErrorContext(r.Context(), "UpdateCircuit error: Failed to update circuit", "error", err)
 		return
 	}
 
-	h.respond(w, updatedCircuit)
+	respond(w, r, http.StatusOK, updatedCircuit)
 }
 
 func (h *CircuitHandler) DeleteCircuit(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
+	if err := h.service.DeleteCircuit(r.Context(), id); err != nil {
+		http.Error(w, "Failed to delete circuit", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "DeleteCircuit error: Failed to delete circuit", "error", err)
 		return
 	}
 
-	if err := h.service.DeleteCircuit(h.ctx, id); err != nil {
-		http.Error(w, "Failed to delete circuit", http.StatusInternalServerError)
-		log.Printf("DeleteCircuit error: Failed to delete circuit: %v", err)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CircuitHandler) RestoreCircuit(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RestoreCircuit(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Deleted circuit not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to restore circuit", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "RestoreCircuit error: Failed to restore circuit", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CircuitHandler) PurgeCircuit(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeCircuit(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Deleted circuit not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrInUse):
+			http.Error(w, "Cannot purge a circuit that is still referenced", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to purge circuit", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "PurgeCircuit error: Failed to purge circuit", "error", err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (h *CircuitHandler) BulkUpsertCircuits(w http.ResponseWriter, r *http.Request) {
+	circuits, mode, err := decodeBulk[model.Circuit](r)
+	if err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "BulkUpsertCircuits error: Invalid request body", "error", err)
+		return
+	}
+
+	result, err := h.service.BulkUpsertCircuits(r.Context(), circuits, mode)
+	if err != nil {
+		http.Error(w, "Failed to upsert circuits", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "BulkUpsertCircuits error: Failed to upsert circuits", "error", err)
+		return
+	}
+
+	respondBulk(w, r, result)
+}
+
 // ------------------------
 // Private methods
 // ------------------------
 
-func (h *CircuitHandler) getAll(w http.ResponseWriter, page, limit int) {
-	circuits, err := h.service.GetAllCircuits(h.ctx, page, limit)
+// stream writes every circuit matching the filters in query, unpaginated, as
+// it is read.
+func (h *CircuitHandler) stream(w http.ResponseWriter, r *http.Request, query url.Values) {
+	filter := model.CircuitFilter{
+		Name:     query.Get("name"),
+		Location: query.Get("location"),
+		Country:  query.Get("country"),
+		Ref:      query.Get("ref"),
+		URL:      query.Get("url"),
+	}
+	if query.Has("current") {
+		current := query.Get("current") == "true"
+		filter.Current = &current
+	}
+
+	ctx, cancel := streamContext(r)
+	defer cancel()
+	respondStream(ctx, w, r, h.service.StreamCircuits(ctx, filter), "Failed to fetch circuits")
+}
+
+func (h *CircuitHandler) getAll(ctx context.Context, w http.ResponseWriter, r *http.Request, page, limit int) {
+	circuits, err := h.service.GetAllCircuits(ctx, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch circuits", http.StatusInternalServerError)
-		log.Printf("GetAll error: %v", err)
+		slog.ErrorContext(ctx, "GetAll error", "error", err)
 		return
 	}
-	h.respond(w, circuits)
+	respond(w, r, http.StatusOK, circuits)
 }
 
-func (h *CircuitHandler) getByName(w http.ResponseWriter, name string, page, limit int) {
-	circuits, err := h.service.GetCircuitByName(h.ctx, name, page, limit)
+func (h *CircuitHandler) getByName(ctx context.Context, w http.ResponseWriter, r *http.Request, name string, page, limit int) {
+	circuits, err := h.service.GetCircuitByName(ctx, name, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch circuits by name", http.StatusInternalServerError)
-		log.Printf("GetByName error: %v", err)
+		slog.ErrorContext(ctx, "GetByName error", "error", err)
 		return
 	}
-	h.respond(w, circuits)
+	respond(w, r, http.StatusOK, circuits)
 }
 
-func (h *CircuitHandler) getByLocation(w http.ResponseWriter, location string, page, limit int) {
-	circuits, err := h.service.GetCircuitByLocation(h.ctx, location, page, limit)
+func (h *CircuitHandler) getByLocation(ctx context.Context, w http.ResponseWriter, r *http.Request, location string, page, limit int) {
+	circuits, err := h.service.GetCircuitByLocation(ctx, location, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch circuits by location", http.StatusInternalServerError)
-		log.Printf("GetByLocation error: %v", err)
+		slog.ErrorContext(ctx, "GetByLocation error", "error", err)
 		return
 	}
-	h.respond(w, circuits)
+	respond(w, r, http.StatusOK, circuits)
 }
 
-func (h *CircuitHandler) getByCountry(w http.ResponseWriter, country string, page, limit int) {
-	circuits, err := h.service.GetCircuitByCountry(h.ctx, country, page, limit)
+func (h *CircuitHandler) getByCountry(ctx context.Context, w http.ResponseWriter, r *http.Request, country string, page, limit int) {
+	circuits, err := h.service.GetCircuitByCountry(ctx, country, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch circuits by country", http.StatusInternalServerError)
-		log.Printf("GetByCountry error: %v", err)
+		slog.ErrorContext(ctx, "GetByCountry error", "error", err)
 		return
 	}
-	h.respond(w, circuits)
+	respond(w, r, http.StatusOK, circuits)
 }
 
-func (h *CircuitHandler) getByCurrent(w http.ResponseWriter, current bool) {
-	circuits, err := h.service.GetCircuitByCurrent(h.ctx, current)
+func (h *CircuitHandler) getByCurrent(ctx context.Context, w http.ResponseWriter, r *http.Request, current bool) {
+	circuits, err := h.service.GetCircuitByCurrent(ctx, current)
 	if err != nil {
 		http.Error(w, "Failed to fetch circuits by current status", http.StatusInternalServerError)
-		log.Printf("GetByCurrent error: %v", err)
+		slog.ErrorContext(ctx, "GetByCurrent error", "error", err)
 		return
 	}
-	h.respond(w, circuits)
+	respond(w, r, http.StatusOK, circuits)
 }
 
-func (h *CircuitHandler) getByRef(w http.ResponseWriter, ref string) {
-	circuit, err := h.service.GetCircuitByRef(h.ctx, ref)
+func (h *CircuitHandler) getByRef(ctx context.Context, w http.ResponseWriter, r *http.Request, ref string) {
+	circuit, err := h.service.GetCircuitByRef(ctx, ref)
 	if err != nil {
 		http.Error(w, "Failed to fetch circuit by ref", http.StatusInternalServerError)
-		log.Printf("GetByRef error: %v", err)
+		slog.ErrorContext(ctx, "GetByRef error", "error", err)
 		return
 	}
 	if circuit.ID == (uuid.UUID{}) {
 		http.Error(w, "Circuit not found", http.StatusNotFound)
 		return
 	}
-	h.respond(w, circuit)
+	respond(w, r, http.StatusOK, circuit)
 }
 
-func (h *CircuitHandler) getByURL(w http.ResponseWriter, url string) {
-	circuit, err := h.service.GetCircuitByURL(h.ctx, url)
+func (h *CircuitHandler) getByURL(ctx context.Context, w http.ResponseWriter, r *http.Request, url string) {
+	circuit, err := h.service.GetCircuitByURL(ctx, url)
 	if err != nil {
 		http.Error(w, "Failed to fetch circuit by URL", http.StatusInternalServerError)
-		log.Printf("GetByURL error: %v", err)
+		slog.ErrorContext(ctx, "GetByURL error", "error", err)
 		return
 	}
 	if circuit.ID == (uuid.UUID{}) {
 		http.Error(w, "Circuit not found", http.StatusNotFound)
 		return
 	}
-	h.respond(w, circuit)
+	respond(w, r, http.StatusOK, circuit)
 }
 
-func (h *CircuitHandler) getByID(w http.ResponseWriter, id uuid.UUID) {
-	circuit, err := h.service.GetCircuitByID(h.ctx, id)
+func (h *CircuitHandler) getByID(ctx context.Context, w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	circuit, err := h.service.GetCircuitByID(ctx, id)
 	if err != nil {
 		http.Error(w, "Circuit not found", http.StatusNotFound)
-		log.Printf("GetByID error: %v", err)
+		slog.ErrorContext(ctx, "GetByID error", "error", err)
 		return
 	}
-	h.respond(w, circuit)
-}
-
-func (h *CircuitHandler) respond(w http.ResponseWriter, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(data); err != nil {
-		log.Printf("Failed to encode response: %v", err)
-	}
+	respond(w, r, http.StatusOK, circuit)
 }
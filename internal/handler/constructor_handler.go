@@ -3,175 +3,235 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
-	"strings"
+	"net/url"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
 	"github.com/ChinmayNoob/f1/internal/service"
 	"github.com/ChinmayNoob/f1/internal/utils"
 	"github.com/google/uuid"
 )
 
 type ConstructorHandler struct {
-	service service.ConstructorService
-	ctx     context.Context
+	service     service.ConstructorService
+	maxPageSize int
 }
 
-func NewConstructorHandler(ctx context.Context, s service.ConstructorService) *ConstructorHandler {
+// NewConstructorHandler returns a handler whose lists return at most maxPageSize
+// items a page.
+func NewConstructorHandler(s service.ConstructorService, maxPageSize int) *ConstructorHandler {
 	return &ConstructorHandler{
-		service: s,
-		ctx:     ctx,
+		service:     s,
+		maxPageSize: maxPageSize,
 	}
 }
 
 func (h *ConstructorHandler) GetConstructor(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
-	page, limit := utils.ParsePagination(query.Get("page"), query.Get("limit"))
+	if streamed(r) {
+		h.stream(w, r, query)
+		return
+	}
+	page, limit := utils.ParsePagination(query.Get("page"), query.Get("limit"), h.maxPageSize)
 
 	switch {
 	case query.Has("name"):
-		h.getByName(w, query.Get("name"), page, limit)
+		h.getByName(readContext(r), w, r, query.Get("name"), page, limit)
 	case query.Has("nationality"):
-		h.getByNationality(w, query.Get("nationality"), page, limit)
+		h.getByNationality(readContext(r), w, r, query.Get("nationality"), page, limit)
 	case query.Has("ref"):
-		h.getByRef(w, query.Get("ref"))
+		h.getByRef(readContext(r), w, r, query.Get("ref"))
 	default:
-		h.getAll(w, page, limit)
+		h.getAll(readContext(r), w, r, page, limit)
 	}
 }
 
 func (h *ConstructorHandler) GetConstructorByID(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
-		return
-	}
+	h.getByID(readContext(r), w, r, id)
+}
 
-	h.getByID(w, id)
+// ResolveRef returns the ID of the constructor with ref, for routes that accept
+// a ref in place of the ID.
+func (h *ConstructorHandler) ResolveRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	return h.service.ResolveConstructorRef(ctx, ref)
 }
 
 func (h *ConstructorHandler) CreateConstructor(w http.ResponseWriter, r *http.Request) {
 	var constructor model.Constructor
 	if err := json.NewDecoder(r.Body).Decode(&constructor); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		log.Printf("CreateConstructor error: Invalid request body: %v", err)
+		slog.WarnContext(r.Context(), "CreateConstructor error: Invalid request body", "error", err)
 		return
 	}
 
-	createdConstructor, err := h.service.CreateConstructor(h.ctx, constructor)
+	createdConstructor, err := h.service.CreateConstructor(r.Context(), constructor)
 	if err != nil {
 		http.Error(w, "Failed to create constructor", http.StatusInternalServerError)
-		log.Printf("CreateConstructor error: Failed to create constructor: %v", err)
+		slog.ErrorContext(r.Context(), "CreateConstructor error: Failed to create constructor", "error", err)
 		return
 	}
 
-	h.respond(w, createdConstructor)
+	respond(w, r, http.StatusOK, createdConstructor)
 }
 
 func (h *ConstructorHandler) UpdateConstructor(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
-		return
-	}
-
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
 	var constructor model.Constructor
 	if err := json.NewDecoder(r.Body).Decode(&constructor); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		log.Printf("UpdateConstructor error: Invalid request body: %v", err)
+		slog.WarnContext(r.Context(), "UpdateConstructor error: Invalid request body", "error", err)
 		return
 	}
 
-	updatedConstructor, err := h.service.UpdateConstructor(h.ctx, id, constructor)
+	updatedConstructor, err := h.service.UpdateConstructor(r.Context(), id, constructor)
 	if err != nil {
 		http.Error(w, "Failed to update constructor", http.StatusInternalServerError)
-		log.Printf("UpdateConstructor error: Failed to update constructor: %v", err)
+		slog.ErrorContext(r.Context(), "UpdateConstructor error: Failed to update constructor", "error", err)
 		return
 	}
 
-	h.respond(w, updatedConstructor)
+	respond(w, r, http.StatusOK, updatedConstructor)
 }
 
 func (h *ConstructorHandler) DeleteConstructor(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
+	if err := h.service.DeleteConstructor(r.Context(), id); err != nil {
+		http.Error(w, "Failed to delete constructor", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "DeleteConstructor error: Failed to delete constructor", "error", err)
 		return
 	}
 
-	if err := h.service.DeleteConstructor(h.ctx, id); err != nil {
-		http.Error(w, "Failed to delete constructor", http.StatusInternalServerError)
-		log.Printf("DeleteConstructor error: Failed to delete constructor: %v", err)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ConstructorHandler) RestoreConstructor(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RestoreConstructor(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Deleted constructor not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to restore constructor", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "RestoreConstructor error: Failed to restore constructor", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ConstructorHandler) PurgeConstructor(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeConstructor(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Deleted constructor not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrInUse):
+			http.Error(w, "Cannot purge a constructor that is still referenced", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to purge constructor", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "PurgeConstructor error: Failed to purge constructor", "error", err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (h *ConstructorHandler) BulkUpsertConstructors(w http.ResponseWriter, r *http.Request) {
+	constructors, mode, err := decodeBulk[model.Constructor](r)
+	if err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "BulkUpsertConstructors error: Invalid request body", "error", err)
+		return
+	}
+
+	result, err := h.service.BulkUpsertConstructors(r.Context(), constructors, mode)
+	if err != nil {
+		http.Error(w, "Failed to upsert constructors", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "BulkUpsertConstructors error: Failed to upsert constructors", "error", err)
+		return
+	}
+
+	respondBulk(w, r, result)
+}
+
 // ------------------------
 // Private methods
 // ------------------------
 
-func (h *ConstructorHandler) getAll(w http.ResponseWriter, page, limit int) {
-	constructors, err := h.service.GetAllConstructors(h.ctx, page, limit)
+// stream writes every constructor matching the filters in query, unpaginated, as
+// it is read.
+func (h *ConstructorHandler) stream(w http.ResponseWriter, r *http.Request, query url.Values) {
+	filter := model.ConstructorFilter{
+		Name:        query.Get("name"),
+		Nationality: query.Get("nationality"),
+		Ref:         query.Get("ref"),
+	}
+
+	ctx, cancel := streamContext(r)
+	defer cancel()
+	respondStream(ctx, w, r, h.service.StreamConstructors(ctx, filter), "Failed to fetch constructors")
+}
+
+func (h *ConstructorHandler) getAll(ctx context.Context, w http.ResponseWriter, r *http.Request, page, limit int) {
+	constructors, err := h.service.GetAllConstructors(ctx, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch constructors", http.StatusInternalServerError)
-		log.Printf("GetAll error: %v", err)
+		slog.ErrorContext(ctx, "GetAll error", "error", err)
 		return
 	}
-	h.respond(w, constructors)
+	respond(w, r, http.StatusOK, constructors)
 }
 
-func (h *ConstructorHandler) getByName(w http.ResponseWriter, name string, page, limit int) {
-	constructor, err := h.service.GetConstructorByName(h.ctx, name, page, limit)
+func (h *ConstructorHandler) getByName(ctx context.Context, w http.ResponseWriter, r *http.Request, name string, page, limit int) {
+	constructor, err := h.service.GetConstructorByName(ctx, name, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch constructor by name", http.StatusInternalServerError)
-		log.Printf("GetByName error: %v", err)
+		slog.ErrorContext(ctx, "GetByName error", "error", err)
 		return
 	}
-	h.respond(w, constructor)
+	respond(w, r, http.StatusOK, constructor)
 }
 
-func (h *ConstructorHandler) getByNationality(w http.ResponseWriter, nationality string, page, limit int) {
-	constructors, err := h.service.GetConstructorByNationality(h.ctx, nationality, page, limit)
+func (h *ConstructorHandler) getByNationality(ctx context.Context, w http.ResponseWriter, r *http.Request, nationality string, page, limit int) {
+	constructors, err := h.service.GetConstructorByNationality(ctx, nationality, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch constructors by nationality", http.StatusInternalServerError)
-		log.Printf("GetByNationality error: %v", err)
+		slog.ErrorContext(ctx, "GetByNationality error", "error", err)
 		return
 	}
-	h.respond(w, constructors)
+	respond(w, r, http.StatusOK, constructors)
 }
 
-func (h *ConstructorHandler) getByRef(w http.ResponseWriter, ref string) {
-	constructor, err := h.service.GetConstructorByRef(h.ctx, ref)
+func (h *ConstructorHandler) getByRef(ctx context.Context, w http.ResponseWriter, r *http.Request, ref string) {
+	constructor, err := h.service.GetConstructorByRef(ctx, ref)
 	if err != nil {
 		http.Error(w, "Failed to fetch constructor by ref", http.StatusInternalServerError)
-		log.Printf("GetByRef error: %v", err)
+		slog.ErrorContext(ctx, "GetByRef error", "error", err)
 		return
 	}
 
@@ -180,20 +240,15 @@ func (h *ConstructorHandler) getByRef(w http.ResponseWriter, ref string) {
 		return
 	}
 
-	h.respond(w, constructor)
+	respond(w, r, http.StatusOK, constructor)
 }
 
-func (h *ConstructorHandler) getByID(w http.ResponseWriter, id uuid.UUID) {
-	constructor, err := h.service.GetConstructorByID(h.ctx, id)
+func (h *ConstructorHandler) getByID(ctx context.Context, w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	constructor, err := h.service.GetConstructorByID(ctx, id)
 	if err != nil {
 		http.Error(w, "Constructor not found", http.StatusNotFound)
-		log.Printf("GetByID error: %v", err)
+		slog.ErrorContext(ctx, "GetByID error", "error", err)
 		return
 	}
-	h.respond(w, constructor)
-}
-
-func (h *ConstructorHandler) respond(w http.ResponseWriter, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(data)
+	respond(w, r, http.StatusOK, constructor)
 }
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ChinmayNoob/f1/internal/middleware"
+	"github.com/ChinmayNoob/f1/internal/repository"
+)
+
+// readContext returns the request context, widened to soft-deleted rows when
+// the client asks for ?include_deleted=true.
+func readContext(r *http.Request) context.Context {
+	if r.URL.Query().Get("include_deleted") == "true" {
+		return repository.WithDeleted(r.Context())
+	}
+	return r.Context()
+}
+
+// streamContext returns the read context of r for a streamed list, which
+// runs for the stream timeout rather than the route's.
+func streamContext(r *http.Request) (context.Context, context.CancelFunc) {
+	return middleware.StreamContext(readContext(r))
+}
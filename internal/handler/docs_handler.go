@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ChinmayNoob/f1/internal/openapi"
+)
+
+type DocsHandler struct {
+	spec []byte
+}
+
+// NewDocsHandler serves spec, the encoded OpenAPI document, and a page that
+// renders it.
+func NewDocsHandler(spec []byte) *DocsHandler {
+	return &DocsHandler{
+		spec: spec,
+	}
+}
+
+func (h *DocsHandler) OpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(h.spec)
+}
+
+func (h *DocsHandler) Docs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(openapi.DocsPage)
+}
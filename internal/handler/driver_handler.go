@@ -4,295 +4,394 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strconv"
-	"strings"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
 	"github.com/ChinmayNoob/f1/internal/service"
 	"github.com/ChinmayNoob/f1/internal/utils"
 	"github.com/google/uuid"
 )
 
 type DriverHandler struct {
-	service service.DriverService
-	ctx     context.Context
+	service     service.DriverService
+	maxPageSize int
 }
 
-func NewDriverHandler(ctx context.Context, s service.DriverService) *DriverHandler {
+// NewDriverHandler returns a handler whose lists return at most maxPageSize
+// items a page.
+func NewDriverHandler(s service.DriverService, maxPageSize int) *DriverHandler {
 	return &DriverHandler{
-		service: s,
-		ctx:     ctx,
+		service:     s,
+		maxPageSize: maxPageSize,
 	}
 }
 
 func (h *DriverHandler) GetDriver(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
-	page, limit := utils.ParsePagination(query.Get("page"), query.Get("limit"))
+	if streamed(r) {
+		h.stream(w, r, query)
+		return
+	}
+	page, limit := utils.ParsePagination(query.Get("page"), query.Get("limit"), h.maxPageSize)
 
 	switch {
 	case query.Has("firstName"):
-		h.getByFirstName(w, query.Get("firstName"), page, limit)
+		h.getByFirstName(readContext(r), w, r, query.Get("firstName"), page, limit)
 	case query.Has("lastName"):
-		h.getByLastName(w, query.Get("lastName"), page, limit)
+		h.getByLastName(readContext(r), w, r, query.Get("lastName"), page, limit)
 	case query.Has("team"):
-		h.getByTeam(w, query.Get("team"), page, limit)
+		h.getByTeam(readContext(r), w, r, query.Get("team"), page, limit)
 	case query.Has("nationality"):
-		h.getByNationality(w, query.Get("nationality"), page, limit)
+		h.getByNationality(readContext(r), w, r, query.Get("nationality"), page, limit)
 	case query.Has("status"):
-		h.getByStatus(w, query.Get("status"), page, limit)
+		h.getByStatus(readContext(r), w, r, query.Get("status"), page, limit)
 	case query.Has("ref"):
-		h.getByRef(w, query.Get("ref"))
+		h.getByRef(readContext(r), w, r, query.Get("ref"))
 	case query.Has("code"):
-		h.getByCode(w, query.Get("code"))
+		h.getByCode(readContext(r), w, r, query.Get("code"))
 	case query.Has("number"):
 		number, err := strconv.Atoi(query.Get("number"))
 		if err != nil {
 			http.Error(w, "Invalid number format", http.StatusBadRequest)
 			return
 		}
-		h.getByNumber(w, number)
+		h.getByNumber(readContext(r), w, r, number)
 	case query.Has("url"):
-		h.getByURL(w, query.Get("url"))
+		h.getByURL(readContext(r), w, r, query.Get("url"))
 	default:
-		h.getAll(w, page, limit)
+		h.getAll(readContext(r), w, r, page, limit)
 	}
 }
 
 func (h *DriverHandler) GetDriverByID(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
-		return
-	}
+	h.getByID(readContext(r), w, r, id)
+}
 
-	h.getByID(w, id)
+// ResolveRef returns the ID of the driver with ref, for routes that accept
+// a ref in place of the ID.
+func (h *DriverHandler) ResolveRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	return h.service.ResolveDriverRef(ctx, ref)
 }
 
 func (h *DriverHandler) CreateDriver(w http.ResponseWriter, r *http.Request) {
 	var driver model.Driver
 	if err := json.NewDecoder(r.Body).Decode(&driver); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		log.Printf("CreateDriver error: Invalid request body: %v", err)
+		slog.WarnContext(r.Context(), "CreateDriver error: Invalid request body", "error", err)
 		return
 	}
 
-	createdDriver, err := h.service.CreateDriver(h.ctx, driver)
+	createdDriver, err := h.service.CreateDriver(r.Context(), driver)
 	if err != nil {
-		if errors.Is(err, errors.New("constructor not found")) {
+		if errors.Is(err, repository.ErrConstructorNotFound) {
 			http.Error(w, "Constructor not found", http.StatusBadRequest)
 		} else {
 			http.Error(w, "Failed to create driver", http.StatusInternalServerError)
 		}
-		log.Printf("CreateDriver error: Failed to create driver: %v", err)
+		slog.ErrorContext(r.Context(), "CreateDriver error: Failed to create driver", "error", err)
 		return
 	}
 
-	h.respond(w, createdDriver)
+	respond(w, r, http.StatusOK, createdDriver)
 }
 
 func (h *DriverHandler) UpdateDriver(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
-		return
-	}
-
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
 	var driver model.Driver
 	if err := json.NewDecoder(r.Body).Decode(&driver); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		log.Printf("UpdateDriver error: Invalid request body: %v", err)
+		slog.WarnContext(r.Context(), "UpdateDriver error: Invalid request body", "error", err)
 		return
 	}
 
-	updatedDriver, err := h.service.UpdateDriver(h.ctx, id, driver)
+	updatedDriver, err := h.service.UpdateDriver(r.Context(), id, driver)
 	if err != nil {
-		if errors.Is(err, errors.New("constructor not found")) {
+		if errors.Is(err, repository.ErrConstructorNotFound) {
 			http.Error(w, "Constructor not found", http.StatusBadRequest)
 		} else {
 			http.Error(w, "Failed to update driver", http.StatusInternalServerError)
 		}
-		log.Printf("UpdateDriver error: Failed to update driver: %v", err)
+		slog.ErrorContext(r.Context(), "UpdateDriver error: Failed to update driver", "error", err)
 		return
 	}
 
-	h.respond(w, updatedDriver)
+	respond(w, r, http.StatusOK, updatedDriver)
 }
 
 func (h *DriverHandler) DeleteDriver(w http.ResponseWriter, r *http.Request) {
-	parts := strings.Split(r.URL.Path, "/")
-	if len(parts) < 3 {
-		http.Error(w, "Missing ID", http.StatusBadRequest)
+	id, ok := pathID(w, r)
+	if !ok {
 		return
 	}
 
-	idStr := parts[2]
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		http.Error(w, "Invalid ID format", http.StatusBadRequest)
-		log.Printf("Error: Invalid ID format: %v", err)
+	if err := h.service.DeleteDriver(r.Context(), id); err != nil {
+		http.Error(w, "Failed to delete driver", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "DeleteDriver error: Failed to delete driver", "error", err)
 		return
 	}
 
-	if err := h.service.DeleteDriver(h.ctx, id); err != nil {
-		http.Error(w, "Failed to delete driver", http.StatusInternalServerError)
-		log.Printf("DeleteDriver error: Failed to delete driver: %v", err)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *DriverHandler) RestoreDriver(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RestoreDriver(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Deleted driver not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to restore driver", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "RestoreDriver error: Failed to restore driver", "error", err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (h *DriverHandler) PurgeDriver(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeDriver(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Deleted driver not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrInUse):
+			http.Error(w, "Cannot purge a driver that is still referenced", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to purge driver", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "PurgeDriver error: Failed to purge driver", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *DriverHandler) MergeDriver(w http.ResponseWriter, r *http.Request) {
+	sourceID, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var payload struct {
+		Into uuid.UUID `json:"into"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.Into == (uuid.UUID{}) {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "MergeDriver error: Invalid request body", "error", err)
+		return
+	}
+
+	driver, err := h.service.MergeDrivers(r.Context(), sourceID, payload.Into)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrSelfMerge):
+			http.Error(w, "Cannot merge a driver into itself", http.StatusBadRequest)
+		case errors.Is(err, service.ErrDriverNotFound):
+			http.Error(w, "Driver not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrDriverResultsOverlap):
+			http.Error(w, "Both drivers have results in the same race", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to merge drivers", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "MergeDriver error: Failed to merge drivers", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusOK, driver)
+}
+
+func (h *DriverHandler) BulkUpsertDrivers(w http.ResponseWriter, r *http.Request) {
+	drivers, mode, err := decodeBulk[model.Driver](r)
+	if err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "BulkUpsertDrivers error: Invalid request body", "error", err)
+		return
+	}
+
+	result, err := h.service.BulkUpsertDrivers(r.Context(), drivers, mode)
+	if err != nil {
+		http.Error(w, "Failed to upsert drivers", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "BulkUpsertDrivers error: Failed to upsert drivers", "error", err)
+		return
+	}
+
+	respondBulk(w, r, result)
+}
+
 // ------------------------
 // Private methods
 // ------------------------
 
-func (h *DriverHandler) getAll(w http.ResponseWriter, page, limit int) {
-	drivers, err := h.service.GetAllDrivers(h.ctx, page, limit)
+// stream writes every driver matching the filters in query, unpaginated, as
+// it is read.
+func (h *DriverHandler) stream(w http.ResponseWriter, r *http.Request, query url.Values) {
+	filter := model.DriverFilter{
+		FirstName:   query.Get("firstName"),
+		LastName:    query.Get("lastName"),
+		Team:        query.Get("team"),
+		Nationality: query.Get("nationality"),
+		Status:      query.Get("status"),
+		Ref:         query.Get("ref"),
+		Code:        query.Get("code"),
+		URL:         query.Get("url"),
+	}
+	if query.Has("number") {
+		number, err := strconv.Atoi(query.Get("number"))
+		if err != nil {
+			http.Error(w, "Invalid number format", http.StatusBadRequest)
+			return
+		}
+		filter.Number = &number
+	}
+
+	ctx, cancel := streamContext(r)
+	defer cancel()
+	respondStream(ctx, w, r, h.service.StreamDrivers(ctx, filter), "Failed to fetch drivers")
+}
+
+func (h *DriverHandler) getAll(ctx context.Context, w http.ResponseWriter, r *http.Request, page, limit int) {
+	drivers, err := h.service.GetAllDrivers(ctx, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch drivers", http.StatusInternalServerError)
-		log.Printf("GetAll error: %v", err)
+		slog.ErrorContext(ctx, "GetAll error", "error", err)
 		return
 	}
-	h.respond(w, drivers)
+	respond(w, r, http.StatusOK, drivers)
 }
 
-func (h *DriverHandler) getByFirstName(w http.ResponseWriter, firstName string, page, limit int) {
-	drivers, err := h.service.GetDriverByFirstName(h.ctx, firstName, page, limit)
+func (h *DriverHandler) getByFirstName(ctx context.Context, w http.ResponseWriter, r *http.Request, firstName string, page, limit int) {
+	drivers, err := h.service.GetDriverByFirstName(ctx, firstName, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch drivers by first name", http.StatusInternalServerError)
-		log.Printf("GetByFirstName error: %v", err)
+		slog.ErrorContext(ctx, "GetByFirstName error", "error", err)
 		return
 	}
-	h.respond(w, drivers)
+	respond(w, r, http.StatusOK, drivers)
 }
 
-func (h *DriverHandler) getByLastName(w http.ResponseWriter, lastName string, page, limit int) {
-	drivers, err := h.service.GetDriverByLastName(h.ctx, lastName, page, limit)
+func (h *DriverHandler) getByLastName(ctx context.Context, w http.ResponseWriter, r *http.Request, lastName string, page, limit int) {
+	drivers, err := h.service.GetDriverByLastName(ctx, lastName, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch drivers by last name", http.StatusInternalServerError)
-		log.Printf("GetByLastName error: %v", err)
+		slog.ErrorContext(ctx, "GetByLastName error", "error", err)
 		return
 	}
-	h.respond(w, drivers)
+	respond(w, r, http.StatusOK, drivers)
 }
 
-func (h *DriverHandler) getByTeam(w http.ResponseWriter, team string, page, limit int) {
-	drivers, err := h.service.GetDriverByTeam(h.ctx, team, page, limit)
+func (h *DriverHandler) getByTeam(ctx context.Context, w http.ResponseWriter, r *http.Request, team string, page, limit int) {
+	drivers, err := h.service.GetDriverByTeam(ctx, team, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch drivers by team", http.StatusInternalServerError)
-		log.Printf("GetByTeam error: %v", err)
+		slog.ErrorContext(ctx, "GetByTeam error", "error", err)
 		return
 	}
-	h.respond(w, drivers)
+	respond(w, r, http.StatusOK, drivers)
 }
 
-func (h *DriverHandler) getByNationality(w http.ResponseWriter, nationality string, page, limit int) {
-	drivers, err := h.service.GetDriverByNationality(h.ctx, nationality, page, limit)
+func (h *DriverHandler) getByNationality(ctx context.Context, w http.ResponseWriter, r *http.Request, nationality string, page, limit int) {
+	drivers, err := h.service.GetDriverByNationality(ctx, nationality, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch drivers by nationality", http.StatusInternalServerError)
-		log.Printf("GetByNationality error: %v", err)
+		slog.ErrorContext(ctx, "GetByNationality error", "error", err)
 		return
 	}
-	h.respond(w, drivers)
+	respond(w, r, http.StatusOK, drivers)
 }
 
-func (h *DriverHandler) getByStatus(w http.ResponseWriter, status string, page, limit int) {
-	drivers, err := h.service.GetDriverByStatus(h.ctx, status, page, limit)
+func (h *DriverHandler) getByStatus(ctx context.Context, w http.ResponseWriter, r *http.Request, status string, page, limit int) {
+	drivers, err := h.service.GetDriverByStatus(ctx, status, page, limit)
 	if err != nil {
 		http.Error(w, "Failed to fetch drivers by status", http.StatusInternalServerError)
-		log.Printf("GetByStatus error: %v", err)
+		slog.ErrorContext(ctx, "GetByStatus error", "error", err)
 		return
 	}
-	h.respond(w, drivers)
+	respond(w, r, http.StatusOK, drivers)
 }
 
-func (h *DriverHandler) getByRef(w http.ResponseWriter, ref string) {
-	driver, err := h.service.GetDriverByRef(h.ctx, ref)
+func (h *DriverHandler) getByRef(ctx context.Context, w http.ResponseWriter, r *http.Request, ref string) {
+	driver, err := h.service.GetDriverByRef(ctx, ref)
 	if err != nil {
 		http.Error(w, "Failed to fetch driver by ref", http.StatusInternalServerError)
-		log.Printf("GetByRef error: %v", err)
+		slog.ErrorContext(ctx, "GetByRef error", "error", err)
 		return
 	}
 	if driver.ID == (uuid.UUID{}) {
 		http.Error(w, "Driver not found", http.StatusNotFound)
 		return
 	}
-	h.respond(w, driver)
+	respond(w, r, http.StatusOK, driver)
 }
 
-func (h *DriverHandler) getByCode(w http.ResponseWriter, code string) {
-	driver, err := h.service.GetDriverByCode(h.ctx, code)
+func (h *DriverHandler) getByCode(ctx context.Context, w http.ResponseWriter, r *http.Request, code string) {
+	driver, err := h.service.GetDriverByCode(ctx, code)
 	if err != nil {
 		http.Error(w, "Failed to fetch driver by code", http.StatusInternalServerError)
-		log.Printf("GetByCode error: %v", err)
+		slog.ErrorContext(ctx, "GetByCode error", "error", err)
 		return
 	}
 	if driver.ID == (uuid.UUID{}) {
 		http.Error(w, "Driver not found", http.StatusNotFound)
 		return
 	}
-	h.respond(w, driver)
+	respond(w, r, http.StatusOK, driver)
 }
 
-func (h *DriverHandler) getByNumber(w http.ResponseWriter, number int) {
-	driver, err := h.service.GetDriverByNumber(h.ctx, number)
+func (h *DriverHandler) getByNumber(ctx context.Context, w http.ResponseWriter, r *http.Request, number int) {
+	driver, err := h.service.GetDriverByNumber(ctx, number)
 	if err != nil {
 		http.Error(w, "Failed to fetch driver by number", http.StatusInternalServerError)
-		log.Printf("GetByNumber error: %v", err)
+		slog.ErrorContext(ctx, "GetByNumber error", "error", err)
 		return
 	}
 	if driver.ID == (uuid.UUID{}) {
 		http.Error(w, "Driver not found", http.StatusNotFound)
 		return
 	}
-	h.respond(w, driver)
+	respond(w, r, http.StatusOK, driver)
 }
 
-func (h *DriverHandler) getByURL(w http.ResponseWriter, url string) {
-	driver, err := h.service.GetDriverByURL(h.ctx, url)
+func (h *DriverHandler) getByURL(ctx context.Context, w http.ResponseWriter, r *http.Request, url string) {
+	driver, err := h.service.GetDriverByURL(ctx, url)
 	if err != nil {
 		http.Error(w, "Failed to fetch driver by URL", http.StatusInternalServerError)
-		log.Printf("GetByURL error: %v", err)
+		slog.ErrorContext(ctx, "GetByURL error", "error", err)
 		return
 	}
 	if driver.ID == (uuid.UUID{}) {
 		http.Error(w, "Driver not found", http.StatusNotFound)
 		return
 	}
-	h.respond(w, driver)
+	respond(w, r, http.StatusOK, driver)
 }
 
-func (h *DriverHandler) getByID(w http.ResponseWriter, id uuid.UUID) {
-	driver, err := h.service.GetDriverByID(h.ctx, id)
+func (h *DriverHandler) getByID(ctx context.Context, w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	driver, err := h.service.GetDriverByID(ctx, id)
 	if err != nil {
 		http.Error(w, "Driver not found", http.StatusNotFound)
-		log.Printf("GetByID error: %v", err)
+		slog.ErrorContext(ctx, "GetByID error", "error", err)
 		return
 	}
-	h.respond(w, driver)
-}
-
-func (h *DriverHandler) respond(w http.ResponseWriter, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(data); err != nil {
-		log.Printf("Failed to encode response: %v", err)
-	}
+	respond(w, r, http.StatusOK, driver)
 }
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ChinmayNoob/f1/internal/graphqlapi"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/render"
+)
+
+type GraphQLHandler struct {
+	schema *graphqlapi.Schema
+}
+
+func NewGraphQLHandler(schema *graphqlapi.Schema) *GraphQLHandler {
+	return &GraphQLHandler{schema: schema}
+}
+
+// Query runs a GraphQL query. Errors in the query are reported in the
+// response's errors, with status 200, as GraphQL clients expect; the
+// response is always JSON.
+func (h *GraphQLHandler) Query(w http.ResponseWriter, r *http.Request) {
+	var req model.GraphQLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "Query error: Invalid request body", "error", err)
+		return
+	}
+	if req.Query == "" {
+		http.Error(w, "Invalid request body: query is required", http.StatusBadRequest)
+		return
+	}
+
+	resp := h.schema.Execute(r.Context(), req)
+	if err := render.Write(w, render.JSON, http.StatusOK, resp); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to encode response", "error", err)
+	}
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/service"
+)
+
+type HealthHandler struct {
+	service service.HealthService
+}
+
+func NewHealthHandler(s service.HealthService) *HealthHandler {
+	return &HealthHandler{
+		service: s,
+	}
+}
+
+// Live reports that the process is up and serving HTTP. It checks nothing
+// else, so a database outage does not get the process restarted.
+func (h *HealthHandler) Live(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(map[string]string{"status": model.HealthOK}); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to encode response", "error", err)
+	}
+}
+
+// Ready reports whether the server can take traffic: 200 when every
+// dependency is ok, 503 otherwise, with the status of each as JSON.
+func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) {
+	readiness := h.service.Ready(r.Context())
+
+	status := http.StatusOK
+	if readiness.Status != model.HealthReady {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(readiness); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to encode response", "error", err)
+	}
+}
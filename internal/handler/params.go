@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/google/uuid"
+)
+
+// pathID parses the {id} wildcard of the matched route. It answers 400 and
+// returns false when the value is not a UUID.
+func pathID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid ID format", http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "Error: Invalid ID format", "error", err)
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
+
+// pathInt parses an integer wildcard of the matched route, such as {year} or
+// {round}. It answers 400 and returns false when the value is not a number.
+func pathInt(w http.ResponseWriter, r *http.Request, name string) (int, bool) {
+	n, err := strconv.Atoi(r.PathValue(name))
+	if err != nil {
+		http.Error(w, "Invalid "+name, http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "Error: Invalid "+name, "error", err)
+		return 0, false
+	}
+	return n, true
+}
+
+// RefResolver returns the ID of the record named by ref, or
+// repository.ErrNotFound.
+type RefResolver func(ctx context.Context, ref string) (uuid.UUID, error)
+
+// ByRef lets the {id} wildcard of h's route be a ref as well as a UUID. A ref
+// is replaced by the ID it resolves to before h runs, so h only sees IDs.
+func ByRef(resolve RefResolver, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		value := r.PathValue("id")
+		if _, err := uuid.Parse(value); err != nil {
+			id, err := resolve(r.Context(), value)
+			if err != nil {
+				if errors.Is(err, repository.ErrNotFound) {
+					http.Error(w, "Not found", http.StatusNotFound)
+				} else {
+					http.Error(w, "Failed to resolve ref", http.StatusInternalServerError)
+					slog.ErrorContext(r.Context(), "ByRef error: Failed to resolve ref", "error", err)
+				}
+				return
+			}
+			r.SetPathValue("id", id.String())
+		}
+		h(w, r)
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/service"
+)
+
+type QueryStatsHandler struct {
+	service service.QueryStatsService
+}
+
+func NewQueryStatsHandler(s service.QueryStatsService) *QueryStatsHandler {
+	return &QueryStatsHandler{
+		service: s,
+	}
+}
+
+// GetQueryStats serves call counts and latency per SQL statement, the ones
+// that took the most time in total first.
+func (h *QueryStatsHandler) GetQueryStats(w http.ResponseWriter, r *http.Request) {
+	stats := h.service.GetQueryStats(r.Context())
+
+	respond(w, r, http.StatusOK, stats)
+}
+
+// ExplainQuery serves the EXPLAIN output of a statement for the arguments of
+// its last call. ?analyze=true runs the statement in a rolled back
+// transaction to report actual timings; only reads can be analyzed.
+func (h *QueryStatsHandler) ExplainQuery(w http.ResponseWriter, r *http.Request) {
+	analyze := r.URL.Query().Get("analyze") == "true"
+
+	plan, err := h.service.ExplainStatement(r.Context(), r.PathValue("id"), analyze)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Statement not found", http.StatusNotFound)
+		case errors.Is(err, service.ErrNotExplainable), errors.Is(err, service.ErrNotAnalyzable):
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		default:
+			http.Error(w, "Failed to explain statement", http.StatusInternalServerError)
+			slog.ErrorContext(r.Context(), "ExplainQuery error: Failed to explain statement", "error", err)
+		}
+		return
+	}
+
+	respond(w, r, http.StatusOK, plan)
+}
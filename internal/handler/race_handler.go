@@ -1,27 +1,48 @@
 package handler
 
 import (
-	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
 
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
 	"github.com/ChinmayNoob/f1/internal/service"
 )
 
 type RaceHandler struct {
-	ctx     context.Context
 	service service.RaceService
 }
 
-func NewRaceHandler(ctx context.Context, service service.RaceService) *RaceHandler {
+func NewRaceHandler(service service.RaceService) *RaceHandler {
 	return &RaceHandler{
-		ctx:     ctx,
 		service: service,
 	}
 }
 
 func (h *RaceHandler) CreateRace(w http.ResponseWriter, r *http.Request) {
-	// TODO: Implement handler logic
-	w.WriteHeader(http.StatusCreated)
+	var payload model.RaceWithResults
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "CreateRace error: Invalid request body", "error", err)
+		return
+	}
+	for _, result := range payload.Results {
+		if result == nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			slog.WarnContext(r.Context(), "CreateRace error: Invalid request body: null result")
+			return
+		}
+	}
+
+	if err := h.service.CreateRaceWithResults(r.Context(), &payload.Race, payload.Results); err != nil {
+		http.Error(w, "Failed to create race", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "CreateRace error: Failed to create race", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusCreated, payload)
 }
 
 func (h *RaceHandler) GetRace(w http.ResponseWriter, r *http.Request) {
@@ -34,12 +55,123 @@ func (h *RaceHandler) GetRaceByID(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetSeasonRaces serves the races of the season in {year}.
+func (h *RaceHandler) GetSeasonRaces(w http.ResponseWriter, r *http.Request) {
+	year, ok := pathInt(w, r, "year")
+	if !ok {
+		return
+	}
+
+	races, err := h.service.GetSeasonRaces(readContext(r), year)
+	if err != nil {
+		http.Error(w, "Failed to get races", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "GetSeasonRaces error: Failed to get races", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusOK, races)
+}
+
+// GetSeasonRace serves round {round} of the season in {year}.
+func (h *RaceHandler) GetSeasonRace(w http.ResponseWriter, r *http.Request) {
+	year, ok := pathInt(w, r, "year")
+	if !ok {
+		return
+	}
+	round, ok := pathInt(w, r, "round")
+	if !ok {
+		return
+	}
+
+	race, err := h.service.GetSeasonRace(readContext(r), year, round)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Race not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to get race", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "GetSeasonRace error: Failed to get race", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusOK, race)
+}
+
 func (h *RaceHandler) UpdateRace(w http.ResponseWriter, r *http.Request) {
 	// TODO: Implement handler logic
 	w.WriteHeader(http.StatusOK)
 }
 
 func (h *RaceHandler) DeleteRace(w http.ResponseWriter, r *http.Request) {
-	// TODO: Implement handler logic
-	w.WriteHeader(http.StatusOK)
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteRace(r.Context(), id); err != nil {
+		http.Error(w, "Failed to delete race", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "DeleteRace error: Failed to delete race", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RaceHandler) RestoreRace(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RestoreRace(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Deleted race not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to restore race", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "RestoreRace error: Failed to restore race", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RaceHandler) PurgeRace(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeRace(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Deleted race not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrInUse):
+			http.Error(w, "Cannot purge a race that is still referenced", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to purge race", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "PurgeRace error: Failed to purge race", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *RaceHandler) BulkUpsertRaces(w http.ResponseWriter, r *http.Request) {
+	races, mode, err := decodeBulk[model.Race](r)
+	if err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "BulkUpsertRaces error: Invalid request body", "error", err)
+		return
+	}
+
+	result, err := h.service.BulkUpsertRaces(r.Context(), pointers(races), mode)
+	if err != nil {
+		http.Error(w, "Failed to upsert races", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "BulkUpsertRaces error: Failed to upsert races", "error", err)
+		return
+	}
+
+	respondBulk(w, r, result)
 }
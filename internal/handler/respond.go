@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"iter"
+	"log/slog"
+	"net/http"
+
+	"github.com/ChinmayNoob/f1/internal/render"
+)
+
+// respond writes data with status in the format r negotiates, JSON unless
+// the client asks for CSV, XML or YAML with ?format= or Accept.
+func respond(w http.ResponseWriter, r *http.Request, status int, data interface{}) {
+	format, err := render.Negotiate(r)
+	if err != nil {
+		format = render.JSON
+	}
+	if err := render.Write(w, format, status, data); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to encode response", "error", err)
+	}
+}
+
+// streamed reports whether r asks for a list to be streamed whole instead of
+// paginated: with ?stream=true, or as NDJSON.
+func streamed(r *http.Request) bool {
+	if r.URL.Query().Get("stream") == "true" {
+		return true
+	}
+	format, err := render.Negotiate(r)
+	return err == nil && format == render.NDJSON
+}
+
+// respondStream writes the list seq yields while it is read from the
+// database with ctx, in the format r negotiates. message is the error
+// answered when seq fails before its first value; later failures can only be
+// logged.
+func respondStream[T any](ctx context.Context, w http.ResponseWriter, r *http.Request, seq iter.Seq2[T, error], message string) {
+	format, err := render.Negotiate(r)
+	if err != nil {
+		format = render.JSON
+	}
+
+	started, err := render.Stream(w, format, seq)
+	switch {
+	case err == nil:
+	case !started:
+		http.Error(w, message, http.StatusInternalServerError)
+		slog.ErrorContext(ctx, "Stream error: "+message, "error", err)
+	case ctx.Err() != nil:
+		slog.WarnContext(ctx, "Stream error: Stream cut short", "error", err)
+	default:
+		slog.ErrorContext(ctx, "Stream error: Stream failed", "error", err)
+	}
+}
@@ -1,20 +1,21 @@
 package handler
 
 import (
-	"context"
+	"errors"
+	"log/slog"
 	"net/http"
 
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
 	"github.com/ChinmayNoob/f1/internal/service"
 )
 
 type ResultHandler struct {
-	ctx     context.Context
 	service service.ResultService
 }
 
-func NewResultHandler(ctx context.Context, service service.ResultService) *ResultHandler {
+func NewResultHandler(service service.ResultService) *ResultHandler {
 	return &ResultHandler{
-		ctx:     ctx,
 		service: service,
 	}
 }
@@ -34,12 +35,120 @@ func (h *ResultHandler) GetResultByID(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetRaceResults serves the results of the race {id}.
+func (h *ResultHandler) GetRaceResults(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	results, err := h.service.GetRaceResults(readContext(r), id)
+	if err != nil {
+		http.Error(w, "Failed to get results", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "GetRaceResults error: Failed to get results", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusOK, results)
+}
+
+// GetSeasonRaceResults serves the results of round {round} of the season in
+// {year}.
+func (h *ResultHandler) GetSeasonRaceResults(w http.ResponseWriter, r *http.Request) {
+	year, ok := pathInt(w, r, "year")
+	if !ok {
+		return
+	}
+	round, ok := pathInt(w, r, "round")
+	if !ok {
+		return
+	}
+
+	results, err := h.service.GetSeasonRaceResults(readContext(r), year, round)
+	if err != nil {
+		http.Error(w, "Failed to get results", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "GetSeasonRaceResults error: Failed to get results", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusOK, results)
+}
+
 func (h *ResultHandler) UpdateResult(w http.ResponseWriter, r *http.Request) {
 	// TODO: Implement handler logic
 	w.WriteHeader(http.StatusOK)
 }
 
 func (h *ResultHandler) DeleteResult(w http.ResponseWriter, r *http.Request) {
-	// TODO: Implement handler logic
-	w.WriteHeader(http.StatusOK)
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteResult(r.Context(), id); err != nil {
+		http.Error(w, "Failed to delete result", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "DeleteResult error: Failed to delete result", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ResultHandler) RestoreResult(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RestoreResult(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Deleted result not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to restore result", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "RestoreResult error: Failed to restore result", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ResultHandler) PurgeResult(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeResult(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Deleted result not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrInUse):
+			http.Error(w, "Cannot purge a result that is still referenced", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to purge result", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "PurgeResult error: Failed to purge result", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ResultHandler) BulkUpsertResults(w http.ResponseWriter, r *http.Request) {
+	results, mode, err := decodeBulk[model.Result](r)
+	if err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "BulkUpsertResults error: Invalid request body", "error", err)
+		return
+	}
+
+	result, err := h.service.BulkUpsertResults(r.Context(), pointers(results), mode)
+	if err != nil {
+		http.Error(w, "Failed to upsert results", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "BulkUpsertResults error: Failed to upsert results", "error", err)
+		return
+	}
+
+	respondBulk(w, r, result)
 }
@@ -2,23 +2,37 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"log/slog"
 	"net/http"
+	"strconv"
 
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
 	"github.com/ChinmayNoob/f1/internal/service"
+	"github.com/google/uuid"
 )
 
 type SeasonHandler struct {
-	ctx     context.Context
 	service service.SeasonService
 }
 
-func NewSeasonHandler(ctx context.Context, service service.SeasonService) *SeasonHandler {
+func NewSeasonHandler(service service.SeasonService) *SeasonHandler {
 	return &SeasonHandler{
-		ctx:     ctx,
 		service: service,
 	}
 }
 
+// ResolveRef returns the ID of the season held in the year ref, for routes
+// that accept a year in place of the ID.
+func (h *SeasonHandler) ResolveRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	year, err := strconv.Atoi(ref)
+	if err != nil {
+		return uuid.UUID{}, repository.ErrNotFound
+	}
+	return h.service.ResolveSeasonYear(ctx, year)
+}
+
 func (h *SeasonHandler) CreateSeason(w http.ResponseWriter, r *http.Request) {
 	// TODO: Implement handler logic
 	w.WriteHeader(http.StatusCreated)
@@ -40,6 +54,75 @@ func (h *SeasonHandler) UpdateSeason(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *SeasonHandler) DeleteSeason(w http.ResponseWriter, r *http.Request) {
-	// TODO: Implement handler logic
-	w.WriteHeader(http.StatusOK)
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteSeason(r.Context(), id); err != nil {
+		http.Error(w, "Failed to delete season", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "DeleteSeason error: Failed to delete season", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SeasonHandler) RestoreSeason(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RestoreSeason(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Deleted season not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to restore season", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "RestoreSeason error: Failed to restore season", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SeasonHandler) PurgeSeason(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeSeason(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Deleted season not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrInUse):
+			http.Error(w, "Cannot purge a season that is still referenced", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to purge season", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "PurgeSeason error: Failed to purge season", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SeasonHandler) BulkUpsertSeasons(w http.ResponseWriter, r *http.Request) {
+	seasons, mode, err := decodeBulk[model.Season](r)
+	if err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "BulkUpsertSeasons error: Invalid request body", "error", err)
+		return
+	}
+
+	result, err := h.service.BulkUpsertSeasons(r.Context(), pointers(seasons), mode)
+	if err != nil {
+		http.Error(w, "Failed to upsert seasons", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "BulkUpsertSeasons error: Failed to upsert seasons", "error", err)
+		return
+	}
+
+	respondBulk(w, r, result)
 }
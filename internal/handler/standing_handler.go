@@ -1,20 +1,21 @@
 package handler
 
 import (
-	"context"
+	"errors"
+	"log/slog"
 	"net/http"
 
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
 	"github.com/ChinmayNoob/f1/internal/service"
 )
 
 type StandingHandler struct {
-	ctx     context.Context
 	service service.StandingService
 }
 
-func NewStandingHandler(ctx context.Context, service service.StandingService) *StandingHandler {
+func NewStandingHandler(service service.StandingService) *StandingHandler {
 	return &StandingHandler{
-		ctx:     ctx,
 		service: service,
 	}
 }
@@ -34,14 +35,83 @@ func (h *StandingHandler) GetDriverStandingByID(w http.ResponseWriter, r *http.R
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetSeasonDriverStandings serves the driver standings of the season in
+// {year}.
+func (h *StandingHandler) GetSeasonDriverStandings(w http.ResponseWriter, r *http.Request) {
+	year, ok := pathInt(w, r, "year")
+	if !ok {
+		return
+	}
+
+	standings, err := h.service.GetSeasonDriverStandings(readContext(r), year)
+	if err != nil {
+		http.Error(w, "Failed to get driver standings", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "GetSeasonDriverStandings error: Failed to get driver standings", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusOK, standings)
+}
+
 func (h *StandingHandler) UpdateDriverStanding(w http.ResponseWriter, r *http.Request) {
 	// TODO: Implement handler logic
 	w.WriteHeader(http.StatusOK)
 }
 
 func (h *StandingHandler) DeleteDriverStanding(w http.ResponseWriter, r *http.Request) {
-	// TODO: Implement handler logic
-	w.WriteHeader(http.StatusOK)
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteDriverStanding(r.Context(), id); err != nil {
+		http.Error(w, "Failed to delete driver standing", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "DeleteDriverStanding error: Failed to delete driver standing", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *StandingHandler) RestoreDriverStanding(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RestoreDriverStanding(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Deleted driver standing not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to restore driver standing", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "RestoreDriverStanding error: Failed to restore driver standing", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *StandingHandler) PurgeDriverStanding(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeDriverStanding(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Deleted driver standing not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrInUse):
+			http.Error(w, "Cannot purge a driver standing that is still referenced", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to purge driver standing", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "PurgeDriverStanding error: Failed to purge driver standing", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
 
 func (h *StandingHandler) CreateConstructorStanding(w http.ResponseWriter, r *http.Request) {
@@ -59,12 +129,117 @@ func (h *StandingHandler) GetConstructorStandingByID(w http.ResponseWriter, r *h
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetSeasonConstructorStandings serves the constructor standings of the
+// season in {year}.
+func (h *StandingHandler) GetSeasonConstructorStandings(w http.ResponseWriter, r *http.Request) {
+	year, ok := pathInt(w, r, "year")
+	if !ok {
+		return
+	}
+
+	standings, err := h.service.GetSeasonConstructorStandings(readContext(r), year)
+	if err != nil {
+		http.Error(w, "Failed to get constructor standings", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "GetSeasonConstructorStandings error: Failed to get constructor standings", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusOK, standings)
+}
+
 func (h *StandingHandler) UpdateConstructorStanding(w http.ResponseWriter, r *http.Request) {
 	// TODO: Implement handler logic
 	w.WriteHeader(http.StatusOK)
 }
 
 func (h *StandingHandler) DeleteConstructorStanding(w http.ResponseWriter, r *http.Request) {
-	// TODO: Implement handler logic
-	w.WriteHeader(http.StatusOK)
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteConstructorStanding(r.Context(), id); err != nil {
+		http.Error(w, "Failed to delete constructor standing", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "DeleteConstructorStanding error: Failed to delete constructor standing", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *StandingHandler) RestoreConstructorStanding(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RestoreConstructorStanding(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "Deleted constructor standing not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to restore constructor standing", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "RestoreConstructorStanding error: Failed to restore constructor standing", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *StandingHandler) PurgeConstructorStanding(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.PurgeConstructorStanding(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			http.Error(w, "Deleted constructor standing not found", http.StatusNotFound)
+		case errors.Is(err, repository.ErrInUse):
+			http.Error(w, "Cannot purge a constructor standing that is still referenced", http.StatusConflict)
+		default:
+			http.Error(w, "Failed to purge constructor standing", http.StatusInternalServerError)
+		}
+		slog.ErrorContext(r.Context(), "PurgeConstructorStanding error: Failed to purge constructor standing", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *StandingHandler) BulkUpsertDriverStandings(w http.ResponseWriter, r *http.Request) {
+	standings, mode, err := decodeBulk[model.DriverStanding](r)
+	if err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "BulkUpsertDriverStandings error: Invalid request body", "error", err)
+		return
+	}
+
+	result, err := h.service.BulkUpsertDriverStandings(r.Context(), pointers(standings), mode)
+	if err != nil {
+		http.Error(w, "Failed to upsert driver standings", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "BulkUpsertDriverStandings error: Failed to upsert driver standings", "error", err)
+		return
+	}
+
+	respondBulk(w, r, result)
+}
+
+func (h *StandingHandler) BulkUpsertConstructorStandings(w http.ResponseWriter, r *http.Request) {
+	standings, mode, err := decodeBulk[model.ConstructorStanding](r)
+	if err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		slog.WarnContext(r.Context(), "BulkUpsertConstructorStandings error: Invalid request body", "error", err)
+		return
+	}
+
+	result, err := h.service.BulkUpsertConstructorStandings(r.Context(), pointers(standings), mode)
+	if err != nil {
+		http.Error(w, "Failed to upsert constructor standings", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "BulkUpsertConstructorStandings error: Failed to upsert constructor standings", "error", err)
+		return
+	}
+
+	respondBulk(w, r, result)
 }
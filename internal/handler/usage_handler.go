@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/service"
+)
+
+const defaultUsageWindow = 24 * time.Hour
+
+type UsageHandler struct {
+	service service.UsageService
+}
+
+func NewUsageHandler(s service.UsageService) *UsageHandler {
+	return &UsageHandler{
+		service: s,
+	}
+}
+
+// GetUsageReport serves usage per client and route between ?from= and ?to=
+// (RFC 3339, the last 24 hours by default), optionally narrowed with
+// ?client= and ?route=.
+func (h *UsageHandler) GetUsageReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	to := time.Now()
+	if v := query.Get("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, "Invalid to, expected RFC 3339", http.StatusBadRequest)
+			return
+		}
+		to = t
+	}
+	from := to.Add(-defaultUsageWindow)
+	if v := query.Get("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, "Invalid from, expected RFC 3339", http.StatusBadRequest)
+			return
+		}
+		from = t
+	}
+	if !from.Before(to) {
+		http.Error(w, "from must be before to", http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.service.GetUsageReport(r.Context(), from, to, query.Get("client"), query.Get("route"))
+	if err != nil {
+		http.Error(w, "Failed to get usage report", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "GetUsageReport error: Failed to get usage report", "error", err)
+		return
+	}
+
+	respond(w, r, http.StatusOK, report)
+}
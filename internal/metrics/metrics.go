@@ -0,0 +1,133 @@
+// Package metrics holds the Prometheus metrics of the API and the handler
+// that exposes them.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "f1"
+
+// Registry holds every metric of the API, plus the Go runtime and process
+// collectors.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route and status code.",
+	}, []string{"route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route and status code.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"route", "status"})
+
+	queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Database query latency by the repository method that ran it.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"repository", "method", "outcome"})
+
+	imports = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "imports_total",
+		Help:      "Bulk imports by entity and outcome.",
+	}, []string{"entity", "outcome"})
+
+	importItems = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "import_items_total",
+		Help:      "Items processed by bulk imports by entity and item status.",
+	}, []string{"entity", "status"})
+
+	standingsRecomputations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "standings_recomputations_total",
+		Help:      "Season standings recomputations by outcome.",
+	}, []string{"outcome"})
+
+	rateLimitFailOpen = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limit_fail_open_total",
+		Help:      "Requests let through unchecked because their limits could not be checked, by tier.",
+	}, []string{"tier"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		queryDuration,
+		imports,
+		importItems,
+		standingsRecomputations,
+		rateLimitFailOpen,
+	)
+}
+
+// Handler serves the registry in the Prometheus text exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveRequest records a served HTTP request.
+func ObserveRequest(route string, status int, d time.Duration) {
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(route, code).Inc()
+	httpDuration.WithLabelValues(route, code).Observe(d.Seconds())
+}
+
+// ObserveQuery records a database query run by a repository method.
+func ObserveQuery(repository, method string, d time.Duration, err error) {
+	queryDuration.WithLabelValues(repository, method, outcome(err)).Observe(d.Seconds())
+}
+
+// ObserveImport records a bulk import of entity and the status of its items.
+func ObserveImport(entity string, result model.BulkResult, err error) {
+	switch {
+	case err != nil:
+		imports.WithLabelValues(entity, "error").Inc()
+		return
+	case result.Failed == 0:
+		imports.WithLabelValues(entity, "ok").Inc()
+	case result.Mode == model.BulkModeAtomic:
+		imports.WithLabelValues(entity, "rolled_back").Inc()
+	default:
+		imports.WithLabelValues(entity, "partial").Inc()
+	}
+	for _, item := range result.Items {
+		importItems.WithLabelValues(entity, string(item.Status)).Inc()
+	}
+}
+
+// ObserveStandingsRecompute records a recomputation of season standings.
+func ObserveStandingsRecompute(err error) {
+	standingsRecomputations.WithLabelValues(outcome(err)).Inc()
+}
+
+// ObserveRateLimitFailOpen records a request of tier that was let through
+// because its limits could not be checked.
+func ObserveRateLimitFailOpen(tier string) {
+	rateLimitFailOpen.WithLabelValues(tier).Inc()
+}
+
+func outcome(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
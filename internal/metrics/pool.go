@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterPool exports the connection statistics of pool.
+func RegisterPool(pool *pgxpool.Pool) {
+	Registry.MustRegister(poolCollector{pool})
+}
+
+var (
+	poolAcquiredConns = poolDesc("acquired_conns", "Connections currently in use.")
+	poolIdleConns     = poolDesc("idle_conns", "Connections currently idle.")
+	poolTotalConns    = poolDesc("total_conns", "Connections currently open.")
+	poolMaxConns      = poolDesc("max_conns", "Maximum size of the pool.")
+	poolAcquires      = poolDesc("acquires_total", "Connections acquired from the pool.")
+	poolEmptyAcquires = poolDesc("empty_acquires_total", "Acquires that had to wait for a connection.")
+	poolCanceled      = poolDesc("canceled_acquires_total", "Acquires cancelled before a connection was available.")
+	poolAcquireWait   = poolDesc("acquire_wait_seconds_total", "Time spent waiting to acquire connections.")
+	poolNewConns      = poolDesc("new_conns_total", "Connections opened.")
+	poolLifetimeDrops = poolDesc("max_lifetime_destroys_total", "Connections closed for exceeding their maximum lifetime.")
+	poolIdleDrops     = poolDesc("max_idle_destroys_total", "Connections closed for exceeding the maximum idle time.")
+)
+
+func poolDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+}
+
+type poolCollector struct {
+	pool *pgxpool.Pool
+}
+
+func (c poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+	gauge := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v)
+	}
+	counter := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, v)
+	}
+
+	gauge(poolAcquiredConns, float64(s.AcquiredConns()))
+	gauge(poolIdleConns, float64(s.IdleConns()))
+	gauge(poolTotalConns, float64(s.TotalConns()))
+	gauge(poolMaxConns, float64(s.MaxConns()))
+	counter(poolAcquires, float64(s.AcquireCount()))
+	counter(poolEmptyAcquires, float64(s.EmptyAcquireCount()))
+	counter(poolCanceled, float64(s.CanceledAcquireCount()))
+	counter(poolAcquireWait, s.AcquireDuration().Seconds())
+	counter(poolNewConns, float64(s.NewConnsCount()))
+	counter(poolLifetimeDrops, float64(s.MaxLifetimeDestroyCount()))
+	counter(poolIdleDrops, float64(s.MaxIdleDestroyCount()))
+}
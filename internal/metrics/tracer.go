@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// QueryTracer is a pgx tracer that times every query and attributes it to
+// the repository method that ran it.
+type QueryTracer struct{}
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	repository, method string
+	at                 time.Time
+}
+
+func (QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	repository, method := RepositoryMethod()
+	return context.WithValue(ctx, queryStartKey{}, queryStart{repository, method, time.Now()})
+}
+
+func (QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	ObserveQuery(start.repository, start.method, time.Since(start.at), data.Err)
+}
+
+const repositoryPackage = "/internal/repository."
+
+// RepositoryMethod walks the stack for the repository method that is
+// running, e.g. ("driver", "GetDrivers"). Package level helpers such as
+// bulkUpsert are skipped in favour of the method that called them; code
+// outside the repositories, like migrations, is reported as ("other", "").
+func RepositoryMethod() (repository, method string) {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	helper := ""
+	for {
+		frame, more := frames.Next()
+		if i := strings.Index(frame.Function, repositoryPackage); i >= 0 {
+			name := frame.Function[i+len(repositoryPackage):]
+			if strings.HasPrefix(name, "(*") {
+				receiver, rest, _ := strings.Cut(name[2:], ").")
+				method, _, _ = strings.Cut(rest, ".")
+				return strings.TrimSuffix(receiver, "Repository"), strings.TrimSuffix(method, "-fm")
+			}
+			if helper == "" {
+				helper, _, _ = strings.Cut(name, "[")
+				helper, _, _ = strings.Cut(helper, ".")
+			}
+		}
+		if !more {
+			break
+		}
+	}
+	if helper != "" {
+		return "repository", helper
+	}
+	return "other", ""
+}
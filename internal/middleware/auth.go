@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+	"github.com/ChinmayNoob/f1/internal/service"
+)
+
+const APIKeyHeader = "X-API-Key"
+
+// Authenticate identifies the caller with authenticator from an API key in
+// the X-API-Key header, or from an Authorization: Bearer header holding an
+// API key or a signed JWT. The caller is stored as the request principal and
+// actor. Requests without credentials run as the anonymous principal.
+// Credentials that do not check out are rejected with 401 and counted
+// against the client IP in failures; a client IP that has failed too often
+// gets 429 with Retry-After for any credentials until its bucket refills.
+// A nil failures does not throttle.
+func Authenticate(authenticator *auth.Authenticator, failures *ratelimit.Failures) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal := authenticator.Anonymous()
+			if secret, bearer := requestCredentials(r); secret != "" {
+				client := "ip:" + clientIP(r)
+				if retryAfter := failures.RetryAfter(client); retryAfter > 0 {
+					w.Header().Set("Retry-After", formatSeconds(retryAfter))
+					http.Error(w, "Too many failed authentications", http.StatusTooManyRequests)
+					slog.WarnContext(r.Context(), "Authenticate error: Throttled failed authentications", "client", client)
+					return
+				}
+
+				p, err := authenticator.Authenticate(r.Context(), secret, bearer)
+				if err != nil {
+					switch {
+					case errors.Is(err, auth.ErrInvalidToken):
+						failures.Add(client)
+						unauthorized(w, "Invalid token")
+						slog.WarnContext(r.Context(), "Authenticate error: Rejected token", "client", client, "error", err)
+					case errors.Is(err, service.ErrInvalidAPIKey):
+						failures.Add(client)
+						unauthorized(w, "Invalid API key")
+						slog.WarnContext(r.Context(), "Authenticate error: Rejected API key", "client", client)
+					default:
+						http.Error(w, "Failed to authenticate", http.StatusInternalServerError)
+						slog.ErrorContext(r.Context(), "Authenticate error: Failed to check API key", "error", err)
+					}
+					return
+				}
+				principal = p
+			}
+
+			ctx := requestctx.WithPrincipal(r.Context(), principal)
+			ctx = requestctx.WithActor(ctx, principal.Name)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireScope rejects requests whose principal lacks scope: with 401 when
+// the caller is anonymous, so it knows to authenticate, and 403 otherwise.
+// Reading soft-deleted rows with ?include_deleted=true requires the admin
+// scope.
+func RequireScope(scope model.Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !Authorize(w, r, RequiredScope(scope, r)) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequiredScope returns the scope r needs on a route that requires scope.
+func RequiredScope(scope model.Scope, r *http.Request) model.Scope {
+	if r.URL.Query().Get("include_deleted") == "true" {
+		return model.ScopeAdmin
+	}
+	return scope
+}
+
+// Authorize reports whether the principal of r has scope, answering the
+// request as RequireScope does when it does not.
+func Authorize(w http.ResponseWriter, r *http.Request, scope model.Scope) bool {
+	principal, ok := requestctx.Principal(r.Context())
+	if ok && principal.HasScope(scope) {
+		return true
+	}
+	if !ok || principal.Name == auth.AnonymousName {
+		unauthorized(w, "Authentication required")
+	} else {
+		http.Error(w, "Missing scope: "+string(scope), http.StatusForbidden)
+	}
+	return false
+}
+
+// requestCredentials returns the API key or token of the request and whether
+// it came from the Authorization header.
+func requestCredentials(r *http.Request) (string, bool) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return key, false
+	}
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token), true
+	}
+	return "", false
+}
+
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="f1"`)
+	http.Error(w, message, http.StatusUnauthorized)
+}
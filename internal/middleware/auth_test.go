@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/service"
+)
+
+// apiKeys accepts the secret "valid" and rejects every other key.
+type apiKeys struct {
+	service.APIKeyService
+}
+
+func (apiKeys) Authenticate(_ context.Context, secret string) (model.APIKey, error) {
+	if secret != "valid" {
+		return model.APIKey{}, service.ErrInvalidAPIKey
+	}
+	return model.APIKey{Name: "ci", Scopes: []model.Scope{model.ScopeRead}}, nil
+}
+
+func TestAuthenticateThrottlesFailures(t *testing.T) {
+	failures := ratelimit.NewFailures(ratelimit.Tier{Name: "test", Rate: 1.0 / 3600, Burst: 2})
+	h := Authenticate(auth.NewAuthenticator(apiKeys{}, nil, true), failures)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		key        string
+		wantStatus int
+	}{
+		{"valid key", "192.0.2.1:1234", "valid", http.StatusNoContent},
+		{"first invalid key", "192.0.2.1:1234", "guess-1", http.StatusUnauthorized},
+		{"second invalid key", "192.0.2.1:1235", "guess-2", http.StatusUnauthorized},
+		{"invalid key beyond the burst", "192.0.2.1:1236", "guess-3", http.StatusTooManyRequests},
+		{"valid key from the throttled IP", "192.0.2.1:1237", "valid", http.StatusTooManyRequests},
+		{"no credentials from the throttled IP", "192.0.2.1:1238", "", http.StatusNoContent},
+		{"invalid key from another IP", "192.0.2.2:1234", "guess-1", http.StatusUnauthorized},
+		{"valid key from another IP", "192.0.2.2:1234", "valid", http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/v1/drivers", nil)
+		req.RemoteAddr = tt.remoteAddr
+		if tt.key != "" {
+			req.Header.Set(APIKeyHeader, tt.key)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.wantStatus)
+		}
+		if retryAfter := rec.Header().Get("Retry-After"); (retryAfter != "") != (tt.wantStatus == http.StatusTooManyRequests) {
+			t.Errorf("%s: Retry-After = %q", tt.name, retryAfter)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/cors"
+)
+
+func TestCORS(t *testing.T) {
+	cfg := cors.Default()
+	cfg.AllowedOrigins = []string{"https://app.example.com"}
+	h := CORS(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	tests := []struct {
+		name        string
+		method      string
+		origin      string
+		preflight   bool
+		wantStatus  int
+		wantAllowed bool
+	}{
+		{name: "preflight from an allowed origin", method: http.MethodOptions, origin: "https://app.example.com", preflight: true, wantStatus: http.StatusNoContent, wantAllowed: true},
+		{name: "preflight from a disallowed origin", method: http.MethodOptions, origin: "https://evil.example.com", preflight: true, wantStatus: http.StatusTeapot},
+		{name: "request from an allowed origin", method: http.MethodGet, origin: "https://app.example.com", wantStatus: http.StatusTeapot, wantAllowed: true},
+		{name: "request from a disallowed origin", method: http.MethodGet, origin: "https://evil.example.com", wantStatus: http.StatusTeapot},
+		{name: "same-origin request", method: http.MethodGet, wantStatus: http.StatusTeapot},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/v1/drivers", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.preflight {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				req.Header.Set("Access-Control-Request-Headers", "Content-Type, Idempotency-Key")
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if !slices.Contains(rec.Header().Values("Vary"), "Origin") {
+				t.Errorf("Vary = %q, want it to contain Origin", rec.Header().Values("Vary"))
+			}
+			allowOrigin := rec.Header().Get("Access-Control-Allow-Origin")
+			if tt.wantAllowed && allowOrigin != tt.origin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", allowOrigin, tt.origin)
+			}
+			if !tt.wantAllowed && allowOrigin != "" {
+				t.Errorf("Access-Control-Allow-Origin = %q, want none", allowOrigin)
+			}
+
+			preflightHeaders := map[string]string{
+				"Access-Control-Allow-Methods": "GET, HEAD, POST, PUT, PATCH, DELETE",
+				"Access-Control-Allow-Headers": "Authorization, Content-Type, X-API-Key, Idempotency-Key, X-Request-ID",
+				"Access-Control-Max-Age":       "600",
+			}
+			for name, value := range preflightHeaders {
+				if !tt.preflight || !tt.wantAllowed {
+					value = ""
+				}
+				if got := rec.Header().Get(name); got != value {
+					t.Errorf("%s = %q, want %q", name, got, value)
+				}
+			}
+			exposed := rec.Header().Get("Access-Control-Expose-Headers")
+			if wantExposed := tt.wantAllowed && !tt.preflight; (exposed != "") != wantExposed {
+				t.Errorf("Access-Control-Expose-Headers = %q, want it set: %t", exposed, wantExposed)
+			}
+		})
+	}
+}
+
+// TestCORSDefaultHeaders checks that the default CORS settings, which name
+// headers without depending on this package, use the headers it reads and
+// sets.
+func TestCORSDefaultHeaders(t *testing.T) {
+	cfg := cors.Default()
+	for _, name := range []string{APIKeyHeader, IdempotencyKeyHeader, RequestIDHeader} {
+		if !slices.Contains(cfg.AllowedHeaders, name) {
+			t.Errorf("allowed headers %q miss %s", cfg.AllowedHeaders, name)
+		}
+	}
+	for _, name := range []string{RequestIDHeader, IdempotentReplayedHeader} {
+		if !slices.Contains(cfg.ExposedHeaders, name) {
+			t.Errorf("exposed headers %q miss %s", cfg.ExposedHeaders, name)
+		}
+	}
+}
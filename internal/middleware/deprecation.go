@@ -0,0 +1,19 @@
+package middleware
+
+import "net/http"
+
+// Deprecated marks the responses of an unversioned alias route as deprecated
+// and links to the same request under prefix, where the route now lives.
+func Deprecated(prefix string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			successor := prefix + r.URL.EscapedPath()
+			if r.URL.RawQuery != "" {
+				successor += "?" + r.URL.RawQuery
+			}
+			w.Header().Set("Deprecation", "true")
+			w.Header().Add("Link", "<"+successor+`>; rel="successor-version"`)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
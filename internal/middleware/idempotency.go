@@ -0,0 +1,203 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+	// maxIdempotentBodySize bounds the body read to fingerprint a request; a
+	// bulk request of the maximum 1000 items fits well within it.
+	maxIdempotentBodySize     = 10 << 20
+	idempotencyPurgeInterval  = time.Hour
+	idempotencyRequestTimeout = 5 * time.Second
+)
+
+// Idempotency makes POST requests carrying an Idempotency-Key header safe to
+// retry. Keys are scoped to the caller, so that callers picking the same key
+// do not collide. The first response for a key, headers included, is stored
+// for ttl and replayed for any retry with the same method, path and body;
+// reusing the key for a different request is rejected with 422. Server errors
+// are not stored so the client can retry them.
+func Idempotency(repo repository.IdempotencyRepository, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				http.Error(w, "Idempotency-Key is too long", http.StatusBadRequest)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					http.Error(w, "Request body is too large", http.StatusRequestEntityTooLarge)
+					return
+				}
+				http.Error(w, "Invalid request body", http.StatusBadRequest)
+				slog.ErrorContext(r.Context(), "Idempotency error: Failed to read request body", "error", err)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			// Storing the outcome must survive the client hanging up, which is
+			// exactly the case the client is going to retry.
+			storeCtx := context.WithoutCancel(r.Context())
+			actor := requestctx.Actor(r.Context())
+
+			fingerprint := requestFingerprint(r, body)
+			record, reserved, err := repo.ReserveIdempotencyKey(storeCtx, actor, key, fingerprint, ttl)
+			if err != nil {
+				http.Error(w, "Failed to process Idempotency-Key", http.StatusInternalServerError)
+				slog.ErrorContext(r.Context(), "Idempotency error: Failed to reserve key", "error", err)
+				return
+			}
+
+			if !reserved {
+				switch {
+				case record.Fingerprint != fingerprint:
+					http.Error(w, "Idempotency-Key was already used with a different request", http.StatusUnprocessableEntity)
+				case !record.Completed():
+					http.Error(w, "A request with this Idempotency-Key is still being processed", http.StatusConflict)
+				default:
+					replayHeader(w.Header(), record.Header)
+					w.Header().Set(IdempotentReplayedHeader, "true")
+					w.WriteHeader(*record.StatusCode)
+					w.Write(record.Body)
+				}
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			if rec.status >= http.StatusInternalServerError {
+				if err := repo.ReleaseIdempotencyKey(storeCtx, actor, key); err != nil {
+					slog.ErrorContext(r.Context(), "Idempotency error: Failed to release key", "error", err)
+				}
+				return
+			}
+			if err := repo.CompleteIdempotencyKey(storeCtx, actor, key, rec.status, storedHeader(rec.Header()), rec.body.Bytes()); err != nil {
+				slog.ErrorContext(r.Context(), "Idempotency error: Failed to store response", "error", err)
+			}
+		})
+	}
+}
+
+// PurgeIdempotencyKeys deletes expired idempotency records every hour until
+// ctx is cancelled.
+func PurgeIdempotencyKeys(ctx context.Context, repo repository.IdempotencyRepository) {
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purgeCtx, cancel := context.WithTimeout(ctx, idempotencyRequestTimeout)
+			deleted, err := repo.DeleteExpiredIdempotencyKeys(purgeCtx)
+			cancel()
+			if err != nil {
+				slog.ErrorContext(ctx, "Idempotency error: Failed to purge expired keys", "error", err)
+				continue
+			}
+			if deleted > 0 {
+				slog.InfoContext(ctx, "Purged expired idempotency keys", "count", deleted)
+			}
+		}
+	}
+}
+
+// unstoredHeaders are response headers that are not replayed: hop-by-hop
+// headers, which describe the connection the response was first sent on, and
+// headers that are set afresh for every response.
+var unstoredHeaders = []string{
+	"Connection", "Keep-Alive", "Proxy-Authenticate", "Proxy-Connection", "Te", "Trailer", "Transfer-Encoding", "Upgrade",
+	"Content-Length", "Date", RequestIDHeader, IdempotentReplayedHeader,
+}
+
+// storedHeader returns the headers of a response to store for replays.
+func storedHeader(header http.Header) http.Header {
+	stored := header.Clone()
+	for _, v := range stored.Values("Connection") {
+		for _, name := range strings.Split(v, ",") {
+			stored.Del(strings.TrimSpace(name))
+		}
+	}
+	for _, name := range unstoredHeaders {
+		stored.Del(name)
+	}
+	for name := range stored {
+		if strings.HasPrefix(name, http.TrailerPrefix) {
+			delete(stored, name)
+		}
+	}
+	return stored
+}
+
+// replayHeader adds the stored headers of a response to header. Headers the
+// middleware in front of this one already set, such as the RateLimit ones,
+// describe this request and are kept.
+func replayHeader(header, stored http.Header) {
+	for name, values := range stored {
+		if _, ok := header[name]; !ok {
+			header[name] = values
+		}
+	}
+}
+
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method)
+	h.Write([]byte{0})
+	io.WriteString(h, r.URL.RequestURI())
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder passes the response through to the client while keeping a
+// copy of the status and body.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.wroteHeader {
+		return
+	}
+	rec.status = status
+	rec.wroteHeader = true
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
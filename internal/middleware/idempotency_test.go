@@ -0,0 +1,287 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+)
+
+// memoryIdempotencyRepository keeps idempotency records in memory, expiring
+// them by its own clock.
+type memoryIdempotencyRepository struct {
+	mu      sync.Mutex
+	now     time.Time
+	records map[[2]string]model.IdempotencyRecord
+}
+
+func newMemoryIdempotencyRepository() *memoryIdempotencyRepository {
+	return &memoryIdempotencyRepository{
+		now:     time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC),
+		records: make(map[[2]string]model.IdempotencyRecord),
+	}
+}
+
+func (m *memoryIdempotencyRepository) advance(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(d)
+}
+
+func (m *memoryIdempotencyRepository) ReserveIdempotencyKey(_ context.Context, actor, key, fingerprint string, ttl time.Duration) (model.IdempotencyRecord, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if record, ok := m.records[[2]string{actor, key}]; ok && record.ExpiresAt.After(m.now) {
+		return record, false, nil
+	}
+	record := model.IdempotencyRecord{Actor: actor, Key: key, Fingerprint: fingerprint, CreatedAt: m.now, ExpiresAt: m.now.Add(ttl)}
+	m.records[[2]string{actor, key}] = record
+	return record, true, nil
+}
+
+func (m *memoryIdempotencyRepository) CompleteIdempotencyKey(_ context.Context, actor, key string, statusCode int, header http.Header, body []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	record := m.records[[2]string{actor, key}]
+	record.StatusCode, record.Header, record.Body = &statusCode, header, body
+	m.records[[2]string{actor, key}] = record
+	return nil
+}
+
+func (m *memoryIdempotencyRepository) ReleaseIdempotencyKey(_ context.Context, actor, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.records, [2]string{actor, key})
+	return nil
+}
+
+func (m *memoryIdempotencyRepository) DeleteExpiredIdempotencyKeys(context.Context) (int64, error) {
+	return 0, nil
+}
+
+// countingHandler answers every request it serves with status and a body
+// naming the call, so that replays can be told apart from new calls.
+type countingHandler struct {
+	mu     sync.Mutex
+	calls  int
+	status int
+}
+
+func (h *countingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	h.calls++
+	call := h.calls
+	h.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/v1/drivers/hamilton")
+	w.Header().Set("ETag", `"v1"`)
+	w.Header().Set("Connection", "X-Hop")
+	w.Header().Set("X-Hop", "yes")
+	w.Header().Set("Keep-Alive", "timeout=5")
+	w.WriteHeader(h.status)
+	io.WriteString(w, `{"call":`+strconv.Itoa(call)+`}`)
+}
+
+func (h *countingHandler) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.calls
+}
+
+func idempotentPost(t *testing.T, h http.Handler, key, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	return idempotentPostRemaining(t, h, key, body, 41)
+}
+
+// idempotentPostRemaining posts body as the RateLimit middleware in front of
+// Idempotency would let it through, with remaining requests left.
+func idempotentPostRemaining(t *testing.T, h http.Handler, key, body string, remaining int) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/v1/drivers", strings.NewReader(body))
+	req.Header.Set(IdempotencyKeyHeader, key)
+	rec := httptest.NewRecorder()
+	rec.Header().Set("RateLimit-Remaining", strconv.Itoa(remaining))
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotencyReplaysHeaders(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	h := Idempotency(newMemoryIdempotencyRepository(), time.Hour)(next)
+
+	first := idempotentPost(t, h, "k1", `{"ref":"hamilton"}`)
+	replay := idempotentPostRemaining(t, h, "k1", `{"ref":"hamilton"}`, 40)
+
+	if next.count() != 1 {
+		t.Fatalf("handler called %d times, want 1", next.count())
+	}
+	if replay.Code != http.StatusCreated || replay.Body.String() != first.Body.String() {
+		t.Errorf("replay = %d %q, want %d %q", replay.Code, replay.Body, first.Code, first.Body)
+	}
+	want := map[string]string{
+		"Content-Type":           "application/json",
+		"Location":               "/v1/drivers/hamilton",
+		"ETag":                   `"v1"`,
+		IdempotentReplayedHeader: "true",
+		// The RateLimit headers of the replay are its own.
+		"RateLimit-Remaining": "40",
+		"Connection":          "",
+		"X-Hop":               "",
+		"Keep-Alive":          "",
+	}
+	for name, value := range want {
+		if got := replay.Header().Get(name); got != value {
+			t.Errorf("replay header %s = %q, want %q", name, got, value)
+		}
+	}
+}
+
+func TestIdempotencyOutcomes(t *testing.T) {
+	tests := []struct {
+		name string
+		// prepare runs the requests before the one under test.
+		prepare    func(t *testing.T, h http.Handler, repo *memoryIdempotencyRepository)
+		status     int
+		body       string
+		wantStatus int
+		wantCalls  int
+	}{
+		{
+			name:       "first request",
+			status:     http.StatusCreated,
+			wantStatus: http.StatusCreated,
+			wantCalls:  1,
+		},
+		{
+			name: "different request with the same key",
+			prepare: func(t *testing.T, h http.Handler, _ *memoryIdempotencyRepository) {
+				idempotentPost(t, h, "k1", `{"ref":"hamilton"}`)
+			},
+			status:     http.StatusCreated,
+			body:       `{"ref":"russell"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCalls:  1,
+		},
+		{
+			name: "request still in flight",
+			prepare: func(_ *testing.T, _ http.Handler, repo *memoryIdempotencyRepository) {
+				req := httptest.NewRequest(http.MethodPost, "/v1/drivers", strings.NewReader(`{"ref":"hamilton"}`))
+				repo.ReserveIdempotencyKey(context.Background(), "", "k1", requestFingerprint(req, []byte(`{"ref":"hamilton"}`)), time.Hour)
+			},
+			status:     http.StatusCreated,
+			wantStatus: http.StatusConflict,
+			wantCalls:  0,
+		},
+		{
+			name: "server error releases the key",
+			prepare: func(t *testing.T, h http.Handler, _ *memoryIdempotencyRepository) {
+				if rec := idempotentPost(t, h, "k1", `{"ref":"hamilton"}`); rec.Code != http.StatusInternalServerError {
+					t.Fatalf("first request = %d, want 500", rec.Code)
+				}
+			},
+			status:     http.StatusInternalServerError,
+			wantStatus: http.StatusInternalServerError,
+			wantCalls:  2,
+		},
+		{
+			name: "expired key",
+			prepare: func(t *testing.T, h http.Handler, repo *memoryIdempotencyRepository) {
+				idempotentPost(t, h, "k1", `{"ref":"hamilton"}`)
+				repo.advance(time.Hour)
+			},
+			status:     http.StatusCreated,
+			wantStatus: http.StatusCreated,
+			wantCalls:  2,
+		},
+		{
+			name: "expired key reused for a different request",
+			prepare: func(t *testing.T, h http.Handler, repo *memoryIdempotencyRepository) {
+				idempotentPost(t, h, "k1", `{"ref":"hamilton"}`)
+				repo.advance(time.Hour + time.Second)
+			},
+			status:     http.StatusCreated,
+			body:       `{"ref":"russell"}`,
+			wantStatus: http.StatusCreated,
+			wantCalls:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryIdempotencyRepository()
+			next := &countingHandler{status: tt.status}
+			h := Idempotency(repo, time.Hour)(next)
+			if tt.prepare != nil {
+				tt.prepare(t, h, repo)
+			}
+
+			body := tt.body
+			if body == "" {
+				body = `{"ref":"hamilton"}`
+			}
+			rec := idempotentPost(t, h, "k1", body)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if next.count() != tt.wantCalls {
+				t.Errorf("handler called %d times, want %d", next.count(), tt.wantCalls)
+			}
+			if rec.Header().Get(IdempotentReplayedHeader) != "" {
+				t.Errorf("response is marked as replayed")
+			}
+		})
+	}
+}
+
+func TestIdempotencyKeysAreScopedToTheCaller(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	h := Idempotency(newMemoryIdempotencyRepository(), time.Hour)(next)
+
+	for _, step := range []struct {
+		actor        string
+		body         string
+		wantReplayed string
+	}{
+		{"key:alice", `{"ref":"hamilton"}`, ""},
+		// Another caller picking the same key gets a response of its own.
+		{"key:bob", `{"ref":"russell"}`, ""},
+		{"key:alice", `{"ref":"hamilton"}`, "true"},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/v1/drivers", strings.NewReader(step.body))
+		req = req.WithContext(requestctx.WithActor(req.Context(), step.actor))
+		req.Header.Set(IdempotencyKeyHeader, "k1")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusCreated {
+			t.Errorf("%s: status = %d, want %d", step.actor, rec.Code, http.StatusCreated)
+		}
+		if got := rec.Header().Get(IdempotentReplayedHeader); got != step.wantReplayed {
+			t.Errorf("%s: %s = %q, want %q", step.actor, IdempotentReplayedHeader, got, step.wantReplayed)
+		}
+	}
+	if next.count() != 2 {
+		t.Errorf("handler called %d times, want 2", next.count())
+	}
+}
+
+func TestIdempotencyRejectsLargeBodies(t *testing.T) {
+	next := &countingHandler{status: http.StatusCreated}
+	h := Idempotency(newMemoryIdempotencyRepository(), time.Hour)(next)
+
+	rec := idempotentPost(t, h, "k1", strings.Repeat("x", maxIdempotentBodySize+1))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+	if next.count() != 0 {
+		t.Errorf("handler called %d times, want 0", next.count())
+	}
+}
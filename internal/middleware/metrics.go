@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/metrics"
+)
+
+// Metrics counts requests and their latency by route and status code.
+func Metrics(mux *http.ServeMux) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r)
+			metrics.ObserveRequest(routeName(mux, r), sw.status, time.Since(start))
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/ChinmayNoob/f1/internal/render"
+)
+
+// Negotiate answers 406 before the handler runs when the client accepts
+// none of the response formats, so that writes are not applied for a
+// response it cannot read.
+func Negotiate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept")
+		if _, err := render.Negotiate(r); err != nil {
+			http.Error(w, "Not acceptable: the supported formats are json, csv, xml, yaml and ndjson", http.StatusNotAcceptable)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
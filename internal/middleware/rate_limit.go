@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/metrics"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+)
+
+// RateLimit applies the limits of the caller's tier. Authenticated callers are
+// limited per principal, anonymous ones per client IP. Every response carries
+// RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers, plus the
+// daily quota when the tier has one; refused requests get 429 with
+// Retry-After. Requests are let through if the quota store is unavailable,
+// and counted in the f1_rate_limit_fail_open_total metric.
+func RateLimit(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, _ := requestctx.Principal(r.Context())
+			client := principal.Name
+			if client == "" || client == auth.AnonymousName {
+				client = "ip:" + clientIP(r)
+			}
+
+			tier := limiter.Tier(principal.Tier)
+			decision, err := limiter.Allow(r.Context(), client, tier)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Rate limit error: Failed to check limits", "client", client, "error", err)
+				metrics.ObserveRateLimitFailOpen(tier.Name)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			h.Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+			h.Set("RateLimit-Reset", formatSeconds(decision.Reset))
+			if decision.QuotaLimit > 0 {
+				h.Set("X-Daily-Quota-Limit", strconv.FormatInt(decision.QuotaLimit, 10))
+				h.Set("X-Daily-Quota-Remaining", strconv.FormatInt(decision.QuotaRemaining, 10))
+			}
+
+			if !decision.Allowed {
+				h.Set("Retry-After", formatSeconds(decision.RetryAfter))
+				if decision.QuotaExceeded {
+					http.Error(w, "Daily quota exceeded", http.StatusTooManyRequests)
+				} else {
+					http.Error(w, "Rate limit exceeded", http.StatusTooManyRequests)
+				}
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func formatSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/metrics"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/ratelimit"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+)
+
+// quotaRepository serves daily usage of 0, or fails with err.
+type quotaRepository struct {
+	err error
+}
+
+func (q quotaRepository) GetDailyUsage(context.Context, string, time.Time) (int64, error) {
+	return 0, q.err
+}
+
+func (q quotaRepository) AddDailyUsage(context.Context, time.Time, map[string]int64) error {
+	return q.err
+}
+
+func rateLimited(limiter *ratelimit.Limiter, tier string) *httptest.ResponseRecorder {
+	h := RateLimit(limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/v1/drivers", nil)
+	req = req.WithContext(requestctx.WithPrincipal(req.Context(), model.Principal{Name: "key:ci", Tier: tier}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimitHeaders(t *testing.T) {
+	limiter := ratelimit.NewLimiter(map[string]ratelimit.Tier{
+		"test": {Name: "test", Rate: 0.5, Burst: 1, DailyQuota: 10},
+	}, quotaRepository{})
+
+	rec := rateLimited(limiter, "test")
+	want := map[string]string{
+		"RateLimit-Limit":         "1",
+		"RateLimit-Remaining":     "0",
+		"RateLimit-Reset":         "2",
+		"X-Daily-Quota-Limit":     "10",
+		"X-Daily-Quota-Remaining": "9",
+		"Retry-After":             "",
+	}
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want 204", rec.Code)
+	}
+	for name, value := range want {
+		if got := rec.Header().Get(name); got != value {
+			t.Errorf("header %s = %q, want %q", name, got, value)
+		}
+	}
+
+	rec = rateLimited(limiter, "test")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "2" {
+		t.Errorf("over the limit: %d with Retry-After %q, want 429 with 2", rec.Code, rec.Header().Get("Retry-After"))
+	}
+}
+
+func TestRateLimitFailsOpen(t *testing.T) {
+	limiter := ratelimit.NewLimiter(map[string]ratelimit.Tier{
+		model.TierStandard: {Name: model.TierStandard, Rate: 1, Burst: 1, DailyQuota: 10},
+	}, quotaRepository{err: errors.New("conn closed")})
+
+	before := failOpenCount(t, model.TierStandard)
+	for range 2 {
+		if rec := rateLimited(limiter, "unknown"); rec.Code != http.StatusNoContent {
+			t.Errorf("status = %d, want 204", rec.Code)
+		}
+	}
+	if got := failOpenCount(t, model.TierStandard) - before; got != 2 {
+		t.Errorf("fail open count went up by %v, want 2", got)
+	}
+}
+
+// failOpenCount returns the requests of tier let through unchecked so far.
+func failOpenCount(t *testing.T, tier string) float64 {
+	t.Helper()
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != "f1_rate_limit_fail_open_total" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "tier" && label.GetValue() == tier {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
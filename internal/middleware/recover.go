@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+)
+
+// problem is an RFC 9457 problem details body.
+type problem struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Instance  string `json:"instance,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Recover turns a panic in a handler into a logged error and a 500 problem
+// response instead of a dropped connection. If the handler had already
+// started the response it is left as it is.
+func Recover(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler {
+				panic(v)
+			}
+			slog.ErrorContext(r.Context(), "Panic serving request", "panic", fmt.Sprint(v), "stack", string(debug.Stack()))
+			if sw.wroteHeader {
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(problem{
+				Type:      "about:blank",
+				Title:     http.StatusText(http.StatusInternalServerError),
+				Status:    http.StatusInternalServerError,
+				Detail:    "The server hit an unexpected error",
+				Instance:  r.URL.Path,
+				RequestID: requestctx.RequestID(r.Context()),
+			})
+		}()
+		next.ServeHTTP(sw, r)
+	})
+}
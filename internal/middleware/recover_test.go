@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+)
+
+func TestRecover(t *testing.T) {
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		wantStatus  int
+		wantProblem bool
+	}{
+		{
+			name:        "panic",
+			handler:     func(http.ResponseWriter, *http.Request) { panic("boom") },
+			wantStatus:  http.StatusInternalServerError,
+			wantProblem: true,
+		},
+		{
+			name: "panic after the response started",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+				io.WriteString(w, "partial")
+				panic("boom")
+			},
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "no panic",
+			handler:    func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) },
+			wantStatus: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/drivers", nil)
+			req = req.WithContext(requestctx.WithRequestID(req.Context(), "req-1"))
+			rec := httptest.NewRecorder()
+			// sw records the status as the access log and metrics in front of
+			// Recover see it.
+			sw := &statusWriter{ResponseWriter: rec, status: http.StatusOK}
+			Recover(tt.handler).ServeHTTP(sw, req)
+
+			if rec.Code != tt.wantStatus || sw.status != tt.wantStatus {
+				t.Errorf("status = %d, recorded %d, want %d", rec.Code, sw.status, tt.wantStatus)
+			}
+			if !tt.wantProblem {
+				return
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+				t.Errorf("Content-Type = %q, want application/problem+json", ct)
+			}
+			var got problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("body %q: %v", rec.Body, err)
+			}
+			want := problem{
+				Type:      "about:blank",
+				Title:     "Internal Server Error",
+				Status:    http.StatusInternalServerError,
+				Detail:    "The server hit an unexpected error",
+				Instance:  "/v1/drivers",
+				RequestID: "req-1",
+			}
+			if got != want {
+				t.Errorf("problem = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestRecoverRepanicsAbortHandler(t *testing.T) {
+	defer func() {
+		if v := recover(); v != http.ErrAbortHandler {
+			t.Errorf("recovered %v, want http.ErrAbortHandler", v)
+		}
+	}()
+	h := Recover(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { panic(http.ErrAbortHandler) }))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/drivers", nil))
+}
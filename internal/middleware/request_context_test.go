@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+	"github.com/google/uuid"
+)
+
+func TestRequestContext(t *testing.T) {
+	tests := []struct {
+		name         string
+		requestID    string
+		wantKept     bool
+		wantGenerate bool
+	}{
+		{name: "incoming ID", requestID: "client-req-42", wantKept: true},
+		{name: "missing ID", wantGenerate: true},
+		{name: "ID too long", requestID: strings.Repeat("x", maxRequestIDLength+1), wantGenerate: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen string
+			h := RequestContext(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = requestctx.RequestID(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/v1/drivers", nil)
+			if tt.requestID != "" {
+				req.Header.Set(RequestIDHeader, tt.requestID)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			echoed := rec.Header().Get(RequestIDHeader)
+			if echoed != seen {
+				t.Errorf("echoed %s %q, handler saw %q", RequestIDHeader, echoed, seen)
+			}
+			if tt.wantKept && seen != tt.requestID {
+				t.Errorf("request ID = %q, want %q", seen, tt.requestID)
+			}
+			if tt.wantGenerate {
+				if _, err := uuid.Parse(seen); err != nil {
+					t.Errorf("request ID = %q, want a generated UUID", seen)
+				}
+			}
+		})
+	}
+}
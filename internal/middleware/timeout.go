@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Timeout gives every request a deadline so that queries run for it are
+// cancelled when it passes, as they are when the client disconnects. routes
+// overrides the timeout per route, keyed by "METHOD pattern" as reported in
+// usage, or by the bare pattern for every method. Streamed lists run for
+// streamTimeout instead, with the context StreamContext returns.
+func Timeout(mux *http.ServeMux, timeout, streamTimeout time.Duration, routes map[string]time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			d := timeout
+			name := routeName(mux, r)
+			if t, ok := routes[name]; ok {
+				d = t
+			} else if t, ok := routes[name[strings.Index(name, " ")+1:]]; ok {
+				d = t
+			}
+			ctx := context.WithValue(r.Context(), streamKey{}, stream{parent: r.Context(), timeout: streamTimeout})
+			if d <= 0 {
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+type streamKey struct{}
+
+// stream holds what StreamContext needs: the context of the request before
+// Timeout gave it a deadline, and the timeout of streams.
+type stream struct {
+	parent  context.Context
+	timeout time.Duration
+}
+
+// StreamContext returns a context for streaming a list from ctx, a request
+// context or one derived from it. It keeps the values of ctx and is
+// cancelled when the client disconnects, but the request's timeout is
+// replaced with the stream timeout, no deadline when that is 0. Outside of
+// Timeout it is ctx.
+func StreamContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	s, ok := ctx.Value(streamKey{}).(stream)
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(s.parent, cancel)
+	if s.timeout <= 0 {
+		return ctx, func() {
+			stop()
+			cancel()
+		}
+	}
+	ctx, cancelTimeout := context.WithTimeout(ctx, s.timeout)
+	return ctx, func() {
+		cancelTimeout()
+		stop()
+		cancel()
+	}
+}
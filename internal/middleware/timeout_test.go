@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestStreamContext(t *testing.T) {
+	type result struct {
+		request, stream error
+		deadline        bool
+	}
+	tests := []struct {
+		name          string
+		streamTimeout time.Duration
+		// wait is how long the handler waits on the stream context.
+		wait       time.Duration
+		disconnect bool
+		want       result
+	}{
+		{
+			name:          "stream outlives the route timeout",
+			streamTimeout: time.Hour,
+			wait:          50 * time.Millisecond,
+			want:          result{request: context.DeadlineExceeded, deadline: true},
+		},
+		{
+			name: "no stream timeout",
+			wait: 50 * time.Millisecond,
+			want: result{request: context.DeadlineExceeded},
+		},
+		{
+			name:          "stream timeout",
+			streamTimeout: 20 * time.Millisecond,
+			wait:          time.Second,
+			want:          result{request: context.DeadlineExceeded, stream: context.DeadlineExceeded, deadline: true},
+		},
+		{
+			name:          "client disconnects",
+			streamTimeout: time.Hour,
+			wait:          time.Second,
+			disconnect:    true,
+			want:          result{request: context.Canceled, stream: context.Canceled, deadline: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			var got result
+			mux.HandleFunc("GET /v1/drivers", func(w http.ResponseWriter, r *http.Request) {
+				ctx, cancel := StreamContext(r.Context())
+				defer cancel()
+				_, got.deadline = ctx.Deadline()
+				select {
+				case <-ctx.Done():
+				case <-time.After(tt.wait):
+				}
+				got.stream = ctx.Err()
+				<-r.Context().Done()
+				got.request = r.Context().Err()
+			})
+			h := Timeout(mux, 10*time.Millisecond, tt.streamTimeout, nil)(mux)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.disconnect {
+				time.AfterFunc(5*time.Millisecond, cancel)
+			}
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/v1/drivers?stream=true", nil)
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			if !errors.Is(got.request, tt.want.request) || !errors.Is(got.stream, tt.want.stream) || got.deadline != tt.want.deadline {
+				t.Errorf("got request error %v, stream error %v, stream deadline %t; want %v, %v, %t",
+					got.request, got.stream, got.deadline, tt.want.request, tt.want.stream, tt.want.deadline)
+			}
+		})
+	}
+}
+
+func TestStreamContextOutsideTimeout(t *testing.T) {
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelParent()
+	ctx, cancel := StreamContext(parent)
+	defer cancel()
+	want, _ := parent.Deadline()
+	if got, _ := ctx.Deadline(); !got.Equal(want) {
+		t.Errorf("StreamContext() deadline = %v, want the deadline of its parent, %v", got, want)
+	}
+}
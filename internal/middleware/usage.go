@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/analytics"
+	"github.com/ChinmayNoob/f1/internal/auth"
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+)
+
+// Usage records every request in rec, attributed to the calling principal
+// and to the mux pattern that serves it, so /drivers/1 and /drivers/2 count
+// as the same route.
+func Usage(rec *analytics.Recorder, mux *http.ServeMux) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r)
+
+			principal, _ := requestctx.Principal(r.Context())
+			client := principal.Name
+			if client == "" {
+				client = auth.AnonymousName
+			}
+			rec.Record(client, routeName(mux, r), sw.status, time.Since(start))
+		})
+	}
+}
+
+// routeName returns the method and pattern of the route serving r.
+func routeName(mux *http.ServeMux, r *http.Request) string {
+	_, pattern := mux.Handler(r)
+	if pattern == "" {
+		return "unmatched"
+	}
+	if !strings.Contains(pattern, " ") {
+		pattern = r.Method + " " + pattern
+	}
+	return pattern
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scope is a permission level. Each scope includes the ones below it:
+// admin includes write, which includes read.
+type Scope string
+
+const (
+	ScopeRead  Scope = "read"
+	ScopeWrite Scope = "write"
+	ScopeAdmin Scope = "admin"
+)
+
+var scopeLevels = map[Scope]int{
+	ScopeRead:  1,
+	ScopeWrite: 2,
+	ScopeAdmin: 3,
+}
+
+func ParseScope(s string) (Scope, error) {
+	scope := Scope(s)
+	if _, ok := scopeLevels[scope]; !ok {
+		return "", fmt.Errorf("unknown scope %q", s)
+	}
+	return scope, nil
+}
+
+// Includes reports whether s grants everything other grants.
+func (s Scope) Includes(other Scope) bool {
+	return scopeLevels[s] >= scopeLevels[other]
+}
+
+// Rate limit tiers every deployment has. Further tiers can be configured and
+// assigned to API keys.
+const (
+	TierAnonymous = "anonymous"
+	TierStandard  = "standard"
+)
+
+// Principal is the caller a request is made on behalf of. Tier selects the
+// rate limits that apply to it.
+type Principal struct {
+	Name   string  `json:"name"`
+	Scopes []Scope `json:"scopes"`
+	Tier   string  `json:"tier"`
+}
+
+func (p Principal) HasScope(scope Scope) bool {
+	for _, s := range p.Scopes {
+		if s.Includes(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+type APIKey struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"-"`
+	Scopes     []Scope    `json:"scopes"`
+	Tier       string     `json:"tier"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+// Role is a role granted by a JWT. Each role maps to the scope it allows.
+type Role string
+
+const (
+	RoleViewer    Role = "viewer"
+	RoleEditor    Role = "editor"
+	RoleDataAdmin Role = "data-admin"
+)
+
+var roleScopes = map[Role]Scope{
+	RoleViewer:    ScopeRead,
+	RoleEditor:    ScopeWrite,
+	RoleDataAdmin: ScopeAdmin,
+}
+
+func (r Role) Scope() (Scope, bool) {
+	scope, ok := roleScopes[r]
+	return scope, ok
+}
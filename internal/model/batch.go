@@ -0,0 +1,34 @@
+package model
+
+import "encoding/json"
+
+type BatchRequest struct {
+	// Atomic runs every operation in one transaction that is rolled back
+	// when any operation fails.
+	Atomic     bool             `json:"atomic"`
+	Operations []BatchOperation `json:"operations"`
+}
+
+type BatchOperation struct {
+	// ID names the operation so later operations can reference its response
+	// as ${id.field} in their path or body.
+	ID      string            `json:"id"`
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+type BatchResult struct {
+	ID      string            `json:"id,omitempty"`
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+type BatchResponse struct {
+	Atomic     bool          `json:"atomic"`
+	RolledBack bool          `json:"rolled_back"`
+	Results    []BatchResult `json:"results"`
+}
@@ -0,0 +1,35 @@
+package model
+
+type BulkMode string
+
+const (
+	// BulkModeAtomic applies every item or none of them.
+	BulkModeAtomic BulkMode = "atomic"
+	// BulkModeBestEffort applies every item it can and reports the rest.
+	BulkModeBestEffort BulkMode = "best-effort"
+)
+
+type BulkItemStatus string
+
+const (
+	BulkItemCreated    BulkItemStatus = "created"
+	BulkItemUpdated    BulkItemStatus = "updated"
+	BulkItemFailed     BulkItemStatus = "failed"
+	BulkItemRolledBack BulkItemStatus = "rolled_back"
+	BulkItemSkipped    BulkItemStatus = "skipped"
+)
+
+type BulkItemResult struct {
+	Index  int            `json:"index"`
+	Status BulkItemStatus `json:"status"`
+	Error  string         `json:"error,omitempty"`
+	Data   interface{}    `json:"data,omitempty"`
+}
+
+type BulkResult struct {
+	Mode    BulkMode         `json:"mode"`
+	Created int              `json:"created"`
+	Updated int              `json:"updated"`
+	Failed  int              `json:"failed"`
+	Items   []BulkItemResult `json:"items"`
+}
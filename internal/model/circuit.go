@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -11,11 +13,23 @@ import (
 // }
 
 type Circuit struct {
-	ID       uuid.UUID `json:"id"`
-	Ref      string    `json:"ref"`
-	Name     string    `json:"name"`
-	Location string    `json:"location"`
-	Country  string    `json:"country"`
-	Current  bool      `json:"current"`
-	URL      string    `json:"url"`
+	ID        uuid.UUID  `json:"id"`
+	Ref       string     `json:"ref"`
+	Name      string     `json:"name"`
+	Location  string     `json:"location"`
+	Country   string     `json:"country"`
+	Current   bool       `json:"current"`
+	URL       string     `json:"url"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// CircuitFilter narrows a streamed circuit list to the circuits matching
+// every field that is set.
+type CircuitFilter struct {
+	Name     string
+	Location string
+	Country  string
+	Current  *bool
+	Ref      string
+	URL      string
 }
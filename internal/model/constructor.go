@@ -1,13 +1,24 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type Constructor struct {
-	ID          uuid.UUID `json:"id"`
-	Ref         string    `json:"ref"`
-	Name        string    `json:"name"`
-	Nationality string    `json:"nationality"`
-	URL         string    `json:"url"`
+	ID          uuid.UUID  `json:"id"`
+	Ref         string     `json:"ref"`
+	Name        string     `json:"name"`
+	Nationality string     `json:"nationality"`
+	URL         string     `json:"url"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// ConstructorFilter narrows a streamed constructor list to the constructors
+// matching every field that is set.
+type ConstructorFilter struct {
+	Name        string
+	Nationality string
+	Ref         string
 }
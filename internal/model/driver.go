@@ -7,15 +7,30 @@ import (
 )
 
 type Driver struct {
-	ID          uuid.UUID `json:"id"`
-	Constructor string    `json:"constructor"`
-	Ref         string    `json:"ref"`
-	Code        *string   `json:"code"`
-	Number      *int      `json:"number"`
-	FirstName   string    `json:"first_name"`
-	LastName    string    `json:"last_name"`
-	DateOfBirth time.Time `json:"date_of_birth"`
-	Nationality string    `json:"nationality"`
-	Status      string    `json:"status"`
-	URL         string    `json:"url"`
+	ID          uuid.UUID  `json:"id"`
+	Constructor string     `json:"constructor"`
+	Ref         string     `json:"ref"`
+	Code        *string    `json:"code"`
+	Number      *int       `json:"number"`
+	FirstName   string     `json:"first_name"`
+	LastName    string     `json:"last_name"`
+	DateOfBirth time.Time  `json:"date_of_birth"`
+	Nationality string     `json:"nationality"`
+	Status      string     `json:"status"`
+	URL         string     `json:"url"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// DriverFilter narrows a streamed driver list to the drivers matching every
+// field that is set. Team is the constructor name.
+type DriverFilter struct {
+	FirstName   string
+	LastName    string
+	Team        string
+	Nationality string
+	Status      string
+	Ref         string
+	Code        string
+	Number      *int
+	URL         string
 }
@@ -0,0 +1,21 @@
+package model
+
+const (
+	HealthOK       = "ok"
+	HealthFailing  = "failing"
+	HealthReady    = "ready"
+	HealthNotReady = "not_ready"
+)
+
+// DependencyStatus is the outcome of checking one dependency for readiness.
+type DependencyStatus struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Detail    string  `json:"detail,omitempty"`
+}
+
+type Readiness struct {
+	Status       string             `json:"status"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+}
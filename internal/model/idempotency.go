@@ -0,0 +1,24 @@
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+type IdempotencyRecord struct {
+	Actor       string `json:"actor"`
+	Key         string `json:"key"`
+	Fingerprint string `json:"fingerprint"`
+	StatusCode  *int   `json:"status_code"`
+	// Header holds the response headers to replay, without hop-by-hop ones.
+	Header    http.Header `json:"header"`
+	Body      []byte      `json:"body"`
+	CreatedAt time.Time   `json:"created_at"`
+	ExpiresAt time.Time   `json:"expires_at"`
+}
+
+// Completed reports whether the original request has finished and its
+// response can be replayed.
+func (r IdempotencyRecord) Completed() bool {
+	return r.StatusCode != nil
+}
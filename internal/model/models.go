@@ -7,50 +7,60 @@ import (
 )
 
 type Season struct {
-	ID   uuid.UUID `json:"id"`
-	Year int       `json:"year"`
-	URL  string    `json:"url"`
+	ID        uuid.UUID  `json:"id"`
+	Year      int        `json:"year"`
+	URL       string     `json:"url"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 type Race struct {
-	ID        uuid.UUID `json:"id"`
-	SeasonID  uuid.UUID `json:"season_id"`
-	CircuitID uuid.UUID `json:"circuit_id"`
-	Round     int       `json:"round"`
-	Name      string    `json:"name"`
-	Date      time.Time `json:"date"`
-	URL       string    `json:"url"`
+	ID        uuid.UUID  `json:"id"`
+	SeasonID  uuid.UUID  `json:"season_id"`
+	CircuitID uuid.UUID  `json:"circuit_id"`
+	Round     int        `json:"round"`
+	Name      string     `json:"name"`
+	Date      time.Time  `json:"date"`
+	URL       string     `json:"url"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+type RaceWithResults struct {
+	Race
+	Results []*Result `json:"results"`
 }
 
 type Result struct {
-	ID            uuid.UUID `json:"id"`
-	RaceID        uuid.UUID `json:"race_id"`
-	DriverID      uuid.UUID `json:"driver_id"`
-	ConstructorID uuid.UUID `json:"constructor_id"`
-	Number        int       `json:"number"`
-	Grid          int       `json:"grid"`
-	Position      *int      `json:"position"`
-	PositionText  string    `json:"position_text"`
-	Points        float64   `json:"points"`
-	Laps          int       `json:"laps"`
-	Time          string    `json:"time"`
-	Status        string    `json:"status"`
+	ID            uuid.UUID  `json:"id"`
+	RaceID        uuid.UUID  `json:"race_id"`
+	DriverID      uuid.UUID  `json:"driver_id"`
+	ConstructorID uuid.UUID  `json:"constructor_id"`
+	Number        int        `json:"number"`
+	Grid          int        `json:"grid"`
+	Position      *int       `json:"position"`
+	PositionText  string     `json:"position_text"`
+	Points        float64    `json:"points"`
+	Laps          int        `json:"laps"`
+	Time          string     `json:"time"`
+	Status        string     `json:"status"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
 }
 
 type DriverStanding struct {
-	ID       uuid.UUID `json:"id"`
-	SeasonID uuid.UUID `json:"season_id"`
-	DriverID uuid.UUID `json:"driver_id"`
-	Position int       `json:"position"`
-	Points   float64   `json:"points"`
-	Wins     int       `json:"wins"`
+	ID        uuid.UUID  `json:"id"`
+	SeasonID  uuid.UUID  `json:"season_id"`
+	DriverID  uuid.UUID  `json:"driver_id"`
+	Position  int        `json:"position"`
+	Points    float64    `json:"points"`
+	Wins      int        `json:"wins"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 type ConstructorStanding struct {
-	ID            uuid.UUID `json:"id"`
-	SeasonID      uuid.UUID `json:"season_id"`
-	ConstructorID uuid.UUID `json:"constructor_id"`
-	Position      int       `json:"position"`
-	Points        float64   `json:"points"`
-	Wins          int       `json:"wins"`
+	ID            uuid.UUID  `json:"id"`
+	SeasonID      uuid.UUID  `json:"season_id"`
+	ConstructorID uuid.UUID  `json:"constructor_id"`
+	Position      int        `json:"position"`
+	Points        float64    `json:"points"`
+	Wins          int        `json:"wins"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
 }
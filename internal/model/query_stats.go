@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// QueryStatement aggregates every run of one SQL statement since the server
+// started. SQL has its literals replaced and whitespace collapsed.
+type QueryStatement struct {
+	ID           string    `json:"id"`
+	SQL          string    `json:"sql"`
+	Caller       string    `json:"caller"`
+	Calls        int64     `json:"calls"`
+	Errors       int64     `json:"errors"`
+	SlowCalls    int64     `json:"slow_calls"`
+	TotalMs      float64   `json:"total_ms"`
+	MeanMs       float64   `json:"mean_ms"`
+	MinMs        float64   `json:"min_ms"`
+	MaxMs        float64   `json:"max_ms"`
+	LastCalledAt time.Time `json:"last_called_at"`
+}
+
+// QueryPlan is the EXPLAIN output of a statement, run with the arguments of
+// its last call.
+type QueryPlan struct {
+	Statement QueryStatement `json:"statement"`
+	Analyzed  bool           `json:"analyzed"`
+	Plan      []string       `json:"plan"`
+}
@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// LatencyBucketBoundsMs are the upper bounds of the latency histogram kept
+// for API usage. Requests slower than the last bound land in an extra
+// overflow bucket.
+var LatencyBucketBoundsMs = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// UsageCounter aggregates the requests of one client to one route with one
+// status code during an hour.
+type UsageCounter struct {
+	Hour            time.Time `json:"hour"`
+	Client          string    `json:"client"`
+	Route           string    `json:"route"`
+	Status          int       `json:"status"`
+	Requests        int64     `json:"requests"`
+	TotalDurationMs float64   `json:"total_duration_ms"`
+	LatencyBuckets  []int64   `json:"latency_buckets"`
+}
+
+type UsageReportEntry struct {
+	Client       string           `json:"client"`
+	Route        string           `json:"route"`
+	Requests     int64            `json:"requests"`
+	ClientErrors int64            `json:"client_errors"`
+	ServerErrors int64            `json:"server_errors"`
+	ErrorRate    float64          `json:"error_rate"`
+	AvgLatencyMs float64          `json:"avg_latency_ms"`
+	P50LatencyMs float64          `json:"p50_latency_ms"`
+	P95LatencyMs float64          `json:"p95_latency_ms"`
+	P99LatencyMs float64          `json:"p99_latency_ms"`
+	Statuses     map[string]int64 `json:"statuses"`
+}
+
+type UsageReport struct {
+	From    time.Time          `json:"from"`
+	To      time.Time          `json:"to"`
+	Entries []UsageReportEntry `json:"entries"`
+}
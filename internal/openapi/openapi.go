@@ -0,0 +1,172 @@
+// Package openapi describes the API as an OpenAPI 3.1 document built from the
+// registered routes and the internal/model types.
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+)
+
+// DocsPage is a self-contained HTML page that renders /openapi.json.
+//
+//go:embed docs.html
+var DocsPage []byte
+
+// Route is a registered route, as recorded by the router.
+type Route struct {
+	// Pattern is the ServeMux pattern, "METHOD /path".
+	Pattern string
+	// Scope is the scope the route requires, empty for none.
+	Scope model.Scope
+	// Deprecated marks an alias kept for an older path.
+	Deprecated bool
+}
+
+// Uncovered returns the patterns of the routes that have no description, so
+// that they would be missing from the document. Deprecated aliases are
+// covered by the route they alias.
+func Uncovered(routes []Route) []string {
+	var missing []string
+	for _, route := range routes {
+		if _, ok := operations[route.Pattern]; !ok && !route.Deprecated {
+			missing = append(missing, route.Pattern)
+		}
+	}
+	return missing
+}
+
+var wildcard = regexp.MustCompile(`\{(\w+)(\.\.\.)?\}`)
+
+// Build returns the document for routes. Deprecated aliases and routes
+// without a description are left out.
+func Build(routes []Route) *Document {
+	s := make(schemas)
+	doc := &Document{
+		OpenAPI: "3.1.0",
+		Info: Info{
+			Title:   "F1 API",
+			Version: "1",
+			Description: "Formula 1 constructors, drivers, circuits, seasons, races, results and standings. " +
+				"Responses are JSON, or CSV, XML, YAML or NDJSON as asked for with Accept or ?format=. Errors are plain text. The unversioned paths that predate /v1 are deprecated aliases of the /v1 routes.",
+		},
+		Paths: make(map[string]PathItem),
+		Components: Components{
+			Schemas: s,
+			SecuritySchemes: map[string]SecurityScheme{
+				"apiKey": {Type: "apiKey", In: "header", Name: "X-API-Key"},
+				"bearer": {Type: "http", Scheme: "bearer", Description: "An API key or a JWT."},
+			},
+		},
+	}
+
+	add := func(pattern string, scope model.Scope, op operation) {
+		method, path, _ := strings.Cut(pattern, " ")
+		item := doc.Paths[path]
+		if item == nil {
+			item = make(PathItem)
+			doc.Paths[path] = item
+		}
+		item[strings.ToLower(method)] = op.build(s, path, scope)
+		if !slices.ContainsFunc(doc.Tags, func(t Tag) bool { return t.Name == op.tag }) {
+			doc.Tags = append(doc.Tags, Tag{Name: op.tag})
+		}
+	}
+	for _, route := range routes {
+		if op, ok := operations[route.Pattern]; ok && !route.Deprecated {
+			add(route.Pattern, route.Scope, op)
+		}
+	}
+	for pattern, op := range rootOperations {
+		add(pattern, "", op)
+	}
+	slices.SortFunc(doc.Tags, func(a, b Tag) int { return strings.Compare(a.Name, b.Name) })
+	return doc
+}
+
+func (op operation) build(s schemas, path string, scope model.Scope) *Operation {
+	out := &Operation{
+		OperationID: op.id,
+		Summary:     op.summary,
+		Tags:        []string{op.tag},
+		Responses:   make(map[string]Response),
+	}
+
+	for _, match := range wildcard.FindAllStringSubmatch(path, -1) {
+		param, ok := op.pathParams[match[1]]
+		if !ok {
+			param = defaultPathParams[match[1]]
+		}
+		param.Name, param.In, param.Required = match[1], "path", true
+		if param.Schema == nil {
+			param.Schema = stringSchema
+		}
+		out.Parameters = append(out.Parameters, param)
+	}
+	out.Parameters = append(out.Parameters, op.query...)
+
+	if op.body != nil {
+		schema := s.of(reflect.TypeOf(op.body))
+		content := map[string]MediaType{"application/json": {Schema: schema}}
+		if op.bulk {
+			content = map[string]MediaType{
+				"application/json":     {Schema: &Schema{Type: "array", Items: schema}},
+				"application/x-ndjson": {Schema: schema},
+			}
+		}
+		out.RequestBody = &RequestBody{Required: true, Content: content}
+	}
+
+	status := op.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := Response{Description: http.StatusText(status)}
+	if op.response != nil && !op.unimplemented {
+		schema := s.of(reflect.TypeOf(op.response))
+		if op.list {
+			schema = &Schema{Type: "array", Items: schema}
+		}
+		if op.contentType != "" {
+			success.Content = map[string]MediaType{op.contentType: {Schema: schema}}
+		} else {
+			success.Content = map[string]MediaType{
+				"application/json": {Schema: schema},
+				"application/yaml": {Schema: schema},
+				"application/xml":  {Schema: stringSchema},
+				"text/csv":         {Schema: stringSchema},
+			}
+			if op.list {
+				success.Content["application/x-ndjson"] = MediaType{Schema: schema.Items}
+			}
+			out.Parameters = append(out.Parameters, formatParam)
+		}
+	}
+	out.Responses[strconv.Itoa(status)] = success
+	out.Responses["default"] = Response{
+		Description: "Error",
+		Content:     map[string]MediaType{"text/plain": {Schema: stringSchema}},
+	}
+
+	if scope != "" {
+		out.Scope = string(scope)
+		out.Security = []map[string][]string{{"apiKey": {}}, {"bearer": {}}}
+		if scope == model.ScopeRead {
+			out.Description = "Requires the read scope, which anonymous callers hold unless anonymous reads are turned off."
+			out.Security = append(out.Security, map[string][]string{})
+		} else {
+			out.Description = "Requires the " + string(scope) + " scope."
+		}
+	}
+	if op.unimplemented {
+		out.Unimplemented = true
+		out.Description = strings.TrimSpace("Not implemented yet: answers with an empty body and stores nothing. " + out.Description)
+	}
+	return out
+}
@@ -0,0 +1,313 @@
+package openapi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/google/uuid"
+)
+
+// operation describes what a route takes and returns. Path parameters are
+// derived from the route's wildcards.
+type operation struct {
+	id      string
+	summary string
+	tag     string
+	query   []Parameter
+	// pathParams overrides the default description of wildcards.
+	pathParams map[string]Parameter
+	// body is a value of the request body type. With bulk set the body is a
+	// list of them, as a JSON array or NDJSON.
+	body any
+	bulk bool
+	// status is the success status, 200 when zero.
+	status int
+	// response is a value of the response type, nil for no content. With
+	// list set the response is a list of them.
+	response any
+	list     bool
+	// contentType is the type of the response when it is not negotiated, by
+	// default it is JSON, CSV, XML or YAML as the client asks.
+	contentType string
+	// unimplemented marks a route whose handler is still a stub: it answers
+	// with the success status and no body, and stores nothing.
+	unimplemented bool
+}
+
+func query(name, description string, schema *Schema) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+var (
+	stringSchema  = &Schema{Type: "string"}
+	integerSchema = &Schema{Type: "integer"}
+	booleanSchema = &Schema{Type: "boolean"}
+
+	pageParams = []Parameter{
+		query("page", "Page number, from 1.", integerSchema),
+		query("limit", "Page size.", integerSchema),
+	}
+	includeDeleted = query("include_deleted", "Also return soft-deleted rows. Requires the admin scope.", booleanSchema)
+	formatParam    = query("format", "Response format, overriding the Accept header.", &Schema{Type: "string", Enum: []string{"json", "csv", "xml", "yaml", "ndjson"}})
+	streamParam    = query("stream", "Stream every matching record, unpaginated, as it is read. NDJSON is always streamed.", booleanSchema)
+)
+
+// defaultPathParams describes the wildcards of routes.
+var defaultPathParams = map[string]Parameter{
+	"id":      {Description: "ID of the record.", Schema: &Schema{Type: "string", Format: "uuid"}},
+	"year":    {Description: "Year of the season.", Schema: integerSchema},
+	"round":   {Description: "Round of the race within its season.", Schema: integerSchema},
+	"version": {Description: "ID of the history entry.", Schema: &Schema{Type: "integer", Format: "int64"}},
+}
+
+// resource is a collection served with the standard create, read, update,
+// delete, bulk, soft delete and history routes.
+type resource struct {
+	path     string
+	tag      string
+	singular string
+	// noun and plural name the records in summaries.
+	noun, plural string
+	model        any
+	// alias names what the {id} wildcard accepts besides the ID, if any.
+	alias   string
+	filters []Parameter
+	// stream marks a list that can be streamed.
+	stream bool
+	// unimplemented lists the routes that are still stubs, by method and
+	// path below the resource, e.g. "PUT /{id}".
+	unimplemented []string
+}
+
+func (res resource) operations() map[string]operation {
+	var idParam map[string]Parameter
+	if res.alias != "" {
+		idParam = map[string]Parameter{
+			"id": {Description: "ID or " + res.alias + " of the record.", Schema: stringSchema},
+		}
+	}
+	list := append(append(append([]Parameter{}, res.filters...), pageParams...), includeDeleted)
+	if res.stream {
+		list = append(list, streamParam)
+	}
+	item := res.path + "/{id}"
+	ops := map[string]operation{
+		"GET " + res.path: {
+			id: "list" + res.tag, summary: "List " + res.plural, tag: res.tag, query: list,
+			response: res.model, list: true,
+		},
+		"POST " + res.path: {
+			id: "create" + res.singular, summary: "Create a " + res.noun, tag: res.tag,
+			body: res.model, response: res.model,
+		},
+		"POST " + res.path + "/bulk": {
+			id: "bulkUpsert" + res.tag, summary: "Create or update " + res.plural + " by natural key", tag: res.tag,
+			query: []Parameter{query("mode", "atomic (default) or best-effort.", &Schema{Type: "string", Enum: []string{string(model.BulkModeAtomic), string(model.BulkModeBestEffort)}})},
+			body:  res.model, bulk: true, response: model.BulkResult{},
+		},
+		"GET " + item: {
+			id: "get" + res.singular, summary: "Get a " + res.noun, tag: res.tag, pathParams: idParam,
+			query: []Parameter{includeDeleted}, response: res.model,
+		},
+		"PUT " + item: {
+			id: "update" + res.singular, summary: "Update a " + res.noun, tag: res.tag, pathParams: idParam,
+			body: res.model, response: res.model,
+		},
+		"DELETE " + item: {
+			id: "delete" + res.singular, summary: "Soft-delete a " + res.noun, tag: res.tag, pathParams: idParam,
+			status: http.StatusNoContent,
+		},
+		"POST " + item + "/restore": {
+			id: "restore" + res.singular, summary: "Restore a soft-deleted " + res.noun, tag: res.tag, pathParams: idParam,
+			status: http.StatusNoContent,
+		},
+		"POST " + item + "/purge": {
+			id: "purge" + res.singular, summary: "Permanently remove a soft-deleted " + res.noun, tag: res.tag, pathParams: idParam,
+			status: http.StatusNoContent,
+		},
+		"GET " + item + "/history": {
+			id: "get" + res.singular + "History", summary: "List the changes of a " + res.noun, tag: res.tag, pathParams: idParam,
+			query: pageParams, response: []model.AuditEntry{},
+		},
+		"POST " + item + "/history/{version}/revert": {
+			id: "revert" + res.singular, summary: "Revert a " + res.noun + " to a recorded version", tag: res.tag, pathParams: idParam,
+			response: res.model,
+		},
+	}
+	for _, route := range res.unimplemented {
+		method, path, _ := strings.Cut(route, " ")
+		pattern := method + " " + res.path + path
+		op, ok := ops[pattern]
+		if !ok {
+			panic("openapi: unknown unimplemented route " + pattern)
+		}
+		op.unimplemented = true
+		ops[pattern] = op
+	}
+	return ops
+}
+
+var resources = []resource{
+	{
+		path: "/v1/constructors", tag: "Constructors", singular: "Constructor", noun: "constructor", plural: "constructors", model: model.Constructor{}, alias: "ref", stream: true,
+		filters: []Parameter{
+			query("name", "", stringSchema),
+			query("nationality", "", stringSchema),
+			query("ref", "", stringSchema),
+		},
+	},
+	{
+		path: "/v1/drivers", tag: "Drivers", singular: "Driver", noun: "driver", plural: "drivers", model: model.Driver{}, alias: "ref", stream: true,
+		filters: []Parameter{
+			query("firstName", "", stringSchema),
+			query("lastName", "", stringSchema),
+			query("team", "Constructor name.", stringSchema),
+			query("nationality", "", stringSchema),
+			query("status", "", stringSchema),
+			query("ref", "", stringSchema),
+			query("code", "", stringSchema),
+			query("number", "", integerSchema),
+			query("url", "", stringSchema),
+		},
+	},
+	{
+		path: "/v1/circuits", tag: "Circuits", singular: "Circuit", noun: "circuit", plural: "circuits", model: model.Circuit{}, alias: "ref", stream: true,
+		filters: []Parameter{
+			query("name", "", stringSchema),
+			query("location", "", stringSchema),
+			query("country", "", stringSchema),
+			query("current", "", booleanSchema),
+			query("ref", "", stringSchema),
+			query("url", "", stringSchema),
+		},
+	},
+	{
+		path: "/v1/seasons", tag: "Seasons", singular: "Season", noun: "season", plural: "seasons", model: model.Season{}, alias: "year",
+		unimplemented: []string{"GET ", "POST ", "GET /{id}", "PUT /{id}"},
+	},
+	{
+		path: "/v1/races", tag: "Races", singular: "Race", noun: "race", plural: "races", model: model.Race{},
+		unimplemented: []string{"GET ", "GET /{id}", "PUT /{id}"},
+	},
+	{
+		path: "/v1/results", tag: "Results", singular: "Result", noun: "result", plural: "results", model: model.Result{},
+		unimplemented: []string{"GET ", "POST ", "GET /{id}", "PUT /{id}"},
+	},
+	{
+		path: "/v1/driver-standings", tag: "DriverStandings", singular: "DriverStanding", noun: "driver standing", plural: "driver standings", model: model.DriverStanding{},
+		unimplemented: []string{"GET ", "POST ", "GET /{id}", "PUT /{id}"},
+	},
+	{
+		path: "/v1/constructor-standings", tag: "ConstructorStandings", singular: "ConstructorStanding", noun: "constructor standing", plural: "constructor standings", model: model.ConstructorStanding{},
+		unimplemented: []string{"GET ", "POST ", "GET /{id}", "PUT /{id}"},
+	},
+}
+
+// driverMerge is the body of a driver merge.
+type driverMerge struct {
+	Into uuid.UUID `json:"into"`
+}
+
+// operations describes every route by its pattern.
+var operations = func() map[string]operation {
+	ops := map[string]operation{
+		"POST /v1/drivers/{id}/merge": {
+			id: "mergeDriver", summary: "Merge a driver into another one", tag: "Drivers",
+			pathParams: map[string]Parameter{"id": {Description: "ID or ref of the driver merged away.", Schema: stringSchema}},
+			body:       driverMerge{}, response: model.Driver{},
+		},
+		"POST /v1/races": {
+			id: "createRace", summary: "Create a race with its results", tag: "Races",
+			body: model.RaceWithResults{}, status: http.StatusCreated, response: model.RaceWithResults{},
+		},
+
+		"GET /v1/seasons/{year}/races": {
+			id: "listSeasonRaces", summary: "List the races of a season", tag: "Seasons",
+			query: []Parameter{includeDeleted}, response: []model.Race{},
+		},
+		"GET /v1/seasons/{year}/races/{round}": {
+			id: "getSeasonRace", summary: "Get a race of a season by round", tag: "Seasons",
+			query: []Parameter{includeDeleted}, response: model.Race{},
+		},
+		"GET /v1/seasons/{year}/races/{round}/results": {
+			id: "listSeasonRaceResults", summary: "List the results of a race of a season", tag: "Seasons",
+			query: []Parameter{includeDeleted}, response: []model.Result{},
+		},
+		"GET /v1/seasons/{year}/driver-standings": {
+			id: "listSeasonDriverStandings", summary: "List the driver standings of a season", tag: "Seasons",
+			query: []Parameter{includeDeleted}, response: []model.DriverStanding{},
+		},
+		"GET /v1/seasons/{year}/constructor-standings": {
+			id: "listSeasonConstructorStandings", summary: "List the constructor standings of a season", tag: "Seasons",
+			query: []Parameter{includeDeleted}, response: []model.ConstructorStanding{},
+		},
+		"GET /v1/races/{id}/results": {
+			id: "listRaceResults", summary: "List the results of a race", tag: "Races",
+			query: []Parameter{includeDeleted}, response: []model.Result{},
+		},
+
+		"POST /v1/batch": {
+			id: "executeBatch", summary: "Run several API calls, optionally in one transaction", tag: "Batch",
+			body: model.BatchRequest{}, response: model.BatchResponse{},
+		},
+		"POST /v1/graphql": {
+			id: "queryGraphQL", summary: "Run a read-only GraphQL query", tag: "GraphQL",
+			body: model.GraphQLRequest{}, response: model.GraphQLResponse{}, contentType: "application/json",
+		},
+
+		"GET /v1/admin/usage": {
+			id: "getUsageReport", summary: "Report API usage per caller and route", tag: "Admin",
+			query: []Parameter{
+				query("from", "Start of the window, RFC 3339. Defaults to 24 hours before to.", &Schema{Type: "string", Format: "date-time"}),
+				query("to", "End of the window, RFC 3339. Defaults to now.", &Schema{Type: "string", Format: "date-time"}),
+				query("client", "Only this caller.", stringSchema),
+				query("route", "Only this route, e.g. GET /v1/drivers/{id}.", stringSchema),
+			},
+			response: model.UsageReport{},
+		},
+		"GET /v1/admin/queries": {
+			id: "getQueryStats", summary: "List query statistics per statement", tag: "Admin",
+			response: []model.QueryStatement{},
+		},
+		"GET /v1/admin/queries/{id}/explain": {
+			id: "explainQuery", summary: "Explain a statement with the arguments of its last call", tag: "Admin",
+			pathParams: map[string]Parameter{"id": {Description: "Statement ID.", Schema: stringSchema}},
+			query:      []Parameter{query("analyze", "Run the statement, in a rolled back read-only transaction, to report actual timings. Only SELECT statements and WITH queries that do not write can be analyzed.", booleanSchema)},
+			response:   model.QueryPlan{},
+		},
+		"GET /metrics": {
+			id: "getMetrics", summary: "Prometheus metrics", tag: "Admin",
+			response: "", contentType: "text/plain",
+		},
+	}
+	for _, res := range resources {
+		for pattern, op := range res.operations() {
+			if _, ok := ops[pattern]; !ok {
+				ops[pattern] = op
+			}
+		}
+	}
+	return ops
+}()
+
+// rootOperations describe the routes served outside of the API, without
+// authentication.
+var rootOperations = map[string]operation{
+	"GET /healthz": {
+		id: "live", summary: "Liveness probe", tag: "Health",
+		response: map[string]string{}, contentType: "application/json",
+	},
+	"GET /readyz": {
+		id: "ready", summary: "Readiness probe, 503 when not ready", tag: "Health",
+		response: model.Readiness{}, contentType: "application/json",
+	},
+	"GET /openapi.json": {
+		id: "getOpenAPI", summary: "This document", tag: "Docs",
+		response: map[string]any{}, contentType: "application/json",
+	},
+	"GET /docs": {
+		id: "getDocs", summary: "API documentation page", tag: "Docs",
+		response: "", contentType: "text/html",
+	},
+}
@@ -0,0 +1,220 @@
+// Package querystats keeps per-statement query statistics and logs slow
+// queries.
+package querystats
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// maxStatements bounds the memory used by statistics; statements seen
+	// after the limit is reached are not tracked.
+	maxStatements  = 1000
+	maxArgLength   = 200
+	modulePrefix   = "github.com/ChinmayNoob/f1/"
+	packagePrefix  = modulePrefix + "internal/querystats."
+	explainKeyword = "EXPLAIN"
+)
+
+// Collector is a pgx tracer that aggregates latency per statement and logs
+// every query slower than the threshold with its caller, and its arguments
+// when logArgs is set.
+type Collector struct {
+	threshold time.Duration
+	logArgs   bool
+
+	mu         sync.Mutex
+	statements map[string]*statement
+}
+
+type statement struct {
+	model.QueryStatement
+	lastSQL  string
+	lastArgs []any
+}
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	sql    string
+	args   []any
+	caller string
+	at     time.Time
+}
+
+// NewCollector returns a collector that logs queries slower than threshold.
+// A zero threshold turns the slow query log off. Arguments are logged only
+// with logArgs, since they may hold personal data; they are replaced with
+// their count otherwise.
+func NewCollector(threshold time.Duration, logArgs bool) *Collector {
+	return &Collector{
+		threshold:  threshold,
+		logArgs:    logArgs,
+		statements: make(map[string]*statement),
+	}
+}
+
+func (c *Collector) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{
+		sql:    data.SQL,
+		args:   data.Args,
+		caller: caller(),
+		at:     time.Now(),
+	})
+}
+
+func (c *Collector) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(start.at)
+	sql := tracing.SanitizeSQL(start.sql)
+	if strings.HasPrefix(strings.ToUpper(sql), explainKeyword) {
+		return
+	}
+
+	slow := c.threshold > 0 && elapsed >= c.threshold
+	if slow {
+		attrs := []any{
+			"duration_ms", milliseconds(elapsed),
+			"sql", sql,
+			"caller", start.caller,
+		}
+		if c.logArgs {
+			attrs = append(attrs, "args", formatArgs(start.args))
+		} else {
+			attrs = append(attrs, "arg_count", len(start.args))
+		}
+		if data.Err != nil {
+			attrs = append(attrs, "error", data.Err)
+		}
+		slog.WarnContext(ctx, "Slow query", attrs...)
+	}
+	c.record(sql, start, elapsed, slow, data.Err)
+}
+
+func (c *Collector) record(sql string, start queryStart, elapsed time.Duration, slow bool, err error) {
+	id := statementID(sql)
+	ms := milliseconds(elapsed)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.statements[id]
+	if s == nil {
+		if len(c.statements) >= maxStatements {
+			return
+		}
+		s = &statement{QueryStatement: model.QueryStatement{ID: id, SQL: sql, MinMs: ms}}
+		c.statements[id] = s
+	}
+	s.Calls++
+	if err != nil {
+		s.Errors++
+	}
+	if slow {
+		s.SlowCalls++
+	}
+	s.TotalMs += ms
+	s.MinMs = min(s.MinMs, ms)
+	s.MaxMs = max(s.MaxMs, ms)
+	s.Caller = start.caller
+	s.LastCalledAt = start.at
+	s.lastSQL = start.sql
+	s.lastArgs = start.args
+}
+
+// Statements returns the statistics of every statement, the ones that took
+// the most time in total first.
+func (c *Collector) Statements() []model.QueryStatement {
+	c.mu.Lock()
+	statements := make([]model.QueryStatement, 0, len(c.statements))
+	for _, s := range c.statements {
+		statements = append(statements, s.snapshot())
+	}
+	c.mu.Unlock()
+
+	slices.SortFunc(statements, func(a, b model.QueryStatement) int {
+		switch {
+		case a.TotalMs > b.TotalMs:
+			return -1
+		case a.TotalMs < b.TotalMs:
+			return 1
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return statements
+}
+
+// Statement returns the statistics of the statement with id together with
+// the SQL and arguments of its last call.
+func (c *Collector) Statement(id string) (stmt model.QueryStatement, sql string, args []any, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.statements[id]
+	if s == nil {
+		return model.QueryStatement{}, "", nil, false
+	}
+	return s.snapshot(), s.lastSQL, s.lastArgs, true
+}
+
+func (s *statement) snapshot() model.QueryStatement {
+	stmt := s.QueryStatement
+	stmt.MeanMs = stmt.TotalMs / float64(stmt.Calls)
+	return stmt
+}
+
+func statementID(sql string) string {
+	sum := sha256.Sum256([]byte(sql))
+	return hex.EncodeToString(sum[:8])
+}
+
+// caller returns the first function of this module on the stack that is not
+// a tracer, e.g. "repository.(*driverRepository).GetDriverByTeam
+// (driver_repository.go:212)".
+func caller() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if strings.HasPrefix(frame.Function, modulePrefix) && !strings.HasPrefix(frame.Function, packagePrefix) {
+			name := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+			return fmt.Sprintf("%s (%s:%d)", name, filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+func formatArgs(args []any) []string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		s := fmt.Sprint(arg)
+		if len(s) > maxArgLength {
+			s = s[:maxArgLength] + "..."
+		}
+		formatted[i] = s
+	}
+	return formatted
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,55 @@
+package querystats
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func TestSlowQueryLogRedactsArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		logArgs  bool
+		want     []string
+		wantNone []string
+	}{
+		{"args redacted", false, []string{`"arg_count":2`}, []string{"hamilton@example.com", `"args"`}},
+		{"args logged", true, []string{`"args":["hamilton@example.com","44"]`}, []string{"arg_count"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			defer slog.SetDefault(slog.Default())
+			slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+			// A threshold of a nanosecond makes every query slow.
+			c := NewCollector(time.Nanosecond, tt.logArgs)
+			ctx := c.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{
+				SQL:  "SELECT id FROM drivers WHERE email = $1 AND number = $2",
+				Args: []any{"hamilton@example.com", 44},
+			})
+			time.Sleep(time.Millisecond)
+			c.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+
+			line := buf.String()
+			if !strings.Contains(line, `"msg":"Slow query"`) {
+				t.Fatalf("log = %q, want a slow query", line)
+			}
+			for _, s := range tt.want {
+				if !strings.Contains(line, s) {
+					t.Errorf("log = %q, want it to contain %s", line, s)
+				}
+			}
+			for _, s := range tt.wantNone {
+				if strings.Contains(line, s) {
+					t.Errorf("log = %q, want it without %s", line, s)
+				}
+			}
+		})
+	}
+}
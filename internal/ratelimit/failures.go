@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// AuthFailureTier bounds how many bad credentials a client may present: 10
+// at once, then one every 6 seconds.
+var AuthFailureTier = Tier{Name: "auth_failures", Rate: 10.0 / 60, Burst: 10}
+
+// Failures keeps a token bucket per client that only failed attempts take
+// from, so that a client is held back once it has failed too often while
+// its successful attempts stay unlimited. Buckets live in memory. A nil
+// Failures holds no client back.
+type Failures struct {
+	tier Tier
+	now  func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+func NewFailures(tier Tier) *Failures {
+	return &Failures{
+		tier:    tier,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// RetryAfter returns how long client must wait before its next attempt, or
+// 0 if it may try now.
+func (f *Failures) RetryAfter(client string) time.Duration {
+	if f == nil {
+		return 0
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	b := f.refill(client)
+	if b == nil || b.tokens >= 1 {
+		return 0
+	}
+	return seconds((1 - b.tokens) / f.tier.Rate)
+}
+
+// Add counts a failed attempt of client.
+func (f *Failures) Add(client string) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	b := f.refill(client)
+	if b == nil {
+		b = &bucket{tokens: float64(f.tier.Burst), last: f.now()}
+		f.buckets[client] = b
+	}
+	b.tokens = math.Max(0, b.tokens-1)
+}
+
+// Run drops the buckets of clients that have not failed for a while every
+// interval, until ctx is cancelled.
+func (f *Failures) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.dropIdle()
+		}
+	}
+}
+
+// refill tops up the bucket of client for the time since it was last used
+// and returns it, or nil if client has no bucket.
+func (f *Failures) refill(client string) *bucket {
+	b := f.buckets[client]
+	if b == nil {
+		return nil
+	}
+	now := f.now()
+	b.tokens = math.Min(float64(f.tier.Burst), b.tokens+now.Sub(b.last).Seconds()*f.tier.Rate)
+	b.last = now
+	return b
+}
+
+func (f *Failures) dropIdle() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	for client, b := range f.buckets {
+		if now.Sub(b.last) > idleBucketTTL {
+			delete(f.buckets, client)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFailures(t *testing.T) {
+	now := time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC)
+	f := NewFailures(Tier{Name: "test", Rate: 0.5, Burst: 3})
+	f.now = func() time.Time { return now }
+
+	if got := f.RetryAfter("ip:1"); got != 0 {
+		t.Fatalf("RetryAfter() before any failure = %v, want 0", got)
+	}
+	for range 3 {
+		f.Add("ip:1")
+	}
+	if got := f.RetryAfter("ip:1"); got != 2*time.Second {
+		t.Errorf("RetryAfter() after the burst = %v, want 2s", got)
+	}
+	if got := f.RetryAfter("ip:2"); got != 0 {
+		t.Errorf("RetryAfter() of another client = %v, want 0", got)
+	}
+
+	now = now.Add(time.Second)
+	if got := f.RetryAfter("ip:1"); got != time.Second {
+		t.Errorf("RetryAfter() a second later = %v, want 1s", got)
+	}
+	now = now.Add(time.Second)
+	if got := f.RetryAfter("ip:1"); got != 0 {
+		t.Errorf("RetryAfter() once refilled = %v, want 0", got)
+	}
+
+	// Failing again while held back does not dig the bucket deeper.
+	f.Add("ip:1")
+	f.Add("ip:1")
+	if got := f.RetryAfter("ip:1"); got != 2*time.Second {
+		t.Errorf("RetryAfter() after failing again = %v, want 2s", got)
+	}
+
+	now = now.Add(idleBucketTTL + time.Second)
+	f.dropIdle()
+	if len(f.buckets) != 0 {
+		t.Errorf("%d buckets left after dropping idle ones, want 0", len(f.buckets))
+	}
+
+	var disabled *Failures
+	disabled.Add("ip:1")
+	if got := disabled.RetryAfter("ip:1"); got != 0 {
+		t.Errorf("RetryAfter() of a nil Failures = %v, want 0", got)
+	}
+}
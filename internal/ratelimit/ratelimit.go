@@ -0,0 +1,287 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+)
+
+// idleBucketTTL is how long a full, unused bucket is kept before it is
+// dropped to bound memory.
+const idleBucketTTL = 10 * time.Minute
+
+// Tier is a rate limit policy. Clients get Burst requests at once, refilled
+// at Rate requests per second, and at most DailyQuota requests per UTC day;
+// a DailyQuota of 0 means no quota.
+type Tier struct {
+	Name       string
+	Rate       float64
+	Burst      int
+	DailyQuota int64
+}
+
+// DefaultTiers applies unless overridden by configuration.
+var DefaultTiers = map[string]Tier{
+	model.TierAnonymous: {Name: model.TierAnonymous, Rate: 2, Burst: 10, DailyQuota: 5000},
+	model.TierStandard:  {Name: model.TierStandard, Rate: 10, Burst: 20, DailyQuota: 100000},
+	"premium":           {Name: "premium", Rate: 50, Burst: 100, DailyQuota: 0},
+}
+
+// ParseTiers reads a comma separated list of name=rate:burst:quota entries,
+// e.g. "standard=10:20:100000,partner=100:200:0", on top of DefaultTiers.
+func ParseTiers(spec string) (map[string]Tier, error) {
+	tiers := make(map[string]Tier, len(DefaultTiers))
+	for name, tier := range DefaultTiers {
+		tiers[name] = tier
+	}
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, policy, ok := strings.Cut(entry, "=")
+		fields := strings.Split(policy, ":")
+		if !ok || name == "" || len(fields) != 3 {
+			return nil, fmt.Errorf("tier %q: want name=rate:burst:quota", entry)
+		}
+		rate, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("tier %q: invalid rate", entry)
+		}
+		burst, err := strconv.Atoi(fields[1])
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("tier %q: invalid burst", entry)
+		}
+		quota, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil || quota < 0 {
+			return nil, fmt.Errorf("tier %q: invalid quota", entry)
+		}
+		tiers[name] = Tier{Name: name, Rate: rate, Burst: burst, DailyQuota: quota}
+	}
+	return tiers, nil
+}
+
+// Decision is the outcome of a request against a client's limits.
+type Decision struct {
+	Allowed bool
+	// QuotaExceeded is set when the request was refused because of the daily
+	// quota rather than the rate.
+	QuotaExceeded  bool
+	Limit          int
+	Remaining      int
+	Reset          time.Duration
+	RetryAfter     time.Duration
+	QuotaLimit     int64
+	QuotaRemaining int64
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type usage struct {
+	day     time.Time
+	used    int64
+	pending int64
+}
+
+// Limiter keeps a token bucket and a daily request count per client. Buckets
+// live in memory; daily counts are loaded from and flushed to the quota
+// repository so they survive restarts.
+type Limiter struct {
+	tiers map[string]Tier
+	repo  repository.QuotaRepository
+	now   func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	usage   map[string]*usage
+	// stale holds unflushed counts of days that have already ended.
+	stale []staleUsage
+}
+
+type staleUsage struct {
+	client string
+	day    time.Time
+	count  int64
+}
+
+func NewLimiter(tiers map[string]Tier, repo repository.QuotaRepository) *Limiter {
+	return &Limiter{
+		tiers:   tiers,
+		repo:    repo,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+		usage:   make(map[string]*usage),
+	}
+}
+
+// Tier returns the named tier, falling back to the standard tier for names
+// that are not configured.
+func (l *Limiter) Tier(name string) Tier {
+	if tier, ok := l.tiers[name]; ok {
+		return tier
+	}
+	return l.tiers[model.TierStandard]
+}
+
+// Allow takes one request from client's bucket and daily quota.
+func (l *Limiter) Allow(ctx context.Context, client string, tier Tier) (Decision, error) {
+	now := l.now()
+	day := now.UTC().Truncate(24 * time.Hour)
+
+	if tier.DailyQuota > 0 {
+		if err := l.loadUsage(ctx, client, day); err != nil {
+			return Decision{}, err
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	d := Decision{Limit: tier.Burst, QuotaLimit: tier.DailyQuota}
+
+	u := l.usage[client]
+	if u == nil || !u.day.Equal(day) {
+		if u != nil && u.pending > 0 {
+			l.stale = append(l.stale, staleUsage{client: client, day: u.day, count: u.pending})
+		}
+		u = &usage{day: day}
+		l.usage[client] = u
+	}
+	if tier.DailyQuota > 0 && u.used >= tier.DailyQuota {
+		d.QuotaExceeded = true
+		d.RetryAfter = day.Add(24 * time.Hour).Sub(now)
+		d.Reset = d.RetryAfter
+		return d, nil
+	}
+
+	b := l.buckets[client]
+	if b == nil {
+		b = &bucket{tokens: float64(tier.Burst), last: now}
+		l.buckets[client] = b
+	}
+	b.tokens = math.Min(float64(tier.Burst), b.tokens+now.Sub(b.last).Seconds()*tier.Rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		d.RetryAfter = seconds((1 - b.tokens) / tier.Rate)
+		d.Reset = seconds((float64(tier.Burst) - b.tokens) / tier.Rate)
+		return d, nil
+	}
+
+	b.tokens--
+	u.used++
+	u.pending++
+
+	d.Allowed = true
+	d.Remaining = int(b.tokens)
+	d.Reset = seconds((float64(tier.Burst) - b.tokens) / tier.Rate)
+	if tier.DailyQuota > 0 {
+		d.QuotaRemaining = tier.DailyQuota - u.used
+	}
+	return d, nil
+}
+
+// Run flushes daily usage to the repository every interval and drops idle
+// buckets, until ctx is cancelled.
+func (l *Limiter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			l.flush(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+			l.flush(ctx)
+			l.dropIdle()
+		}
+	}
+}
+
+func (l *Limiter) loadUsage(ctx context.Context, client string, day time.Time) error {
+	l.mu.Lock()
+	u := l.usage[client]
+	loaded := u != nil && u.day.Equal(day)
+	l.mu.Unlock()
+	if loaded {
+		return nil
+	}
+
+	used, err := l.repo.GetDailyUsage(ctx, client, day)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if u := l.usage[client]; u == nil || !u.day.Equal(day) {
+		if u != nil && u.pending > 0 {
+			l.stale = append(l.stale, staleUsage{client: client, day: u.day, count: u.pending})
+		}
+		l.usage[client] = &usage{day: day, used: used}
+	}
+	return nil
+}
+
+func (l *Limiter) flush(ctx context.Context) {
+	l.mu.Lock()
+	pending := make(map[time.Time]map[string]int64)
+	add := func(client string, day time.Time, count int64) {
+		if pending[day] == nil {
+			pending[day] = make(map[string]int64)
+		}
+		pending[day][client] += count
+	}
+	for client, u := range l.usage {
+		if u.pending > 0 {
+			add(client, u.day, u.pending)
+			u.pending = 0
+		}
+	}
+	for _, s := range l.stale {
+		add(s.client, s.day, s.count)
+	}
+	l.stale = nil
+	l.mu.Unlock()
+
+	for day, usage := range pending {
+		if err := l.repo.AddDailyUsage(ctx, day, usage); err != nil {
+			slog.ErrorContext(ctx, "Rate limit error: Failed to store daily usage", "error", err)
+		}
+	}
+}
+
+func (l *Limiter) dropIdle() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	today := now.UTC().Truncate(24 * time.Hour)
+	for client, b := range l.buckets {
+		if now.Sub(b.last) > idleBucketTTL {
+			delete(l.buckets, client)
+		}
+	}
+	for client, u := range l.usage {
+		if u.pending == 0 && !u.day.Equal(today) {
+			delete(l.usage, client)
+		}
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Ceil(s)) * time.Second
+}
@@ -0,0 +1,182 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+)
+
+// memoryQuotaRepository keeps daily usage in memory.
+type memoryQuotaRepository struct {
+	mu    sync.Mutex
+	usage map[time.Time]map[string]int64
+	err   error
+}
+
+func newMemoryQuotaRepository() *memoryQuotaRepository {
+	return &memoryQuotaRepository{usage: make(map[time.Time]map[string]int64)}
+}
+
+func (m *memoryQuotaRepository) GetDailyUsage(_ context.Context, client string, day time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return 0, m.err
+	}
+	return m.usage[day][client], nil
+}
+
+func (m *memoryQuotaRepository) AddDailyUsage(_ context.Context, day time.Time, usage map[string]int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	if m.usage[day] == nil {
+		m.usage[day] = make(map[string]int64)
+	}
+	for client, count := range usage {
+		m.usage[day][client] += count
+	}
+	return nil
+}
+
+// testLimiter returns a limiter with tiers and a clock starting at start,
+// which advance moves forward.
+func testLimiter(tiers map[string]Tier, repo *memoryQuotaRepository, start time.Time) (l *Limiter, advance func(time.Duration)) {
+	now := start
+	l = NewLimiter(tiers, repo)
+	l.now = func() time.Time { return now }
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestLimiterBucket(t *testing.T) {
+	tier := Tier{Name: "test", Rate: 0.5, Burst: 3}
+	l, advance := testLimiter(map[string]Tier{"test": tier}, newMemoryQuotaRepository(), time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	type want struct {
+		allowed    bool
+		remaining  int
+		retryAfter time.Duration
+		reset      time.Duration
+	}
+	steps := []struct {
+		name    string
+		advance time.Duration
+		want    want
+	}{
+		{name: "first request", want: want{allowed: true, remaining: 2, reset: 2 * time.Second}},
+		{name: "second request", want: want{allowed: true, remaining: 1, reset: 4 * time.Second}},
+		{name: "last of the burst", want: want{allowed: true, remaining: 0, reset: 6 * time.Second}},
+		{name: "beyond the burst", want: want{retryAfter: 2 * time.Second, reset: 6 * time.Second}},
+		{name: "half refilled", advance: time.Second, want: want{retryAfter: time.Second, reset: 5 * time.Second}},
+		{name: "one token refilled", advance: time.Second, want: want{allowed: true, remaining: 0, reset: 6 * time.Second}},
+		{name: "refilled beyond the burst", advance: time.Minute, want: want{allowed: true, remaining: 2, reset: 2 * time.Second}},
+	}
+	for _, step := range steps {
+		advance(step.advance)
+		d, err := l.Allow(ctx, "ip:192.0.2.1", tier)
+		if err != nil {
+			t.Fatalf("%s: Allow() error = %v", step.name, err)
+		}
+		got := want{allowed: d.Allowed, remaining: d.Remaining, retryAfter: d.RetryAfter, reset: d.Reset}
+		if got != step.want {
+			t.Errorf("%s: Allow() = %+v, want %+v", step.name, got, step.want)
+		}
+		if d.Limit != tier.Burst || d.QuotaExceeded {
+			t.Errorf("%s: Limit = %d, QuotaExceeded = %t", step.name, d.Limit, d.QuotaExceeded)
+		}
+	}
+
+	if d, _ := l.Allow(ctx, "ip:192.0.2.2", tier); !d.Allowed || d.Remaining != 2 {
+		t.Errorf("another client: Allow() = %+v, want a full bucket", d)
+	}
+}
+
+func TestLimiterQuotaRollsOverAtMidnightUTC(t *testing.T) {
+	tier := Tier{Name: "test", Rate: 100, Burst: 100, DailyQuota: 2}
+	repo := newMemoryQuotaRepository()
+	// 23:59:59 UTC is already the next day in CET; quota days are UTC days.
+	start := time.Date(2024, 3, 3, 0, 59, 59, 0, time.FixedZone("CET", 3600))
+	l, advance := testLimiter(map[string]Tier{"test": tier}, repo, start)
+	ctx := context.Background()
+	day := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+	next := day.Add(24 * time.Hour)
+
+	for i := range 2 {
+		if d, err := l.Allow(ctx, "key:ci", tier); err != nil || !d.Allowed || d.QuotaRemaining != int64(1-i) {
+			t.Fatalf("request %d: Allow() = %+v, %v, want allowed with %d left", i+1, d, err, 1-i)
+		}
+	}
+	d, err := l.Allow(ctx, "key:ci", tier)
+	if err != nil || d.Allowed || !d.QuotaExceeded {
+		t.Fatalf("over quota: Allow() = %+v, %v, want the quota exceeded", d, err)
+	}
+	if d.RetryAfter != time.Second || d.Reset != time.Second {
+		t.Errorf("over quota: RetryAfter = %v, Reset = %v, want 1s until midnight UTC", d.RetryAfter, d.Reset)
+	}
+
+	advance(time.Second)
+	d, err = l.Allow(ctx, "key:ci", tier)
+	if err != nil || !d.Allowed || d.QuotaRemaining != 1 {
+		t.Fatalf("after midnight: Allow() = %+v, %v, want allowed with 1 left", d, err)
+	}
+
+	l.flush(ctx)
+	if got := repo.usage[day]["key:ci"]; got != 2 {
+		t.Errorf("stored usage of %s = %d, want 2", day.Format(time.DateOnly), got)
+	}
+	if got := repo.usage[next]["key:ci"]; got != 1 {
+		t.Errorf("stored usage of %s = %d, want 1", next.Format(time.DateOnly), got)
+	}
+}
+
+func TestLimiterQuotaLoadsStoredUsage(t *testing.T) {
+	tier := Tier{Name: "test", Rate: 100, Burst: 100, DailyQuota: 5}
+	repo := newMemoryQuotaRepository()
+	now := time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC)
+	repo.AddDailyUsage(context.Background(), now.Truncate(24*time.Hour), map[string]int64{"key:ci": 5})
+	l, _ := testLimiter(map[string]Tier{"test": tier}, repo, now)
+
+	d, err := l.Allow(context.Background(), "key:ci", tier)
+	if err != nil || d.Allowed || !d.QuotaExceeded || d.RetryAfter != 12*time.Hour {
+		t.Errorf("Allow() = %+v, %v, want the quota exceeded for 12h", d, err)
+	}
+
+	repo.err = errors.New("conn closed")
+	if _, err := l.Allow(context.Background(), "key:other", tier); err == nil {
+		t.Errorf("Allow() with the quota store down: error = nil")
+	}
+}
+
+func TestLimiterTier(t *testing.T) {
+	tiers, err := ParseTiers("partner=100:200:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := NewLimiter(tiers, newMemoryQuotaRepository())
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"partner", "partner"},
+		{model.TierAnonymous, model.TierAnonymous},
+		{"premium", "premium"},
+		{"unknown", model.TierStandard},
+		{"", model.TierStandard},
+	}
+	for _, tt := range tests {
+		if got := l.Tier(tt.name); got.Name != tt.want {
+			t.Errorf("Tier(%q) = %q, want %q", tt.name, got.Name, tt.want)
+		}
+	}
+	if got := l.Tier("unknown"); got != DefaultTiers[model.TierStandard] {
+		t.Errorf("Tier(%q) = %+v, want the default standard tier", "unknown", got)
+	}
+}
@@ -0,0 +1,172 @@
+package render
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// csvEncoder writes a header row with a column per JSON field of the model,
+// in the order the fields are declared, then a row per value. Nested values
+// are written as JSON.
+type csvEncoder struct {
+	w    *csv.Writer
+	cols []column
+}
+
+func newCSVEncoder(w io.Writer) *csvEncoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+// column is a CSV column. index is the path to its struct field, nil for a
+// column holding the whole value.
+type column struct {
+	name  string
+	index []int
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// columns lists the columns of t, which are the JSON fields of structs,
+// embedded structs flattened, and a single value column for anything else.
+func columns(t reflect.Type) []column {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.Implements(textMarshalerType) {
+		return []column{{name: "value"}}
+	}
+	return structColumns(t, nil)
+}
+
+func structColumns(t reflect.Type, parent []int) []column {
+	var cols []column
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		index := append(append([]int{}, parent...), i)
+		// Like encoding/json, the fields of embedded structs are promoted
+		// even when the struct type itself is unexported.
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			cols = append(cols, structColumns(f.Type, index)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		cols = append(cols, column{name: name, index: index})
+	}
+	return cols
+}
+
+func (e *csvEncoder) begin(elem reflect.Type) error {
+	e.cols = columns(elem)
+	header := make([]string, len(e.cols))
+	for i, col := range e.cols {
+		header[i] = col.name
+	}
+	return e.w.Write(header)
+}
+
+func (e *csvEncoder) item(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	row := make([]string, len(e.cols))
+	for i, col := range e.cols {
+		field := rv
+		if col.index != nil {
+			var err error
+			if field, err = rv.FieldByIndexErr(col.index); err != nil {
+				continue
+			}
+		}
+		cell, err := csvCell(field)
+		if err != nil {
+			return err
+		}
+		row[i] = cell
+	}
+	return e.w.Write(row)
+}
+
+func (e *csvEncoder) end() error {
+	return e.flush()
+}
+
+func (e *csvEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// value writes a single value as a list of one.
+func (e *csvEncoder) value(v any) error {
+	if err := e.begin(reflect.TypeOf(v)); err != nil {
+		return err
+	}
+	if err := e.item(v); err != nil {
+		return err
+	}
+	return e.end()
+}
+
+// formulaPrefixes start cells that spreadsheets evaluate as formulas.
+const formulaPrefixes = "=+-@\t\r"
+
+// escapeFormula prefixes text that a spreadsheet would evaluate as a formula
+// with ', so that opening an export never runs what a record holds.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune(formulaPrefixes, rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// csvCell formats v as a cell. Text is escaped with escapeFormula; numbers,
+// booleans and JSON are not, since spreadsheets read them as what they are.
+func csvCell(v reflect.Value) (string, error) {
+	if !v.IsValid() {
+		return "", nil
+	}
+	if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+		return "", nil
+	}
+	if v.Type() == rawJSONType {
+		return string(v.Bytes()), nil
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return escapeFormula(string(b)), err
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return csvCell(v.Elem())
+	case reflect.String:
+		return escapeFormula(v.String()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		b, err := json.Marshal(v.Interface())
+		return string(b), err
+	default:
+		return escapeFormula(fmt.Sprint(v.Interface())), nil
+	}
+}
@@ -0,0 +1,84 @@
+package render
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type csvBase struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type csvRecord struct {
+	csvBase
+	Number    *int            `json:"number,omitempty"`
+	Points    float64         `json:"points"`
+	Current   bool            `json:"current"`
+	Tags      []string        `json:"tags"`
+	State     json.RawMessage `json:"state"`
+	UpdatedAt time.Time       `json:"updated_at"`
+	DeletedAt *time.Time      `json:"deleted_at"`
+	Untagged  string
+	Secret    string `json:"-"`
+	internal  string
+}
+
+func TestCSV(t *testing.T) {
+	number := 44
+	updated := time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC)
+	record := csvRecord{
+		csvBase:   csvBase{ID: "1", Name: "Lewis, \"Sir\" Hamilton"},
+		Number:    &number,
+		Points:    -2.5,
+		Current:   true,
+		Tags:      []string{"champion"},
+		State:     json.RawMessage(`{"a":1}`),
+		UpdatedAt: updated,
+		Untagged:  "x",
+		Secret:    "hidden",
+		internal:  "hidden",
+	}
+	header := "id,name,number,points,current,tags,state,updated_at,deleted_at,Untagged\n"
+	row := `1,"Lewis, ""Sir"" Hamilton",44,-2.5,true,"[""champion""]","{""a"":1}",2024-03-02T12:00:00Z,,x` + "\n"
+
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{name: "list", v: []csvRecord{record, {csvBase: csvBase{ID: "2"}}}, want: header + row + "2,,,0,false,null,,0001-01-01T00:00:00Z,,\n"},
+		{name: "list of pointers", v: []*csvRecord{&record}, want: header + row},
+		{name: "empty list", v: []csvRecord{}, want: header},
+		{name: "single value", v: record, want: header + row},
+		{name: "scalars", v: []int{1, -2}, want: "value\n1\n-2\n"},
+		{
+			name: "formulas",
+			v: []csvBase{
+				{ID: "=1+1", Name: "+44"},
+				{ID: "-2", Name: "@SUM(A1)"},
+				{ID: "\tx", Name: "\rx"},
+				{ID: "a=b", Name: "Hamilton"},
+			},
+			want: "id,name\n'=1+1,'+44\n'-2,'@SUM(A1)\n'\tx,\"'\rx\"\na=b,Hamilton\n",
+		},
+		{name: "formula in a scalar", v: []string{"=cmd|' /C calc'!A0"}, want: "value\n'=cmd|' /C calc'!A0\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			if err := Write(rec, CSV, http.StatusOK, tt.v); err != nil {
+				t.Fatal(err)
+			}
+			if got := rec.Body.String(); got != tt.want {
+				t.Errorf("Write() =\n%q\nwant\n%q", got, tt.want)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "text/csv; charset=utf-8" {
+				t.Errorf("Content-Type = %q", ct)
+			}
+		})
+	}
+}
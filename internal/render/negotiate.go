@@ -0,0 +1,125 @@
+// Package render writes API responses in the format the client negotiates:
+// JSON, CSV, XML, YAML or NDJSON.
+package render
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Format is a response format, named as in the format query parameter.
+type Format string
+
+const (
+	JSON Format = "json"
+	CSV  Format = "csv"
+	XML  Format = "xml"
+	YAML Format = "yaml"
+	// NDJSON is newline-delimited JSON, one value per line.
+	NDJSON Format = "ndjson"
+)
+
+// Formats lists the supported formats, by preference when the client accepts
+// any of them.
+var Formats = []Format{JSON, CSV, XML, YAML, NDJSON}
+
+// ErrNotAcceptable is returned when the client accepts none of the formats.
+var ErrNotAcceptable = errors.New("render: none of json, csv, xml, yaml and ndjson is acceptable")
+
+var mediaTypes = map[Format][]string{
+	JSON:   {"application/json"},
+	CSV:    {"text/csv"},
+	XML:    {"application/xml", "text/xml"},
+	YAML:   {"application/yaml", "application/x-yaml", "text/yaml"},
+	NDJSON: {"application/x-ndjson", "application/ndjson"},
+}
+
+// ContentType is the Content-Type of responses in f.
+func (f Format) ContentType() string {
+	switch f {
+	case CSV, XML:
+		return mediaTypes[f][0] + "; charset=utf-8"
+	default:
+		return mediaTypes[f][0]
+	}
+}
+
+// Negotiate picks the format of the response to r. The format query
+// parameter wins over the Accept header, and JSON is the default.
+func Negotiate(r *http.Request) (Format, error) {
+	if name := r.URL.Query().Get("format"); name != "" {
+		f := Format(strings.ToLower(name))
+		if _, ok := mediaTypes[f]; !ok {
+			return "", ErrNotAcceptable
+		}
+		return f, nil
+	}
+
+	accept := r.Header.Get("Accept")
+	if strings.TrimSpace(accept) == "" {
+		return JSON, nil
+	}
+	ranges := parseAccept(accept)
+	best, bestQ := Format(""), 0.0
+	for _, f := range Formats {
+		if q := quality(ranges, f); q > bestQ {
+			best, bestQ = f, q
+		}
+	}
+	if best == "" {
+		return "", ErrNotAcceptable
+	}
+	return best, nil
+}
+
+type mediaRange struct {
+	mediaType string
+	q         float64
+}
+
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		ranges = append(ranges, mediaRange{mediaType: mediaType, q: q})
+	}
+	return ranges
+}
+
+// quality is the q-value the ranges give to f, taken from the most specific
+// range that matches one of its media types.
+func quality(ranges []mediaRange, f Format) float64 {
+	q, specificity := 0.0, -1
+	for _, mr := range ranges {
+		for _, mediaType := range mediaTypes[f] {
+			s := -1
+			switch {
+			case mr.mediaType == mediaType:
+				s = 2
+			case mr.mediaType == "*/*":
+				s = 0
+			case strings.HasSuffix(mr.mediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(mr.mediaType, "*")):
+				s = 1
+			}
+			if s < 0 {
+				continue
+			}
+			if s > specificity || (s == specificity && mr.q > q) {
+				q, specificity = mr.q, s
+			}
+		}
+	}
+	return q
+}
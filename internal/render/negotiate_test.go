@@ -0,0 +1,60 @@
+package render
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		accept  string
+		want    Format
+		wantErr error
+	}{
+		{name: "no Accept", want: JSON},
+		{name: "blank Accept", accept: "  ", want: JSON},
+		{name: "JSON", accept: "application/json", want: JSON},
+		{name: "CSV", accept: "text/csv", want: CSV},
+		{name: "XML alias", accept: "text/xml", want: XML},
+		{name: "YAML alias", accept: "application/x-yaml", want: YAML},
+		{name: "NDJSON", accept: "application/x-ndjson", want: NDJSON},
+		{name: "any", accept: "*/*", want: JSON},
+		{name: "any text prefers CSV", accept: "text/*", want: CSV},
+		{name: "highest q wins", accept: "application/xml;q=0.5, application/yaml", want: YAML},
+		{name: "q-values out of order", accept: "text/csv;q=0.2, application/json;q=0.9", want: JSON},
+		{name: "q=0 refuses a format", accept: "application/json;q=0, */*", want: CSV},
+		{name: "specific range wins over wildcard", accept: "application/*;q=0.9, application/json;q=0.1", want: XML},
+		{name: "specific range wins over any", accept: "*/*;q=1, text/csv;q=0.1, application/json;q=0.05", want: XML},
+		{name: "malformed ranges are skipped", accept: "garbage;;, text/csv", want: CSV},
+		{name: "nothing acceptable", accept: "image/png", wantErr: ErrNotAcceptable},
+		{name: "every format refused", accept: "*/*;q=0", wantErr: ErrNotAcceptable},
+		{name: "format wins over Accept", format: "yaml", accept: "text/csv", want: YAML},
+		{name: "format is case insensitive", format: "XML", want: XML},
+		{name: "format wins over an unacceptable Accept", format: "csv", accept: "image/png", want: CSV},
+		{name: "unknown format", format: "pdf", accept: "application/json", wantErr: ErrNotAcceptable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := "/v1/drivers"
+			if tt.format != "" {
+				target += "?format=" + tt.format
+			}
+			r := httptest.NewRequest(http.MethodGet, target, nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+
+			got, err := Negotiate(r)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Negotiate() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Negotiate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
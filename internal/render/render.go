@@ -0,0 +1,119 @@
+package render
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+)
+
+// encoder writes values in one format. A list is written with begin, one
+// item call per element and end, so that it never has to be held encoded in
+// memory as a whole.
+type encoder interface {
+	begin(elem reflect.Type) error
+	item(v any) error
+	end() error
+	// value writes a single value that is not a list.
+	value(v any) error
+	// flush writes out what the encoder buffers.
+	flush() error
+}
+
+func (f Format) encoder(w io.Writer) encoder {
+	switch f {
+	case CSV:
+		return newCSVEncoder(w)
+	case XML:
+		return newXMLEncoder(w)
+	case YAML:
+		return newYAMLEncoder(w)
+	case NDJSON:
+		return &ndjsonEncoder{w: w}
+	default:
+		return &jsonEncoder{w: w}
+	}
+}
+
+// Write writes v with status in format f. Slices are written element by
+// element, so large lists stream to the client as they are encoded.
+func Write(w http.ResponseWriter, f Format, status int, v any) error {
+	w.Header().Set("Content-Type", f.ContentType())
+	w.WriteHeader(status)
+
+	enc := f.encoder(w)
+	rv := reflect.ValueOf(v)
+	if !isList(rv) {
+		return enc.value(v)
+	}
+	if err := enc.begin(rv.Type().Elem()); err != nil {
+		return err
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := enc.item(rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return enc.end()
+}
+
+var rawJSONType = reflect.TypeOf(json.RawMessage{})
+
+// isList reports whether v is written as a list: a slice, other than bytes.
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice && v.Type() != rawJSONType && v.Type().Elem().Kind() != reflect.Uint8
+}
+
+type jsonEncoder struct {
+	w io.Writer
+	n int
+}
+
+func (e *jsonEncoder) begin(reflect.Type) error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonEncoder) item(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if e.n > 0 {
+		b = append([]byte{','}, b...)
+	}
+	e.n++
+	_, err = e.w.Write(b)
+	return err
+}
+
+func (e *jsonEncoder) end() error {
+	_, err := io.WriteString(e.w, "]\n")
+	return err
+}
+
+func (e *jsonEncoder) value(v any) error {
+	return json.NewEncoder(e.w).Encode(v)
+}
+
+func (e *jsonEncoder) flush() error { return nil }
+
+// ndjsonEncoder writes a value per line. A list is just its lines, with
+// nothing around them.
+type ndjsonEncoder struct {
+	w io.Writer
+}
+
+func (e *ndjsonEncoder) begin(reflect.Type) error { return nil }
+
+func (e *ndjsonEncoder) item(v any) error {
+	return json.NewEncoder(e.w).Encode(v)
+}
+
+func (e *ndjsonEncoder) end() error { return nil }
+
+func (e *ndjsonEncoder) value(v any) error {
+	return json.NewEncoder(e.w).Encode(v)
+}
+
+func (e *ndjsonEncoder) flush() error { return nil }
@@ -0,0 +1,81 @@
+package render
+
+import (
+	"iter"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// StreamErrorTrailer is the trailer that reports a failure after a streamed
+// list has started, when the status can no longer say so.
+const StreamErrorTrailer = "X-Stream-Error"
+
+// flushEvery is the number of values after which a stream is flushed to the
+// client.
+const flushEvery = 64
+
+// StreamWriteTimeout is the time a stream has to write the values up to its
+// next flush. Each flush pushes the write deadline of the connection back by
+// it, so that a stream is not cut off by the server's write timeout while it
+// keeps making progress. 0 leaves the deadline alone.
+var StreamWriteTimeout time.Duration
+
+// Stream writes the values of seq as a list in format f while they are read,
+// so memory use does not grow with the list, and stops reading as soon as a
+// write fails because the client went away.
+//
+// The 200 status is sent with the first value, or with the end of an empty
+// list. If seq fails before that, started is false, nothing has been
+// written and the caller answers with an error status. A later failure
+// leaves the list unterminated, so that it cannot pass for a complete one,
+// and is reported in the StreamErrorTrailer trailer.
+func Stream[T any](w http.ResponseWriter, f Format, seq iter.Seq2[T, error]) (started bool, err error) {
+	rc := http.NewResponseController(w)
+	flush := func() {
+		if StreamWriteTimeout > 0 {
+			rc.SetWriteDeadline(time.Now().Add(StreamWriteTimeout))
+		}
+		rc.Flush()
+	}
+	enc := f.encoder(w)
+	start := func() error {
+		started = true
+		w.Header().Set("Content-Type", f.ContentType())
+		w.WriteHeader(http.StatusOK)
+		// Sending the header at once makes the response chunked, which
+		// the trailer needs.
+		flush()
+		return enc.begin(reflect.TypeFor[T]())
+	}
+
+	n := 0
+	for v, err := range seq {
+		if err == nil && !started {
+			err = start()
+		}
+		if err == nil {
+			err = enc.item(v)
+		}
+		if err != nil {
+			if started {
+				enc.flush()
+				w.Header().Set(http.TrailerPrefix+StreamErrorTrailer, http.StatusText(http.StatusInternalServerError))
+			}
+			return started, err
+		}
+		if n++; n%flushEvery == 0 {
+			if err := enc.flush(); err != nil {
+				return started, err
+			}
+			flush()
+		}
+	}
+
+	if !started {
+		if err := start(); err != nil {
+			return started, err
+		}
+	}
+	return started, enc.end()
+}
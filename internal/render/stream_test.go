@@ -0,0 +1,97 @@
+package render
+
+import (
+	"errors"
+	"iter"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// deadlineRecorder records the write deadlines a stream sets.
+type deadlineRecorder struct {
+	*httptest.ResponseRecorder
+	deadlines []time.Time
+}
+
+func (r *deadlineRecorder) SetWriteDeadline(deadline time.Time) error {
+	r.deadlines = append(r.deadlines, deadline)
+	return nil
+}
+
+func numbers(n int, err error) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for i := range n {
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(0, err)
+		}
+	}
+}
+
+func TestStreamExtendsWriteDeadline(t *testing.T) {
+	defer func(d time.Duration) { StreamWriteTimeout = d }(StreamWriteTimeout)
+
+	tests := []struct {
+		name          string
+		writeTimeout  time.Duration
+		n             int
+		wantDeadlines int
+	}{
+		// One at the start, then one per flushEvery values.
+		{"empty list", time.Minute, 0, 1},
+		{"short list", time.Minute, flushEvery - 1, 1},
+		{"long list", time.Minute, 3*flushEvery + 1, 4},
+		{"no write timeout", 0, 3 * flushEvery, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			StreamWriteTimeout = tt.writeTimeout
+			w := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
+			before := time.Now()
+			if _, err := Stream(w, NDJSON, numbers(tt.n, nil)); err != nil {
+				t.Fatal(err)
+			}
+			if len(w.deadlines) != tt.wantDeadlines {
+				t.Fatalf("%d write deadlines set, want %d", len(w.deadlines), tt.wantDeadlines)
+			}
+			for _, deadline := range w.deadlines {
+				if deadline.Before(before.Add(tt.writeTimeout)) {
+					t.Errorf("write deadline %v is less than %v away", deadline, tt.writeTimeout)
+				}
+			}
+		})
+	}
+}
+
+func TestStreamFailure(t *testing.T) {
+	failure := errors.New("conn closed")
+	tests := []struct {
+		name        string
+		n           int
+		wantStarted bool
+		wantBody    string
+		wantTrailer bool
+	}{
+		{"before the first value", 0, false, "", false},
+		{"after the first value", 2, true, "[0,1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			started, err := Stream(rec, JSON, numbers(tt.n, failure))
+			if !errors.Is(err, failure) || started != tt.wantStarted {
+				t.Fatalf("Stream() = %t, %v, want %t, %v", started, err, tt.wantStarted, failure)
+			}
+			if rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", rec.Body, tt.wantBody)
+			}
+			if got := rec.Result().Trailer.Get(StreamErrorTrailer) != ""; got != tt.wantTrailer {
+				t.Errorf("%s trailer set = %t, want %t", StreamErrorTrailer, got, tt.wantTrailer)
+			}
+		})
+	}
+}
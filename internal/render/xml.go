@@ -0,0 +1,148 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// xmlEncoder writes values as XML shaped like their JSON: an element per
+// object field, named after it, and an item element per array element.
+// Fields whose names are not XML names are entry elements with a key
+// attribute, and null values are empty elements with nil="true".
+type xmlEncoder struct {
+	w    io.Writer
+	enc  *xml.Encoder
+	name string
+}
+
+func newXMLEncoder(w io.Writer) *xmlEncoder {
+	return &xmlEncoder{w: w, enc: xml.NewEncoder(w)}
+}
+
+// begin opens a list element, whose items are named after their type.
+func (e *xmlEncoder) begin(elem reflect.Type) error {
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return err
+	}
+	e.name = elementName(elem)
+	return e.enc.EncodeToken(xml.StartElement{Name: xml.Name{Local: "list"}})
+}
+
+func (e *xmlEncoder) item(v any) error {
+	return e.encode(e.name, v)
+}
+
+func (e *xmlEncoder) end() error {
+	if err := e.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: "list"}}); err != nil {
+		return err
+	}
+	if err := e.enc.Flush(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(e.w, "\n")
+	return err
+}
+
+func (e *xmlEncoder) value(v any) error {
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return err
+	}
+	if err := e.encode(elementName(reflect.TypeOf(v)), v); err != nil {
+		return err
+	}
+	if err := e.enc.Flush(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(e.w, "\n")
+	return err
+}
+
+func (e *xmlEncoder) flush() error {
+	return e.enc.Flush()
+}
+
+func (e *xmlEncoder) encode(name string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return e.element(dec, name)
+}
+
+// element reads one JSON value from dec and writes it as an element.
+func (e *xmlEncoder) element(dec *json.Decoder, name string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	start := startElement(name)
+
+	switch t := tok.(type) {
+	case json.Delim:
+		if err := e.enc.EncodeToken(start); err != nil {
+			return err
+		}
+		for dec.More() {
+			child := "item"
+			if t == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				child = key.(string)
+			}
+			if err := e.element(dec, child); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil { // The closing delimiter.
+			return err
+		}
+		return e.enc.EncodeToken(start.End())
+	case nil:
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "nil"}, Value: "true"})
+		return e.enc.EncodeElement("", start)
+	case string:
+		return e.enc.EncodeElement(t, start)
+	case json.Number:
+		return e.enc.EncodeElement(t.String(), start)
+	case bool:
+		return e.enc.EncodeElement(strconv.FormatBool(t), start)
+	}
+	return nil
+}
+
+var xmlName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9._-]*$`)
+
+func startElement(name string) xml.StartElement {
+	if xmlName.MatchString(name) && !strings.HasPrefix(strings.ToLower(name), "xml") {
+		return xml.StartElement{Name: xml.Name{Local: name}}
+	}
+	return xml.StartElement{
+		Name: xml.Name{Local: "entry"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: name}},
+	}
+}
+
+// elementName names the element of a value of type t after the type, as in
+// driverStanding for model.DriverStanding.
+func elementName(t reflect.Type) string {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Name() == "" {
+		return "item"
+	}
+	name := []rune(t.Name())
+	name[0] = unicode.ToLower(name[0])
+	return string(name)
+}
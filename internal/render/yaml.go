@@ -0,0 +1,95 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlEncoder writes values as YAML with the same field names and order as
+// their JSON. A list is written as a block sequence, one entry at a time.
+type yamlEncoder struct {
+	w io.Writer
+	n int
+}
+
+func newYAMLEncoder(w io.Writer) *yamlEncoder {
+	return &yamlEncoder{w: w}
+}
+
+func (e *yamlEncoder) begin(reflect.Type) error {
+	return nil
+}
+
+// item writes v as a sequence of one entry. Sequences written one after
+// another read as a single one.
+func (e *yamlEncoder) item(v any) error {
+	node, err := yamlNode(v)
+	if err != nil {
+		return err
+	}
+	e.n++
+	return e.encode(&yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{node}})
+}
+
+func (e *yamlEncoder) end() error {
+	if e.n > 0 {
+		return nil
+	}
+	_, err := io.WriteString(e.w, "[]\n")
+	return err
+}
+
+func (e *yamlEncoder) value(v any) error {
+	node, err := yamlNode(v)
+	if err != nil {
+		return err
+	}
+	return e.encode(node)
+}
+
+func (e *yamlEncoder) flush() error {
+	return nil
+}
+
+func (e *yamlEncoder) encode(node *yaml.Node) error {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	_, err := e.w.Write(buf.Bytes())
+	return err
+}
+
+// yamlNode converts v to a YAML node through its JSON, which YAML reads as
+// is, to keep the JSON field names and order.
+func yamlNode(v any) (*yaml.Node, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	node := doc.Content[0]
+	blockStyle(node)
+	return node, nil
+}
+
+// blockStyle drops the flow style and quoting the JSON was read with, so
+// that the encoder picks the usual block style.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key model.APIKey) (model.APIKey, error)
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (model.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+}
+
+type apiKeyRepository struct{}
+
+func NewAPIKeyRepository() APIKeyRepository {
+	return &apiKeyRepository{}
+}
+
+func (r *apiKeyRepository) CreateAPIKey(ctx context.Context, key model.APIKey) (model.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.CreateAPIKey")
+	defer span.End()
+
+	query := `
+		INSERT INTO api_keys (id, name, prefix, key_hash, scopes, tier)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, name, prefix, key_hash, scopes, tier, created_at, last_used_at, revoked_at
+	`
+	return scanAPIKey(db.Executor(ctx).QueryRow(ctx, query, key.ID, key.Name, key.Prefix, key.KeyHash, scopeStrings(key.Scopes), key.Tier))
+}
+
+func (r *apiKeyRepository) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (model.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.GetActiveAPIKeyByHash")
+	defer span.End()
+
+	query := `
+		SELECT id, name, prefix, key_hash, scopes, tier, created_at, last_used_at, revoked_at
+		FROM api_keys
+		WHERE key_hash = $1 AND revoked_at IS NULL
+	`
+	key, err := scanAPIKey(db.Executor(ctx).QueryRow(ctx, query, keyHash))
+	if err == pgx.ErrNoRows {
+		return model.APIKey{}, ErrNotFound
+	}
+	return key, err
+}
+
+func (r *apiKeyRepository) ListAPIKeys(ctx context.Context) ([]model.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.ListAPIKeys")
+	defer span.End()
+
+	query := `
+		SELECT id, name, prefix, key_hash, scopes, tier, created_at, last_used_at, revoked_at
+		FROM api_keys
+		ORDER BY created_at
+	`
+	rows, err := db.Executor(ctx).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []model.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (r *apiKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.RevokeAPIKey")
+	defer span.End()
+
+	query := `UPDATE api_keys SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *apiKeyRepository) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.TouchAPIKey")
+	defer span.End()
+
+	query := `UPDATE api_keys SET last_used_at = now() WHERE id = $1`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
+	return err
+}
+
+func scanAPIKey(row pgx.Row) (model.APIKey, error) {
+	var key model.APIKey
+	var scopes []string
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&scopes,
+		&key.Tier,
+		&key.CreatedAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	)
+	if err != nil {
+		return model.APIKey{}, err
+	}
+	for _, s := range scopes {
+		key.Scopes = append(key.Scopes, model.Scope(s))
+	}
+	return key, nil
+}
+
+func scopeStrings(scopes []model.Scope) []string {
+	s := make([]string, len(scopes))
+	for i, scope := range scopes {
+		s[i] = string(scope)
+	}
+	return s
+}
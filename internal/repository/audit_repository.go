@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/internal/utils"
+	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var ErrVersionConflict = errors.New("version conflicts with existing data")
+
+// integrityConstraintViolation is the SQLSTATE class of unique, foreign key
+// and check constraint violations.
+const integrityConstraintViolation = "23"
+
+// auditedTables are the tables with an audit trigger.
+var auditedTables = map[string]bool{
+	"constructors":          true,
+	"drivers":               true,
+	"circuits":              true,
+	"seasons":               true,
+	"races":                 true,
+	"results":               true,
+	"driver_standings":      true,
+	"constructor_standings": true,
+}
+
+type AuditRepository interface {
+	GetHistory(ctx context.Context, entityType string, entityID uuid.UUID, page, limit int) ([]model.AuditEntry, error)
+	GetAuditEntry(ctx context.Context, entityType string, entityID uuid.UUID, entryID int64) (model.AuditEntry, error)
+	// RestoreVersion writes state, a row snapshot taken from the audit log,
+	// back to its table, recreating the row if it was purged.
+	RestoreVersion(ctx context.Context, entityType string, state json.RawMessage) error
+}
+
+type auditRepository struct{}
+
+func NewAuditRepository() AuditRepository {
+	return &auditRepository{}
+}
+
+func (r *auditRepository) GetHistory(ctx context.Context, entityType string, entityID uuid.UUID, page, limit int) ([]model.AuditEntry, error) {
+	ctx, span := tracing.Start(ctx, "AuditRepository.GetHistory")
+	defer span.End()
+
+	query, err := utils.Paginate(`
+		SELECT id, entity_type, entity_id, action, actor, request_id, before, after, changes, created_at
+		FROM audit_log
+		WHERE entity_type = $1 AND entity_id = $2
+		ORDER BY id DESC
+	`, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Executor(ctx).Query(ctx, query, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []model.AuditEntry
+	for rows.Next() {
+		var entry model.AuditEntry
+		if err := scanAuditEntry(rows, &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func (r *auditRepository) GetAuditEntry(ctx context.Context, entityType string, entityID uuid.UUID, entryID int64) (model.AuditEntry, error) {
+	ctx, span := tracing.Start(ctx, "AuditRepository.GetAuditEntry")
+	defer span.End()
+
+	query := `
+		SELECT id, entity_type, entity_id, action, actor, request_id, before, after, changes, created_at
+		FROM audit_log
+		WHERE entity_type = $1 AND entity_id = $2 AND id = $3
+	`
+	var entry model.AuditEntry
+	err := scanAuditEntry(db.Executor(ctx).QueryRow(ctx, query, entityType, entityID, entryID), &entry)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.AuditEntry{}, ErrNotFound
+	}
+	return entry, err
+}
+
+func (r *auditRepository) RestoreVersion(ctx context.Context, entityType string, state json.RawMessage) error {
+	ctx, span := tracing.Start(ctx, "AuditRepository.RestoreVersion")
+	defer span.End()
+
+	if !auditedTables[entityType] {
+		return fmt.Errorf("%s is not audited", entityType)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(state, &fields); err != nil {
+		return err
+	}
+	var columns, excluded []string
+	for name := range fields {
+		if name != "id" {
+			columns = append(columns, name)
+		}
+	}
+	sort.Strings(columns)
+	for i, name := range columns {
+		columns[i] = pgx.Identifier{name}.Sanitize()
+		excluded = append(excluded, "EXCLUDED."+columns[i])
+	}
+
+	table := pgx.Identifier{entityType}.Sanitize()
+	query := fmt.Sprintf(`
+		INSERT INTO %s
+		SELECT * FROM jsonb_populate_record(NULL::%s, $1::jsonb)
+		ON CONFLICT (id) DO UPDATE SET (%s) = ROW(%s)
+	`, table, table, strings.Join(columns, ", "), strings.Join(excluded, ", "))
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.Executor(ctx).Exec(ctx, `SELECT set_config('f1.audit_action', $1, true)`, string(model.AuditRevert)); err != nil {
+			return err
+		}
+		if _, err := db.Executor(ctx).Exec(ctx, query, string(state)); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && strings.HasPrefix(pgErr.Code, integrityConstraintViolation) {
+				return ErrVersionConflict
+			}
+			return err
+		}
+		_, err := db.Executor(ctx).Exec(ctx, `SELECT set_config('f1.audit_action', '', true)`)
+		return err
+	})
+}
+
+func scanAuditEntry(row pgx.Row, entry *model.AuditEntry) error {
+	var before, after, changes []byte
+	err := row.Scan(
+		&entry.ID,
+		&entry.EntityType,
+		&entry.EntityID,
+		&entry.Action,
+		&entry.Actor,
+		&entry.RequestID,
+		&before,
+		&after,
+		&changes,
+		&entry.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	entry.Before = jsonOrNull(before)
+	entry.After = jsonOrNull(after)
+	if changes != nil {
+		entry.Changes = changes
+	}
+	return nil
+}
+
+func jsonOrNull(b []byte) json.RawMessage {
+	if b == nil {
+		return json.RawMessage("null")
+	}
+	return b
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	notNullViolation = "23502"
+	uniqueViolation  = "23505"
+	checkViolation   = "23514"
+)
+
+// ErrDeletedConflict is the error of an item whose natural key is held by a
+// soft-deleted row. Upserts leave such rows deleted: restoring them needs
+// the admin scope, which the bulk endpoints do not.
+var ErrDeletedConflict = errors.New("conflicts with a deleted record")
+
+// upsertFunc writes a single item and reports whether it was inserted (true)
+// or updated an existing row (false). Its statement only updates rows that
+// are not soft-deleted, so that it returns no row on conflict with one.
+type upsertFunc[T any] func(ctx context.Context, tx pgx.Tx, item T) (T, bool, error)
+
+// bulkUpsert runs upsert for every item inside one transaction. Each item gets
+// its own savepoint so a failure only discards that item. In atomic mode the
+// first failure stops processing and the whole transaction is rolled back.
+func bulkUpsert[T any](ctx context.Context, items []T, mode model.BulkMode, upsert upsertFunc[T]) (model.BulkResult, error) {
+	result := model.BulkResult{
+		Mode:  mode,
+		Items: make([]model.BulkItemResult, len(items)),
+	}
+
+	tx, err := db.Executor(ctx).Begin(ctx)
+	if err != nil {
+		return model.BulkResult{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	for i, item := range items {
+		result.Items[i].Index = i
+		if mode == model.BulkModeAtomic && result.Failed > 0 {
+			result.Items[i].Status = model.BulkItemSkipped
+			continue
+		}
+
+		saved, inserted, err := upsertInSavepoint(ctx, tx, item, upsert)
+		if err != nil {
+			result.Failed++
+			result.Items[i].Status = model.BulkItemFailed
+			result.Items[i].Error = bulkItemError(ctx, i, err)
+			continue
+		}
+
+		result.Items[i].Data = saved
+		if inserted {
+			result.Created++
+			result.Items[i].Status = model.BulkItemCreated
+		} else {
+			result.Updated++
+			result.Items[i].Status = model.BulkItemUpdated
+		}
+	}
+
+	if mode == model.BulkModeAtomic && result.Failed > 0 {
+		for i := range result.Items {
+			if result.Items[i].Status == model.BulkItemCreated || result.Items[i].Status == model.BulkItemUpdated {
+				result.Items[i].Status = model.BulkItemRolledBack
+				result.Items[i].Data = nil
+			}
+		}
+		result.Created = 0
+		result.Updated = 0
+		return result, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.BulkResult{}, err
+	}
+	return result, nil
+}
+
+func upsertInSavepoint[T any](ctx context.Context, tx pgx.Tx, item T, upsert upsertFunc[T]) (T, bool, error) {
+	var zero T
+
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return zero, false, err
+	}
+	saved, inserted, err := upsert(ctx, sp, item)
+	if err != nil {
+		sp.Rollback(ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = ErrDeletedConflict
+		}
+		return zero, false, err
+	}
+	if err := sp.Commit(ctx); err != nil {
+		return zero, false, err
+	}
+	return saved, inserted, nil
+}
+
+// bulkItemError returns the message reported for an item that failed with
+// err. Database errors are reported by kind, so that the result does not
+// expose their text; errors of an unknown kind are logged.
+func bulkItemError(ctx context.Context, index int, err error) string {
+	for _, known := range []error{ErrConstructorNotFound, ErrDeletedConflict} {
+		if errors.Is(err, known) {
+			return known.Error()
+		}
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return "conflicts with an existing record"
+		case foreignKeyViolation:
+			return "references a record that does not exist"
+		case notNullViolation:
+			return "is missing a required field"
+		case checkViolation:
+			return "has a value out of range"
+		}
+	}
+	slog.ErrorContext(ctx, "BulkUpsert error: Failed to upsert item", "index", index, "error", err)
+	return "failed to save item"
+}
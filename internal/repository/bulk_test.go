@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestBulkItemError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"unknown constructor", fmt.Errorf("resolve constructor: %w", ErrConstructorNotFound), "constructor not found"},
+		{"deleted record", ErrDeletedConflict, "conflicts with a deleted record"},
+		{"unique violation", &pgconn.PgError{Code: uniqueViolation, Message: `duplicate key value violates unique constraint "drivers_ref_key"`}, "conflicts with an existing record"},
+		{"foreign key violation", fmt.Errorf("insert: %w", &pgconn.PgError{Code: foreignKeyViolation, Detail: "Key (race_id)=(...) is not present"}), "references a record that does not exist"},
+		{"not null violation", &pgconn.PgError{Code: notNullViolation}, "is missing a required field"},
+		{"check violation", &pgconn.PgError{Code: checkViolation}, "has a value out of range"},
+		{"other database error", &pgconn.PgError{Code: "42P01", Message: `relation "drivers" does not exist`}, "failed to save item"},
+		{"other error", errors.New("conn closed"), "failed to save item"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bulkItemError(context.Background(), 0, tt.err); got != tt.want {
+				t.Errorf("bulkItemError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
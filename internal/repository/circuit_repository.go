@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"iter"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/ChinmayNoob/f1/internal/utils"
 	"github.com/ChinmayNoob/f1/pkg/db"
 	"github.com/google/uuid"
@@ -13,15 +15,21 @@ import (
 type CircuitRepository interface {
 	CreateCircuit(ctx context.Context, circuit model.Circuit) (model.Circuit, error)
 	GetAllCircuits(ctx context.Context, page, limit int) ([]model.Circuit, error)
+	StreamCircuits(ctx context.Context, filter model.CircuitFilter) iter.Seq2[model.Circuit, error]
 	GetCircuitByID(ctx context.Context, id uuid.UUID) (model.Circuit, error)
+	GetCircuitsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Circuit, error)
 	GetCircuitByRef(ctx context.Context, ref string) (model.Circuit, error)
 	GetCircuitByName(ctx context.Context, name string, page, limit int) ([]model.Circuit, error)
 	GetCircuitByLocation(ctx context.Context, location string, page, limit int) ([]model.Circuit, error)
 	GetCircuitByCountry(ctx context.Context, country string, page, limit int) ([]model.Circuit, error)
 	GetCircuitByCurrent(ctx context.Context, current bool) ([]model.Circuit, error)
 	GetCircuitByURL(ctx context.Context, url string) (model.Circuit, error)
+	ResolveCircuitRef(ctx context.Context, ref string) (uuid.UUID, error)
 	UpdateCircuit(ctx context.Context, id uuid.UUID, circuit model.Circuit) (model.Circuit, error)
 	DeleteCircuit(ctx context.Context, id uuid.UUID) error
+	RestoreCircuit(ctx context.Context, id uuid.UUID) error
+	PurgeCircuit(ctx context.Context, id uuid.UUID) error
+	BulkUpsertCircuits(ctx context.Context, circuits []model.Circuit, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type circuitRepository struct{}
@@ -31,14 +39,17 @@ func NewCircuitRepository() CircuitRepository {
 }
 
 func (r *circuitRepository) CreateCircuit(ctx context.Context, circuit model.Circuit) (model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitRepository.CreateCircuit")
+	defer span.End()
+
 	query := `
 		INSERT INTO circuits (id, ref, name, location, country, "current", url)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING *
+		RETURNING id, ref, name, location, country, "current", url, deleted_at
 	`
 
 	var createdCircuit model.Circuit
-	err := db.Conn.QueryRow(ctx, query, circuit.ID, circuit.Ref, circuit.Name, circuit.Location, circuit.Country, circuit.Current, circuit.URL).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, circuit.ID, circuit.Ref, circuit.Name, circuit.Location, circuit.Country, circuit.Current, circuit.URL).Scan(
 		&createdCircuit.ID,
 		&createdCircuit.Ref,
 		&createdCircuit.Name,
@@ -46,6 +57,7 @@ func (r *circuitRepository) CreateCircuit(ctx context.Context, circuit model.Cir
 		&createdCircuit.Country,
 		&createdCircuit.Current,
 		&createdCircuit.URL,
+		&createdCircuit.DeletedAt,
 	)
 	if err != nil {
 		return model.Circuit{}, err
@@ -54,7 +66,10 @@ func (r *circuitRepository) CreateCircuit(ctx context.Context, circuit model.Cir
 }
 
 func (r *circuitRepository) GetAllCircuits(ctx context.Context, page, limit int) ([]model.Circuit, error) {
-	query := `SELECT * FROM circuits`
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetAllCircuits")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE ` + notDeleted(ctx, "deleted_at")
 
 	paginationQuery, err := utils.Paginate(query, page, limit)
 
@@ -62,7 +77,7 @@ func (r *circuitRepository) GetAllCircuits(ctx context.Context, page, limit int)
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +94,7 @@ func (r *circuitRepository) GetAllCircuits(ctx context.Context, page, limit int)
 			&circuit.Country,
 			&circuit.Current,
 			&circuit.URL,
+			&circuit.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -88,10 +104,30 @@ func (r *circuitRepository) GetAllCircuits(ctx context.Context, page, limit int)
 	return circuits, nil
 }
 
+// StreamCircuits yields every circuit matching filter, unpaginated, as it is
+// read from the database.
+func (r *circuitRepository) StreamCircuits(ctx context.Context, filter model.CircuitFilter) iter.Seq2[model.Circuit, error] {
+	return tracing.Seq2(ctx, "CircuitRepository.StreamCircuits", func(ctx context.Context) iter.Seq2[model.Circuit, error] {
+		var cond conditions
+		cond.equal("name", filter.Name)
+		cond.equal("location", filter.Location)
+		cond.equal("country", filter.Country)
+		cond.equal(`"current"`, filter.Current)
+		cond.equal("ref", filter.Ref)
+		cond.equal("url", filter.URL)
+
+		query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE ` + cond.sql() + ` AND ` + notDeleted(ctx, "deleted_at")
+		return streamRows(ctx, scanCircuit, query, cond.args...)
+	})
+}
+
 func (r *circuitRepository) GetCircuitByID(ctx context.Context, id uuid.UUID) (model.Circuit, error) {
-	query := `SELECT * FROM circuits WHERE id = $1`
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetCircuitByID")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE id = $1 AND ` + notDeleted(ctx, "deleted_at")
 	var circuit model.Circuit
-	err := db.Conn.QueryRow(ctx, query, id).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, id).Scan(
 		&circuit.ID,
 		&circuit.Ref,
 		&circuit.Name,
@@ -99,6 +135,7 @@ func (r *circuitRepository) GetCircuitByID(ctx context.Context, id uuid.UUID) (m
 		&circuit.Country,
 		&circuit.Current,
 		&circuit.URL,
+		&circuit.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -109,10 +146,23 @@ func (r *circuitRepository) GetCircuitByID(ctx context.Context, id uuid.UUID) (m
 	return circuit, nil
 }
 
+// GetCircuitsByIDs returns the circuits with the given IDs, in no particular
+// order. IDs that match no circuit are left out.
+func (r *circuitRepository) GetCircuitsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetCircuitsByIDs")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE id = ANY($1) AND ` + notDeleted(ctx, "deleted_at")
+	return queryAll(ctx, scanCircuit, query, ids)
+}
+
 func (r *circuitRepository) GetCircuitByRef(ctx context.Context, ref string) (model.Circuit, error) {
-	query := `SELECT * FROM circuits WHERE ref = $1`
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetCircuitByRef")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE ref = $1 AND ` + notDeleted(ctx, "deleted_at")
 	var circuit model.Circuit
-	err := db.Conn.QueryRow(ctx, query, ref).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, ref).Scan(
 		&circuit.ID,
 		&circuit.Ref,
 		&circuit.Name,
@@ -120,6 +170,7 @@ func (r *circuitRepository) GetCircuitByRef(ctx context.Context, ref string) (mo
 		&circuit.Country,
 		&circuit.Current,
 		&circuit.URL,
+		&circuit.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -131,14 +182,17 @@ func (r *circuitRepository) GetCircuitByRef(ctx context.Context, ref string) (mo
 }
 
 func (r *circuitRepository) GetCircuitByName(ctx context.Context, name string, page, limit int) ([]model.Circuit, error) {
-	query := `SELECT * FROM circuits WHERE name = $1`
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetCircuitByName")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE name = $1 AND ` + notDeleted(ctx, "deleted_at")
 
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, name)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, name)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +209,7 @@ func (r *circuitRepository) GetCircuitByName(ctx context.Context, name string, p
 			&circuit.Country,
 			&circuit.Current,
 			&circuit.URL,
+			&circuit.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -165,14 +220,17 @@ func (r *circuitRepository) GetCircuitByName(ctx context.Context, name string, p
 }
 
 func (r *circuitRepository) GetCircuitByLocation(ctx context.Context, location string, page, limit int) ([]model.Circuit, error) {
-	query := `SELECT * FROM circuits WHERE location = $1`
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetCircuitByLocation")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE location = $1 AND ` + notDeleted(ctx, "deleted_at")
 
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, location)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, location)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +247,7 @@ func (r *circuitRepository) GetCircuitByLocation(ctx context.Context, location s
 			&circuit.Country,
 			&circuit.Current,
 			&circuit.URL,
+			&circuit.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -199,13 +258,16 @@ func (r *circuitRepository) GetCircuitByLocation(ctx context.Context, location s
 }
 
 func (r *circuitRepository) GetCircuitByCountry(ctx context.Context, country string, page, limit int) ([]model.Circuit, error) {
-	query := `SELECT * FROM circuits WHERE country = $1`
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetCircuitByCountry")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE country = $1 AND ` + notDeleted(ctx, "deleted_at")
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, country)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, country)
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +283,7 @@ func (r *circuitRepository) GetCircuitByCountry(ctx context.Context, country str
 			&circuit.Country,
 			&circuit.Current,
 			&circuit.URL,
+			&circuit.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -231,9 +294,12 @@ func (r *circuitRepository) GetCircuitByCountry(ctx context.Context, country str
 }
 
 func (r *circuitRepository) GetCircuitByCurrent(ctx context.Context, current bool) ([]model.Circuit, error) {
-	query := `SELECT * FROM circuits WHERE "current" = $1`
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetCircuitByCurrent")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE "current" = $1 AND ` + notDeleted(ctx, "deleted_at")
 
-	rows, err := db.Conn.Query(ctx, query, current)
+	rows, err := db.Executor(ctx).Query(ctx, query, current)
 	if err != nil {
 		return nil, err
 	}
@@ -250,6 +316,7 @@ func (r *circuitRepository) GetCircuitByCurrent(ctx context.Context, current boo
 			&circuit.Country,
 			&circuit.Current,
 			&circuit.URL,
+			&circuit.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -260,9 +327,12 @@ func (r *circuitRepository) GetCircuitByCurrent(ctx context.Context, current boo
 }
 
 func (r *circuitRepository) GetCircuitByURL(ctx context.Context, url string) (model.Circuit, error) {
-	query := `SELECT * FROM circuits WHERE url = $1`
+	ctx, span := tracing.Start(ctx, "CircuitRepository.GetCircuitByURL")
+	defer span.End()
+
+	query := `SELECT id, ref, name, location, country, "current", url, deleted_at FROM circuits WHERE url = $1 AND ` + notDeleted(ctx, "deleted_at")
 	var circuit model.Circuit
-	err := db.Conn.QueryRow(ctx, query, url).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, url).Scan(
 		&circuit.ID,
 		&circuit.Ref,
 		&circuit.Name,
@@ -270,6 +340,7 @@ func (r *circuitRepository) GetCircuitByURL(ctx context.Context, url string) (mo
 		&circuit.Country,
 		&circuit.Current,
 		&circuit.URL,
+		&circuit.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -280,15 +351,35 @@ func (r *circuitRepository) GetCircuitByURL(ctx context.Context, url string) (mo
 	return circuit, nil
 }
 
+// ResolveCircuitRef returns the ID of the circuit with ref, or ErrNotFound.
+// Deleted circuits are found too, so that they can be restored by ref.
+func (r *circuitRepository) ResolveCircuitRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "CircuitRepository.ResolveCircuitRef")
+	defer span.End()
+
+	var id uuid.UUID
+	err := db.Executor(ctx).QueryRow(ctx, "SELECT id FROM circuits WHERE ref = $1", ref).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.UUID{}, ErrNotFound
+		}
+		return uuid.UUID{}, err
+	}
+	return id, nil
+}
+
 func (r *circuitRepository) UpdateCircuit(ctx context.Context, id uuid.UUID, circuit model.Circuit) (model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitRepository.UpdateCircuit")
+	defer span.End()
+
 	query := `
 		UPDATE circuits
 		SET ref = $1, name = $2, location = $3, country = $4, "current" = $5, url = $6
-		WHERE id = $7
-		RETURNING *
+		WHERE id = $7 AND deleted_at IS NULL
+		RETURNING id, ref, name, location, country, "current", url, deleted_at
 	`
 	var updatedCircuit model.Circuit
-	err := db.Conn.QueryRow(ctx, query, circuit.Ref, circuit.Name, circuit.Location, circuit.Country, circuit.Current, circuit.URL, id).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, circuit.Ref, circuit.Name, circuit.Location, circuit.Country, circuit.Current, circuit.URL, id).Scan(
 		&updatedCircuit.ID,
 		&updatedCircuit.Ref,
 		&updatedCircuit.Name,
@@ -296,6 +387,7 @@ func (r *circuitRepository) UpdateCircuit(ctx context.Context, id uuid.UUID, cir
 		&updatedCircuit.Country,
 		&updatedCircuit.Current,
 		&updatedCircuit.URL,
+		&updatedCircuit.DeletedAt,
 	)
 	if err != nil {
 		return model.Circuit{}, err
@@ -304,7 +396,75 @@ func (r *circuitRepository) UpdateCircuit(ctx context.Context, id uuid.UUID, cir
 }
 
 func (r *circuitRepository) DeleteCircuit(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM circuits WHERE id = $1`
-	_, err := db.Conn.Exec(ctx, query, id)
+	ctx, span := tracing.Start(ctx, "CircuitRepository.DeleteCircuit")
+	defer span.End()
+
+	query := `UPDATE circuits SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
 	return err
 }
+
+func (r *circuitRepository) RestoreCircuit(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "CircuitRepository.RestoreCircuit")
+	defer span.End()
+
+	query := `UPDATE circuits SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *circuitRepository) PurgeCircuit(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "CircuitRepository.PurgeCircuit")
+	defer span.End()
+
+	query := `DELETE FROM circuits WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *circuitRepository) BulkUpsertCircuits(ctx context.Context, circuits []model.Circuit, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "CircuitRepository.BulkUpsertCircuits")
+	defer span.End()
+
+	return bulkUpsert(ctx, circuits, mode, upsertCircuit)
+}
+
+func upsertCircuit(ctx context.Context, tx pgx.Tx, circuit model.Circuit) (model.Circuit, bool, error) {
+	query := `
+		INSERT INTO circuits (id, ref, name, location, country, "current", url)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (ref) DO UPDATE
+		SET name = EXCLUDED.name, location = EXCLUDED.location, country = EXCLUDED.country, "current" = EXCLUDED."current", url = EXCLUDED.url
+		WHERE circuits.deleted_at IS NULL
+		RETURNING id, ref, name, location, country, "current", url, (xmax = 0) AS inserted
+	`
+	var saved model.Circuit
+	var inserted bool
+	err := tx.QueryRow(ctx, query, circuit.ID, circuit.Ref, circuit.Name, circuit.Location, circuit.Country, circuit.Current, circuit.URL).Scan(
+		&saved.ID,
+		&saved.Ref,
+		&saved.Name,
+		&saved.Location,
+		&saved.Country,
+		&saved.Current,
+		&saved.URL,
+		&inserted,
+	)
+	if err != nil {
+		return model.Circuit{}, false, err
+	}
+	return saved, inserted, nil
+}
+
+func scanCircuit(rows pgx.Rows) (model.Circuit, error) {
+	var circuit model.Circuit
+	err := rows.Scan(
+		&circuit.ID,
+		&circuit.Ref,
+		&circuit.Name,
+		&circuit.Location,
+		&circuit.Country,
+		&circuit.Current,
+		&circuit.URL,
+		&circuit.DeletedAt,
+	)
+	return circuit, err
+}
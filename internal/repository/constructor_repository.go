@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"iter"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/ChinmayNoob/f1/internal/utils"
 	"github.com/ChinmayNoob/f1/pkg/db"
 	"github.com/google/uuid"
@@ -13,12 +15,19 @@ import (
 type ConstructorRepository interface {
 	CreateConstructor(ctx context.Context, constructor model.Constructor) (model.Constructor, error)
 	GetAllConstructors(ctx context.Context, page, limit int) ([]model.Constructor, error)
+	StreamConstructors(ctx context.Context, filter model.ConstructorFilter) iter.Seq2[model.Constructor, error]
 	GetConstructorByName(ctx context.Context, name string, page, limit int) ([]model.Constructor, error)
 	GetConstructorByID(ctx context.Context, id uuid.UUID) (model.Constructor, error)
+	GetConstructorsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Constructor, error)
+	GetDriverConstructors(ctx context.Context, driverIDs []uuid.UUID) (map[uuid.UUID]model.Constructor, error)
 	GetConstructorByNationality(ctx context.Context, nationality string, page, limit int) ([]model.Constructor, error)
 	GetConstructorByRef(ctx context.Context, ref string) (model.Constructor, error)
+	ResolveConstructorRef(ctx context.Context, ref string) (uuid.UUID, error)
 	UpdateConstructor(ctx context.Context, id uuid.UUID, constructor model.Constructor) (model.Constructor, error)
 	DeleteConstructor(ctx context.Context, id uuid.UUID) error
+	RestoreConstructor(ctx context.Context, id uuid.UUID) error
+	PurgeConstructor(ctx context.Context, id uuid.UUID) error
+	BulkUpsertConstructors(ctx context.Context, constructors []model.Constructor, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type constructorRepository struct{}
@@ -28,18 +37,22 @@ func NewConstructorRepository() ConstructorRepository {
 }
 
 func (r *constructorRepository) CreateConstructor(ctx context.Context, constructor model.Constructor) (model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.CreateConstructor")
+	defer span.End()
+
 	query := `
 		INSERT INTO constructors (id, ref, name, nationality, url)
 		VALUES ($1, $2, $3, $4, $5)
-		RETURNING *
+		RETURNING id, ref, name, nationality, url, deleted_at
 	`
 	var createdConstructor model.Constructor
-	err := db.Conn.QueryRow(ctx, query, constructor.ID, constructor.Ref, constructor.Name, constructor.Nationality, constructor.URL).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, constructor.ID, constructor.Ref, constructor.Name, constructor.Nationality, constructor.URL).Scan(
 		&createdConstructor.ID,
 		&createdConstructor.Ref,
 		&createdConstructor.Name,
 		&createdConstructor.Nationality,
 		&createdConstructor.URL,
+		&createdConstructor.DeletedAt,
 	)
 	if err != nil {
 		return model.Constructor{}, err
@@ -48,7 +61,10 @@ func (r *constructorRepository) CreateConstructor(ctx context.Context, construct
 }
 
 func (r *constructorRepository) GetAllConstructors(ctx context.Context, page, limit int) ([]model.Constructor, error) {
-	query := `SELECT * FROM constructors`
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.GetAllConstructors")
+	defer span.End()
+
+	query := `SELECT id, ref, name, nationality, url, deleted_at FROM constructors WHERE ` + notDeleted(ctx, "deleted_at")
 
 	paginationQuery, err := utils.Paginate(query, page, limit)
 
@@ -56,7 +72,7 @@ func (r *constructorRepository) GetAllConstructors(ctx context.Context, page, li
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +87,7 @@ func (r *constructorRepository) GetAllConstructors(ctx context.Context, page, li
 			&constructor.Name,
 			&constructor.Nationality,
 			&constructor.URL,
+			&constructor.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -80,14 +97,31 @@ func (r *constructorRepository) GetAllConstructors(ctx context.Context, page, li
 	return constructors, nil
 }
 
+// StreamConstructors yields every constructor matching filter, unpaginated,
+// as it is read from the database.
+func (r *constructorRepository) StreamConstructors(ctx context.Context, filter model.ConstructorFilter) iter.Seq2[model.Constructor, error] {
+	return tracing.Seq2(ctx, "ConstructorRepository.StreamConstructors", func(ctx context.Context) iter.Seq2[model.Constructor, error] {
+		var cond conditions
+		cond.equal("name", filter.Name)
+		cond.equal("nationality", filter.Nationality)
+		cond.equal("ref", filter.Ref)
+
+		query := `SELECT id, ref, name, nationality, url, deleted_at FROM constructors WHERE ` + cond.sql() + ` AND ` + notDeleted(ctx, "deleted_at")
+		return streamRows(ctx, scanConstructor, query, cond.args...)
+	})
+}
+
 func (r *constructorRepository) GetConstructorByName(ctx context.Context, name string, page, limit int) ([]model.Constructor, error) {
-	query := `SELECT * FROM constructors WHERE name = $1`
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.GetConstructorByName")
+	defer span.End()
+
+	query := `SELECT id, ref, name, nationality, url, deleted_at FROM constructors WHERE name = $1 AND ` + notDeleted(ctx, "deleted_at")
 	paginationQuery, err := utils.Paginate(query, page, limit)
 
 	if err != nil {
 		return nil, err
 	}
-	rows, err := db.Conn.Query(ctx, paginationQuery, name)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, name)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +135,7 @@ func (r *constructorRepository) GetConstructorByName(ctx context.Context, name s
 			&constructor.Name,
 			&constructor.Nationality,
 			&constructor.URL,
+			&constructor.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -111,14 +146,18 @@ func (r *constructorRepository) GetConstructorByName(ctx context.Context, name s
 }
 
 func (r *constructorRepository) GetConstructorByID(ctx context.Context, id uuid.UUID) (model.Constructor, error) {
-	query := `SELECT * FROM constructors WHERE id = $1`
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.GetConstructorByID")
+	defer span.End()
+
+	query := `SELECT id, ref, name, nationality, url, deleted_at FROM constructors WHERE id = $1 AND ` + notDeleted(ctx, "deleted_at")
 	var constructor model.Constructor
-	err := db.Conn.QueryRow(ctx, query, id).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, id).Scan(
 		&constructor.ID,
 		&constructor.Ref,
 		&constructor.Name,
 		&constructor.Nationality,
 		&constructor.URL,
+		&constructor.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -129,15 +168,68 @@ func (r *constructorRepository) GetConstructorByID(ctx context.Context, id uuid.
 	return constructor, nil
 }
 
+// GetConstructorsByIDs returns the constructors with the given IDs, in no
+// particular order. IDs that match no constructor are left out.
+func (r *constructorRepository) GetConstructorsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.GetConstructorsByIDs")
+	defer span.End()
+
+	query := `SELECT id, ref, name, nationality, url, deleted_at FROM constructors WHERE id = ANY($1) AND ` + notDeleted(ctx, "deleted_at")
+	return queryAll(ctx, scanConstructor, query, ids)
+}
+
+// GetDriverConstructors returns the constructor of each of the given
+// drivers, by driver ID.
+func (r *constructorRepository) GetDriverConstructors(ctx context.Context, driverIDs []uuid.UUID) (map[uuid.UUID]model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.GetDriverConstructors")
+	defer span.End()
+
+	query := `
+		SELECT d.id, c.id, c.ref, c.name, c.nationality, c.url, c.deleted_at
+		FROM drivers d
+		INNER JOIN constructors c ON d.constructor_id = c.id
+		WHERE d.id = ANY($1) AND ` + notDeleted(ctx, "c.deleted_at") + `
+	`
+	rows, err := db.Executor(ctx).Query(ctx, query, driverIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	constructors := make(map[uuid.UUID]model.Constructor)
+	for rows.Next() {
+		var driverID uuid.UUID
+		var constructor model.Constructor
+		err := rows.Scan(
+			&driverID,
+			&constructor.ID,
+			&constructor.Ref,
+			&constructor.Name,
+			&constructor.Nationality,
+			&constructor.URL,
+			&constructor.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		constructors[driverID] = constructor
+	}
+	return constructors, rows.Err()
+}
+
 func (r *constructorRepository) GetConstructorByRef(ctx context.Context, ref string) (model.Constructor, error) {
-	query := `SELECT * FROM constructors WHERE ref = $1`
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.GetConstructorByRef")
+	defer span.End()
+
+	query := `SELECT id, ref, name, nationality, url, deleted_at FROM constructors WHERE ref = $1 AND ` + notDeleted(ctx, "deleted_at")
 	var constructor model.Constructor
-	err := db.Conn.QueryRow(ctx, query, ref).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, ref).Scan(
 		&constructor.ID,
 		&constructor.Ref,
 		&constructor.Name,
 		&constructor.Nationality,
 		&constructor.URL,
+		&constructor.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -149,14 +241,17 @@ func (r *constructorRepository) GetConstructorByRef(ctx context.Context, ref str
 }
 
 func (r *constructorRepository) GetConstructorByNationality(ctx context.Context, nationality string, page, limit int) ([]model.Constructor, error) {
-	query := `SELECT * FROM constructors WHERE nationality = $1`
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.GetConstructorByNationality")
+	defer span.End()
+
+	query := `SELECT id, ref, name, nationality, url, deleted_at FROM constructors WHERE nationality = $1 AND ` + notDeleted(ctx, "deleted_at")
 
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, nationality)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, nationality)
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +265,7 @@ func (r *constructorRepository) GetConstructorByNationality(ctx context.Context,
 			&constructor.Name,
 			&constructor.Nationality,
 			&constructor.URL,
+			&constructor.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -179,20 +275,41 @@ func (r *constructorRepository) GetConstructorByNationality(ctx context.Context,
 	return constructors, nil
 }
 
+// ResolveConstructorRef returns the ID of the constructor with ref, or ErrNotFound.
+// Deleted constructors are found too, so that they can be restored by ref.
+func (r *constructorRepository) ResolveConstructorRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.ResolveConstructorRef")
+	defer span.End()
+
+	var id uuid.UUID
+	err := db.Executor(ctx).QueryRow(ctx, "SELECT id FROM constructors WHERE ref = $1", ref).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.UUID{}, ErrNotFound
+		}
+		return uuid.UUID{}, err
+	}
+	return id, nil
+}
+
 func (r *constructorRepository) UpdateConstructor(ctx context.Context, id uuid.UUID, constructor model.Constructor) (model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.UpdateConstructor")
+	defer span.End()
+
 	query := `
 		UPDATE constructors
 		SET ref = $1, name = $2, nationality = $3, url = $4
-		WHERE id = $5
-		RETURNING *
+		WHERE id = $5 AND deleted_at IS NULL
+		RETURNING id, ref, name, nationality, url, deleted_at
 	`
 	var updatedConstructor model.Constructor
-	err := db.Conn.QueryRow(ctx, query, constructor.Ref, constructor.Name, constructor.Nationality, constructor.URL, id).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, constructor.Ref, constructor.Name, constructor.Nationality, constructor.URL, id).Scan(
 		&updatedConstructor.ID,
 		&updatedConstructor.Ref,
 		&updatedConstructor.Name,
 		&updatedConstructor.Nationality,
 		&updatedConstructor.URL,
+		&updatedConstructor.DeletedAt,
 	)
 	if err != nil {
 		return model.Constructor{}, err
@@ -201,7 +318,71 @@ func (r *constructorRepository) UpdateConstructor(ctx context.Context, id uuid.U
 }
 
 func (r *constructorRepository) DeleteConstructor(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM constructors WHERE id = $1`
-	_, err := db.Conn.Exec(ctx, query, id)
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.DeleteConstructor")
+	defer span.End()
+
+	query := `UPDATE constructors SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
 	return err
 }
+
+func (r *constructorRepository) RestoreConstructor(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.RestoreConstructor")
+	defer span.End()
+
+	query := `UPDATE constructors SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *constructorRepository) PurgeConstructor(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.PurgeConstructor")
+	defer span.End()
+
+	query := `DELETE FROM constructors WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *constructorRepository) BulkUpsertConstructors(ctx context.Context, constructors []model.Constructor, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorRepository.BulkUpsertConstructors")
+	defer span.End()
+
+	return bulkUpsert(ctx, constructors, mode, upsertConstructor)
+}
+
+func upsertConstructor(ctx context.Context, tx pgx.Tx, constructor model.Constructor) (model.Constructor, bool, error) {
+	query := `
+		INSERT INTO constructors (id, ref, name, nationality, url)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (ref) DO UPDATE
+		SET name = EXCLUDED.name, nationality = EXCLUDED.nationality, url = EXCLUDED.url
+		WHERE constructors.deleted_at IS NULL
+		RETURNING id, ref, name, nationality, url, (xmax = 0) AS inserted
+	`
+	var saved model.Constructor
+	var inserted bool
+	err := tx.QueryRow(ctx, query, constructor.ID, constructor.Ref, constructor.Name, constructor.Nationality, constructor.URL).Scan(
+		&saved.ID,
+		&saved.Ref,
+		&saved.Name,
+		&saved.Nationality,
+		&saved.URL,
+		&inserted,
+	)
+	if err != nil {
+		return model.Constructor{}, false, err
+	}
+	return saved, inserted, nil
+}
+
+func scanConstructor(rows pgx.Rows) (model.Constructor, error) {
+	var constructor model.Constructor
+	err := rows.Scan(
+		&constructor.ID,
+		&constructor.Ref,
+		&constructor.Name,
+		&constructor.Nationality,
+		&constructor.URL,
+		&constructor.DeletedAt,
+	)
+	return constructor, err
+}
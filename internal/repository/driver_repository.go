@@ -3,29 +3,41 @@ package repository
 import (
 	"context"
 	"errors"
+	"iter"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/ChinmayNoob/f1/internal/utils"
 	"github.com/ChinmayNoob/f1/pkg/db"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
 
+// ErrConstructorNotFound is returned when a driver names a constructor that
+// does not exist.
+var ErrConstructorNotFound = errors.New("constructor not found")
+
 type DriverRepository interface {
 	CreateDriver(ctx context.Context, driver model.Driver) (model.Driver, error)
 	GetAllDrivers(ctx context.Context, page, limit int) ([]model.Driver, error)
+	StreamDrivers(ctx context.Context, filter model.DriverFilter) iter.Seq2[model.Driver, error]
 	GetDriverByFirstName(ctx context.Context, firstName string, page, limit int) ([]model.Driver, error)
 	GetDriverByLastName(ctx context.Context, lastName string, page, limit int) ([]model.Driver, error)
 	GetDriverByTeam(ctx context.Context, constructorName string, page, limit int) ([]model.Driver, error)
 	GetDriverByID(ctx context.Context, id uuid.UUID) (model.Driver, error)
+	GetDriversByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Driver, error)
 	GetDriverByRef(ctx context.Context, ref string) (model.Driver, error)
 	GetDriverByCode(ctx context.Context, code string) (model.Driver, error)
 	GetDriverByNumber(ctx context.Context, number int) (model.Driver, error)
 	GetDriverByNationality(ctx context.Context, nationality string, page, limit int) ([]model.Driver, error)
 	GetDriverByStatus(ctx context.Context, status string, page, limit int) ([]model.Driver, error)
 	GetDriverByURL(ctx context.Context, url string) (model.Driver, error)
+	ResolveDriverRef(ctx context.Context, ref string) (uuid.UUID, error)
 	UpdateDriver(ctx context.Context, id uuid.UUID, driver model.Driver) (model.Driver, error)
 	DeleteDriver(ctx context.Context, id uuid.UUID) error
+	RestoreDriver(ctx context.Context, id uuid.UUID) error
+	PurgeDriver(ctx context.Context, id uuid.UUID) error
+	BulkUpsertDrivers(ctx context.Context, drivers []model.Driver, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type driverRepository struct{}
@@ -35,12 +47,11 @@ func NewDriverRepository() DriverRepository {
 }
 
 func (r *driverRepository) CreateDriver(ctx context.Context, driver model.Driver) (model.Driver, error) {
-	var constructorID uuid.UUID
-	err := db.Conn.QueryRow(ctx, "SELECT id FROM constructors WHERE name = $1", driver.Constructor).Scan(&constructorID)
+	ctx, span := tracing.Start(ctx, "DriverRepository.CreateDriver")
+	defer span.End()
+
+	constructorID, constructorName, err := findConstructor(ctx, db.Executor(ctx), driver.Constructor)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return model.Driver{}, errors.New("constructor not found")
-		}
 		return model.Driver{}, err
 	}
 
@@ -50,7 +61,7 @@ func (r *driverRepository) CreateDriver(ctx context.Context, driver model.Driver
 		RETURNING id, ref, code, number, first_name, last_name, date_of_birth, nationality, status, url
 	`
 	var createdDriver model.Driver
-	err = db.Conn.QueryRow(ctx, query, driver.ID, constructorID, driver.Ref, driver.Code, driver.Number, driver.FirstName, driver.LastName, driver.DateOfBirth, driver.Nationality, driver.Status, driver.URL).Scan(
+	err = db.Executor(ctx).QueryRow(ctx, query, driver.ID, constructorID, driver.Ref, driver.Code, driver.Number, driver.FirstName, driver.LastName, driver.DateOfBirth, driver.Nationality, driver.Status, driver.URL).Scan(
 		&createdDriver.ID,
 		&createdDriver.Ref,
 		&createdDriver.Code,
@@ -65,22 +76,26 @@ func (r *driverRepository) CreateDriver(ctx context.Context, driver model.Driver
 	if err != nil {
 		return model.Driver{}, err
 	}
-	createdDriver.Constructor = driver.Constructor
+	createdDriver.Constructor = constructorName
 	return createdDriver, nil
 }
 
 func (r *driverRepository) GetAllDrivers(ctx context.Context, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetAllDrivers")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
+		WHERE ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +116,7 @@ func (r *driverRepository) GetAllDrivers(ctx context.Context, page, limit int) (
 			&driver.Nationality,
 			&driver.Status,
 			&driver.URL,
+			&driver.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -110,19 +126,47 @@ func (r *driverRepository) GetAllDrivers(ctx context.Context, page, limit int) (
 	return drivers, nil
 }
 
+// StreamDrivers yields every driver matching filter, unpaginated, as it is
+// read from the database.
+func (r *driverRepository) StreamDrivers(ctx context.Context, filter model.DriverFilter) iter.Seq2[model.Driver, error] {
+	return tracing.Seq2(ctx, "DriverRepository.StreamDrivers", func(ctx context.Context) iter.Seq2[model.Driver, error] {
+		var cond conditions
+		cond.equal("d.first_name", filter.FirstName)
+		cond.equal("d.last_name", filter.LastName)
+		cond.equal("c.name", filter.Team)
+		cond.equal("d.nationality", filter.Nationality)
+		cond.equal("d.status", filter.Status)
+		cond.equal("d.ref", filter.Ref)
+		cond.equal("d.code", filter.Code)
+		cond.equal("d.number", filter.Number)
+		cond.equal("d.url", filter.URL)
+
+		query := `
+			SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
+			FROM drivers d
+			INNER JOIN constructors c ON d.constructor_id = c.id
+			WHERE ` + cond.sql() + ` AND ` + notDeleted(ctx, "d.deleted_at") + `
+		`
+		return streamRows(ctx, scanDriver, query, cond.args...)
+	})
+}
+
 func (r *driverRepository) GetDriverByFirstName(ctx context.Context, firstName string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByFirstName")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.first_name = $1
+		WHERE d.first_name = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, firstName)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, firstName)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +187,7 @@ func (r *driverRepository) GetDriverByFirstName(ctx context.Context, firstName s
 			&driver.Nationality,
 			&driver.Status,
 			&driver.URL,
+			&driver.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -153,18 +198,21 @@ func (r *driverRepository) GetDriverByFirstName(ctx context.Context, firstName s
 }
 
 func (r *driverRepository) GetDriverByLastName(ctx context.Context, lastName string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByLastName")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.last_name = $1
+		WHERE d.last_name = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, lastName)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, lastName)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +233,7 @@ func (r *driverRepository) GetDriverByLastName(ctx context.Context, lastName str
 			&driver.Nationality,
 			&driver.Status,
 			&driver.URL,
+			&driver.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -195,18 +244,21 @@ func (r *driverRepository) GetDriverByLastName(ctx context.Context, lastName str
 }
 
 func (r *driverRepository) GetDriverByTeam(ctx context.Context, constructorName string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByTeam")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE c.name = $1
+		WHERE c.name = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, constructorName)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, constructorName)
 	if err != nil {
 		return nil, err
 	}
@@ -227,6 +279,7 @@ func (r *driverRepository) GetDriverByTeam(ctx context.Context, constructorName
 			&driver.Nationality,
 			&driver.Status,
 			&driver.URL,
+			&driver.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -237,14 +290,17 @@ func (r *driverRepository) GetDriverByTeam(ctx context.Context, constructorName
 }
 
 func (r *driverRepository) GetDriverByID(ctx context.Context, id uuid.UUID) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByID")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.id = $1
+		WHERE d.id = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	var driver model.Driver
-	err := db.Conn.QueryRow(ctx, query, id).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, id).Scan(
 		&driver.ID,
 		&driver.Constructor,
 		&driver.Ref,
@@ -256,6 +312,7 @@ func (r *driverRepository) GetDriverByID(ctx context.Context, id uuid.UUID) (mod
 		&driver.Nationality,
 		&driver.Status,
 		&driver.URL,
+		&driver.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -266,15 +323,33 @@ func (r *driverRepository) GetDriverByID(ctx context.Context, id uuid.UUID) (mod
 	return driver, nil
 }
 
+// GetDriversByIDs returns the drivers with the given IDs, in no particular
+// order. IDs that match no driver are left out.
+func (r *driverRepository) GetDriversByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriversByIDs")
+	defer span.End()
+
+	query := `
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
+		FROM drivers d
+		INNER JOIN constructors c ON d.constructor_id = c.id
+		WHERE d.id = ANY($1) AND ` + notDeleted(ctx, "d.deleted_at") + `
+	`
+	return queryAll(ctx, scanDriver, query, ids)
+}
+
 func (r *driverRepository) GetDriverByRef(ctx context.Context, ref string) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByRef")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.ref = $1
+		WHERE d.ref = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	var driver model.Driver
-	err := db.Conn.QueryRow(ctx, query, ref).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, ref).Scan(
 		&driver.ID,
 		&driver.Constructor,
 		&driver.Ref,
@@ -286,6 +361,7 @@ func (r *driverRepository) GetDriverByRef(ctx context.Context, ref string) (mode
 		&driver.Nationality,
 		&driver.Status,
 		&driver.URL,
+		&driver.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -297,14 +373,17 @@ func (r *driverRepository) GetDriverByRef(ctx context.Context, ref string) (mode
 }
 
 func (r *driverRepository) GetDriverByCode(ctx context.Context, code string) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByCode")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.code = $1
+		WHERE d.code = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	var driver model.Driver
-	err := db.Conn.QueryRow(ctx, query, code).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, code).Scan(
 		&driver.ID,
 		&driver.Constructor,
 		&driver.Ref,
@@ -316,6 +395,7 @@ func (r *driverRepository) GetDriverByCode(ctx context.Context, code string) (mo
 		&driver.Nationality,
 		&driver.Status,
 		&driver.URL,
+		&driver.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -327,14 +407,17 @@ func (r *driverRepository) GetDriverByCode(ctx context.Context, code string) (mo
 }
 
 func (r *driverRepository) GetDriverByNumber(ctx context.Context, number int) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByNumber")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.number = $1
+		WHERE d.number = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	var driver model.Driver
-	err := db.Conn.QueryRow(ctx, query, number).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, number).Scan(
 		&driver.ID,
 		&driver.Constructor,
 		&driver.Ref,
@@ -346,6 +429,7 @@ func (r *driverRepository) GetDriverByNumber(ctx context.Context, number int) (m
 		&driver.Nationality,
 		&driver.Status,
 		&driver.URL,
+		&driver.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -357,18 +441,21 @@ func (r *driverRepository) GetDriverByNumber(ctx context.Context, number int) (m
 }
 
 func (r *driverRepository) GetDriverByNationality(ctx context.Context, nationality string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByNationality")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.nationality = $1
+		WHERE d.nationality = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, nationality)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, nationality)
 	if err != nil {
 		return nil, err
 	}
@@ -389,6 +476,7 @@ func (r *driverRepository) GetDriverByNationality(ctx context.Context, nationali
 			&driver.Nationality,
 			&driver.Status,
 			&driver.URL,
+			&driver.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -399,18 +487,21 @@ func (r *driverRepository) GetDriverByNationality(ctx context.Context, nationali
 }
 
 func (r *driverRepository) GetDriverByStatus(ctx context.Context, status string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByStatus")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.status = $1
+		WHERE d.status = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	paginationQuery, err := utils.Paginate(query, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := db.Conn.Query(ctx, paginationQuery, status)
+	rows, err := db.Executor(ctx).Query(ctx, paginationQuery, status)
 	if err != nil {
 		return nil, err
 	}
@@ -431,6 +522,7 @@ func (r *driverRepository) GetDriverByStatus(ctx context.Context, status string,
 			&driver.Nationality,
 			&driver.Status,
 			&driver.URL,
+			&driver.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -441,14 +533,17 @@ func (r *driverRepository) GetDriverByStatus(ctx context.Context, status string,
 }
 
 func (r *driverRepository) GetDriverByURL(ctx context.Context, url string) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.GetDriverByURL")
+	defer span.End()
+
 	query := `
-		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url
+		SELECT d.id, c.name as constructor, d.ref, d.code, d.number, d.first_name, d.last_name, d.date_of_birth, d.nationality, d.status, d.url, d.deleted_at
 		FROM drivers d
 		INNER JOIN constructors c ON d.constructor_id = c.id
-		WHERE d.url = $1
+		WHERE d.url = $1 AND ` + notDeleted(ctx, "d.deleted_at") + `
 	`
 	var driver model.Driver
-	err := db.Conn.QueryRow(ctx, query, url).Scan(
+	err := db.Executor(ctx).QueryRow(ctx, query, url).Scan(
 		&driver.ID,
 		&driver.Constructor,
 		&driver.Ref,
@@ -460,6 +555,7 @@ func (r *driverRepository) GetDriverByURL(ctx context.Context, url string) (mode
 		&driver.Nationality,
 		&driver.Status,
 		&driver.URL,
+		&driver.DeletedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -470,24 +566,40 @@ func (r *driverRepository) GetDriverByURL(ctx context.Context, url string) (mode
 	return driver, nil
 }
 
-func (r *driverRepository) UpdateDriver(ctx context.Context, id uuid.UUID, driver model.Driver) (model.Driver, error) {
-	var constructorID uuid.UUID
-	err := db.Conn.QueryRow(ctx, "SELECT id FROM constructors WHERE name = $1", driver.Constructor).Scan(&constructorID)
+// ResolveDriverRef returns the ID of the driver with ref, or ErrNotFound.
+// Deleted drivers are found too, so that they can be restored by ref.
+func (r *driverRepository) ResolveDriverRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.ResolveDriverRef")
+	defer span.End()
+
+	var id uuid.UUID
+	err := db.Executor(ctx).QueryRow(ctx, "SELECT id FROM drivers WHERE ref = $1", ref).Scan(&id)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return model.Driver{}, errors.New("constructor not found")
+			return uuid.UUID{}, ErrNotFound
 		}
+		return uuid.UUID{}, err
+	}
+	return id, nil
+}
+
+func (r *driverRepository) UpdateDriver(ctx context.Context, id uuid.UUID, driver model.Driver) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.UpdateDriver")
+	defer span.End()
+
+	constructorID, constructorName, err := findConstructor(ctx, db.Executor(ctx), driver.Constructor)
+	if err != nil {
 		return model.Driver{}, err
 	}
 
 	query := `
 		UPDATE drivers
 		SET constructor_id = $1, ref = $2, code = $3, number = $4, first_name = $5, last_name = $6, date_of_birth = $7, nationality = $8, status = $9, url = $10
-		WHERE id = $11
+		WHERE id = $11 AND deleted_at IS NULL
 		RETURNING id, ref, code, number, first_name, last_name, date_of_birth, nationality, status, url
 	`
 	var updatedDriver model.Driver
-	err = db.Conn.QueryRow(ctx, query, constructorID, driver.Ref, driver.Code, driver.Number, driver.FirstName, driver.LastName, driver.DateOfBirth, driver.Nationality, driver.Status, driver.URL, id).Scan(
+	err = db.Executor(ctx).QueryRow(ctx, query, constructorID, driver.Ref, driver.Code, driver.Number, driver.FirstName, driver.LastName, driver.DateOfBirth, driver.Nationality, driver.Status, driver.URL, id).Scan(
 		&updatedDriver.ID,
 		&updatedDriver.Ref,
 		&updatedDriver.Code,
@@ -502,12 +614,117 @@ func (r *driverRepository) UpdateDriver(ctx context.Context, id uuid.UUID, drive
 	if err != nil {
 		return model.Driver{}, err
 	}
-	updatedDriver.Constructor = driver.Constructor
+	updatedDriver.Constructor = constructorName
 	return updatedDriver, nil
 }
 
 func (r *driverRepository) DeleteDriver(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM drivers WHERE id = $1`
-	_, err := db.Conn.Exec(ctx, query, id)
+	ctx, span := tracing.Start(ctx, "DriverRepository.DeleteDriver")
+	defer span.End()
+
+	query := `UPDATE drivers SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
 	return err
 }
+
+func (r *driverRepository) RestoreDriver(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "DriverRepository.RestoreDriver")
+	defer span.End()
+
+	query := `UPDATE drivers SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *driverRepository) PurgeDriver(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "DriverRepository.PurgeDriver")
+	defer span.End()
+
+	query := `DELETE FROM drivers WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *driverRepository) BulkUpsertDrivers(ctx context.Context, drivers []model.Driver, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "DriverRepository.BulkUpsertDrivers")
+	defer span.End()
+
+	return bulkUpsert(ctx, drivers, mode, upsertDriver)
+}
+
+func upsertDriver(ctx context.Context, tx pgx.Tx, driver model.Driver) (model.Driver, bool, error) {
+	constructorID, constructorName, err := findConstructor(ctx, tx, driver.Constructor)
+	if err != nil {
+		return model.Driver{}, false, err
+	}
+
+	query := `
+		INSERT INTO drivers (id, constructor_id, ref, code, number, first_name, last_name, date_of_birth, nationality, status, url)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (ref) DO UPDATE
+		SET constructor_id = EXCLUDED.constructor_id, code = EXCLUDED.code, number = EXCLUDED.number, first_name = EXCLUDED.first_name,
+			last_name = EXCLUDED.last_name, date_of_birth = EXCLUDED.date_of_birth, nationality = EXCLUDED.nationality,
+			status = EXCLUDED.status, url = EXCLUDED.url
+		WHERE drivers.deleted_at IS NULL
+		RETURNING id, ref, code, number, first_name, last_name, date_of_birth, nationality, status, url, (xmax = 0) AS inserted
+	`
+	var saved model.Driver
+	var inserted bool
+	err = tx.QueryRow(ctx, query, driver.ID, constructorID, driver.Ref, driver.Code, driver.Number, driver.FirstName, driver.LastName, driver.DateOfBirth, driver.Nationality, driver.Status, driver.URL).Scan(
+		&saved.ID,
+		&saved.Ref,
+		&saved.Code,
+		&saved.Number,
+		&saved.FirstName,
+		&saved.LastName,
+		&saved.DateOfBirth,
+		&saved.Nationality,
+		&saved.Status,
+		&saved.URL,
+		&inserted,
+	)
+	if err != nil {
+		return model.Driver{}, false, err
+	}
+	saved.Constructor = constructorName
+	return saved, inserted, nil
+}
+
+// findConstructor looks up the constructor a driver names by ID, ref or
+// display name, preferring them in that order, and returns its ID and name.
+func findConstructor(ctx context.Context, q db.DBTX, constructor string) (uuid.UUID, string, error) {
+	query := `
+		SELECT id, name
+		FROM constructors
+		WHERE (id::text = $1 OR ref = $1 OR name = $1) AND deleted_at IS NULL
+		ORDER BY id::text = $1 DESC, ref = $1 DESC
+		LIMIT 1
+	`
+	var id uuid.UUID
+	var name string
+	err := q.QueryRow(ctx, query, constructor).Scan(&id, &name)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.UUID{}, "", ErrConstructorNotFound
+		}
+		return uuid.UUID{}, "", err
+	}
+	return id, name, nil
+}
+
+func scanDriver(rows pgx.Rows) (model.Driver, error) {
+	var driver model.Driver
+	err := rows.Scan(
+		&driver.ID,
+		&driver.Constructor,
+		&driver.Ref,
+		&driver.Code,
+		&driver.Number,
+		&driver.FirstName,
+		&driver.LastName,
+		&driver.DateOfBirth,
+		&driver.Nationality,
+		&driver.Status,
+		&driver.URL,
+		&driver.DeletedAt,
+	)
+	return driver, err
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/pkg/db"
+)
+
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	GetMigrationVersions(ctx context.Context) (applied, latest int, err error)
+}
+
+type healthRepository struct{}
+
+func NewHealthRepository() HealthRepository {
+	return &healthRepository{}
+}
+
+func (r *healthRepository) Ping(ctx context.Context) error {
+	return db.Pool.Ping(ctx)
+}
+
+func (r *healthRepository) GetMigrationVersions(ctx context.Context) (applied, latest int, err error) {
+	return db.MigrationVersions(ctx)
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type IdempotencyRepository interface {
+	// ReserveIdempotencyKey claims key for a new request of actor. Keys are
+	// scoped to the actor that sent them. When the key is already held by an
+	// unexpired record, that record is returned with reserved set to false.
+	ReserveIdempotencyKey(ctx context.Context, actor, key, fingerprint string, ttl time.Duration) (record model.IdempotencyRecord, reserved bool, err error)
+	CompleteIdempotencyKey(ctx context.Context, actor, key string, statusCode int, header http.Header, body []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, actor, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+type idempotencyRepository struct{}
+
+func NewIdempotencyRepository() IdempotencyRepository {
+	return &idempotencyRepository{}
+}
+
+func (r *idempotencyRepository) ReserveIdempotencyKey(ctx context.Context, actor, key, fingerprint string, ttl time.Duration) (model.IdempotencyRecord, bool, error) {
+	ctx, span := tracing.Start(ctx, "IdempotencyRepository.ReserveIdempotencyKey")
+	defer span.End()
+
+	query := `
+		INSERT INTO idempotency_keys (actor, key, fingerprint, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (actor, key) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint, status_code = NULL, headers = '{}', body = NULL, created_at = now(), expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= now()
+		RETURNING actor, key, fingerprint, status_code, headers, body, created_at, expires_at
+	`
+	var record model.IdempotencyRecord
+	err := db.Executor(ctx).QueryRow(ctx, query, actor, key, fingerprint, time.Now().Add(ttl)).Scan(
+		&record.Actor,
+		&record.Key,
+		&record.Fingerprint,
+		&record.StatusCode,
+		&record.Header,
+		&record.Body,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+	)
+	if err == nil {
+		return record, true, nil
+	}
+	if err != pgx.ErrNoRows {
+		return model.IdempotencyRecord{}, false, err
+	}
+
+	query = `
+		SELECT actor, key, fingerprint, status_code, headers, body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE actor = $1 AND key = $2
+	`
+	err = db.Executor(ctx).QueryRow(ctx, query, actor, key).Scan(
+		&record.Actor,
+		&record.Key,
+		&record.Fingerprint,
+		&record.StatusCode,
+		&record.Header,
+		&record.Body,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+	)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, err
+	}
+	return record, false, nil
+}
+
+func (r *idempotencyRepository) CompleteIdempotencyKey(ctx context.Context, actor, key string, statusCode int, header http.Header, body []byte) error {
+	ctx, span := tracing.Start(ctx, "IdempotencyRepository.CompleteIdempotencyKey")
+	defer span.End()
+
+	query := `UPDATE idempotency_keys SET status_code = $1, headers = $2, body = $3 WHERE actor = $4 AND key = $5`
+	_, err := db.Executor(ctx).Exec(ctx, query, statusCode, header, body, actor, key)
+	return err
+}
+
+func (r *idempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, actor, key string) error {
+	ctx, span := tracing.Start(ctx, "IdempotencyRepository.ReleaseIdempotencyKey")
+	defer span.End()
+
+	query := `DELETE FROM idempotency_keys WHERE actor = $1 AND key = $2`
+	_, err := db.Executor(ctx).Exec(ctx, query, actor, key)
+	return err
+}
+
+func (r *idempotencyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	ctx, span := tracing.Start(ctx, "IdempotencyRepository.DeleteExpiredIdempotencyKeys")
+	defer span.End()
+
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= now()`
+	tag, err := db.Executor(ctx).Exec(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type QueryPlanRepository interface {
+	ExplainQuery(ctx context.Context, sql string, args []any, analyze bool) ([]string, error)
+}
+
+type queryPlanRepository struct{}
+
+func NewQueryPlanRepository() QueryPlanRepository {
+	return &queryPlanRepository{}
+}
+
+// ExplainQuery returns the plan of sql for args. With analyze the statement
+// is executed to measure it. It runs in a read-only transaction that is
+// always rolled back, so that a statement that would write fails instead.
+func (r *queryPlanRepository) ExplainQuery(ctx context.Context, sql string, args []any, analyze bool) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "QueryPlanRepository.ExplainQuery")
+	defer span.End()
+
+	tx, err := db.Pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	explain := "EXPLAIN "
+	if analyze {
+		explain = "EXPLAIN (ANALYZE, BUFFERS) "
+	}
+	rows, err := tx.Query(ctx, explain+sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var plan []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return nil, err
+		}
+		plan = append(plan, line)
+	}
+	return plan, rows.Err()
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type QuotaRepository interface {
+	GetDailyUsage(ctx context.Context, client string, day time.Time) (int64, error)
+	AddDailyUsage(ctx context.Context, day time.Time, usage map[string]int64) error
+}
+
+type quotaRepository struct{}
+
+func NewQuotaRepository() QuotaRepository {
+	return &quotaRepository{}
+}
+
+func (r *quotaRepository) GetDailyUsage(ctx context.Context, client string, day time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "QuotaRepository.GetDailyUsage")
+	defer span.End()
+
+	query := `SELECT requests FROM daily_quota_usage WHERE client = $1 AND day = $2`
+	var requests int64
+	err := db.Executor(ctx).QueryRow(ctx, query, client, day).Scan(&requests)
+	if err == pgx.ErrNoRows {
+		return 0, nil
+	}
+	return requests, err
+}
+
+func (r *quotaRepository) AddDailyUsage(ctx context.Context, day time.Time, usage map[string]int64) error {
+	ctx, span := tracing.Start(ctx, "QuotaRepository.AddDailyUsage")
+	defer span.End()
+
+	query := `
+		INSERT INTO daily_quota_usage (client, day, requests)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (client, day) DO UPDATE
+		SET requests = daily_quota_usage.requests + EXCLUDED.requests
+	`
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		for client, requests := range usage {
+			if _, err := db.Executor(ctx).Exec(ctx, query, client, day, requests); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -4,15 +4,25 @@ import (
 	"context"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type RaceRepository interface {
 	CreateRace(ctx context.Context, race *model.Race) error
 	GetRace(ctx context.Context, id uuid.UUID) (*model.Race, error)
 	GetAllRaces(ctx context.Context) ([]*model.Race, error)
+	GetSeasonRaces(ctx context.Context, year int) ([]*model.Race, error)
+	GetSeasonRace(ctx context.Context, year, round int) (*model.Race, error)
+	GetRacesByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Race, error)
+	GetRacesBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.Race, error)
 	UpdateRace(ctx context.Context, race *model.Race) error
 	DeleteRace(ctx context.Context, id uuid.UUID) error
+	RestoreRace(ctx context.Context, id uuid.UUID) error
+	PurgeRace(ctx context.Context, id uuid.UUID) error
+	BulkUpsertRaces(ctx context.Context, races []*model.Race, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type raceRepository struct {
@@ -23,8 +33,15 @@ func NewRaceRepository() RaceRepository {
 }
 
 func (r *raceRepository) CreateRace(ctx context.Context, race *model.Race) error {
-	// TODO: Implement database logic
-	return nil
+	ctx, span := tracing.Start(ctx, "RaceRepository.CreateRace")
+	defer span.End()
+
+	query := `
+		INSERT INTO races (id, season_id, circuit_id, round, name, date, url)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := db.Executor(ctx).Exec(ctx, query, race.ID, race.SeasonID, race.CircuitID, race.Round, race.Name, race.Date, race.URL)
+	return err
 }
 
 func (r *raceRepository) GetRace(ctx context.Context, id uuid.UUID) (*model.Race, error) {
@@ -37,12 +54,154 @@ func (r *raceRepository) GetAllRaces(ctx context.Context) ([]*model.Race, error)
 	return nil, nil
 }
 
+// GetSeasonRaces returns the races of the season held in year, by round.
+func (r *raceRepository) GetSeasonRaces(ctx context.Context, year int) ([]*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceRepository.GetSeasonRaces")
+	defer span.End()
+
+	query := `
+		SELECT ` + raceColumns + `
+		FROM races ra
+		INNER JOIN seasons s ON ra.season_id = s.id
+		WHERE s.year = $1 AND ` + notDeleted(ctx, "ra.deleted_at") + `
+		ORDER BY ra.round
+	`
+	return queryAll(ctx, scanRace, query, year)
+}
+
+// GetRacesByIDs returns the races with the given IDs, in no particular
+// order. IDs that match no race are left out.
+func (r *raceRepository) GetRacesByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceRepository.GetRacesByIDs")
+	defer span.End()
+
+	query := `SELECT ` + raceColumns + ` FROM races ra WHERE ra.id = ANY($1) AND ` + notDeleted(ctx, "ra.deleted_at")
+	return queryAll(ctx, scanRace, query, ids)
+}
+
+// GetRacesBySeasonIDs returns the races of the given seasons, by season and
+// round.
+func (r *raceRepository) GetRacesBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceRepository.GetRacesBySeasonIDs")
+	defer span.End()
+
+	query := `
+		SELECT ` + raceColumns + `
+		FROM races ra
+		WHERE ra.season_id = ANY($1) AND ` + notDeleted(ctx, "ra.deleted_at") + `
+		ORDER BY ra.season_id, ra.round
+	`
+	return queryAll(ctx, scanRace, query, seasonIDs)
+}
+
+// GetSeasonRace returns one round of the season held in year, or ErrNotFound.
+func (r *raceRepository) GetSeasonRace(ctx context.Context, year, round int) (*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceRepository.GetSeasonRace")
+	defer span.End()
+
+	query := `
+		SELECT ra.id, ra.season_id, ra.circuit_id, ra.round, ra.name, ra.date, ra.url, ra.deleted_at
+		FROM races ra
+		INNER JOIN seasons s ON ra.season_id = s.id
+		WHERE s.year = $1 AND ra.round = $2 AND ` + notDeleted(ctx, "ra.deleted_at") + `
+	`
+	var race model.Race
+	err := db.Executor(ctx).QueryRow(ctx, query, year, round).Scan(
+		&race.ID,
+		&race.SeasonID,
+		&race.CircuitID,
+		&race.Round,
+		&race.Name,
+		&race.Date,
+		&race.URL,
+		&race.DeletedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &race, nil
+}
+
 func (r *raceRepository) UpdateRace(ctx context.Context, race *model.Race) error {
 	// TODO: Implement database logic
 	return nil
 }
 
 func (r *raceRepository) DeleteRace(ctx context.Context, id uuid.UUID) error {
-	// TODO: Implement database logic
-	return nil
+	ctx, span := tracing.Start(ctx, "RaceRepository.DeleteRace")
+	defer span.End()
+
+	query := `UPDATE races SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
+	return err
+}
+
+func (r *raceRepository) RestoreRace(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "RaceRepository.RestoreRace")
+	defer span.End()
+
+	query := `UPDATE races SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *raceRepository) PurgeRace(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "RaceRepository.PurgeRace")
+	defer span.End()
+
+	query := `DELETE FROM races WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *raceRepository) BulkUpsertRaces(ctx context.Context, races []*model.Race, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "RaceRepository.BulkUpsertRaces")
+	defer span.End()
+
+	return bulkUpsert(ctx, races, mode, upsertRace)
+}
+
+func upsertRace(ctx context.Context, tx pgx.Tx, race *model.Race) (*model.Race, bool, error) {
+	query := `
+		INSERT INTO races (id, season_id, circuit_id, round, name, date, url)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (season_id, round) DO UPDATE
+		SET circuit_id = EXCLUDED.circuit_id, name = EXCLUDED.name, date = EXCLUDED.date, url = EXCLUDED.url
+		WHERE races.deleted_at IS NULL
+		RETURNING id, season_id, circuit_id, round, name, date, url, (xmax = 0) AS inserted
+	`
+	var saved model.Race
+	var inserted bool
+	err := tx.QueryRow(ctx, query, race.ID, race.SeasonID, race.CircuitID, race.Round, race.Name, race.Date, race.URL).Scan(
+		&saved.ID,
+		&saved.SeasonID,
+		&saved.CircuitID,
+		&saved.Round,
+		&saved.Name,
+		&saved.Date,
+		&saved.URL,
+		&inserted,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	return &saved, inserted, nil
+}
+
+const raceColumns = `ra.id, ra.season_id, ra.circuit_id, ra.round, ra.name, ra.date, ra.url, ra.deleted_at`
+
+func scanRace(rows pgx.Rows) (*model.Race, error) {
+	var race model.Race
+	err := rows.Scan(
+		&race.ID,
+		&race.SeasonID,
+		&race.CircuitID,
+		&race.Round,
+		&race.Name,
+		&race.Date,
+		&race.URL,
+		&race.DeletedAt,
+	)
+	return &race, err
 }
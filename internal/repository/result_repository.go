@@ -2,17 +2,30 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
+var ErrDriverResultsOverlap = errors.New("both drivers have results in the same race")
+
 type ResultRepository interface {
 	CreateResult(ctx context.Context, result *model.Result) error
 	GetResult(ctx context.Context, id uuid.UUID) (*model.Result, error)
 	GetAllResults(ctx context.Context) ([]*model.Result, error)
+	GetRaceResults(ctx context.Context, raceID uuid.UUID) ([]*model.Result, error)
+	GetSeasonRaceResults(ctx context.Context, year, round int) ([]*model.Result, error)
+	GetResultsByRaceIDs(ctx context.Context, raceIDs []uuid.UUID) ([]*model.Result, error)
 	UpdateResult(ctx context.Context, result *model.Result) error
 	DeleteResult(ctx context.Context, id uuid.UUID) error
+	RestoreResult(ctx context.Context, id uuid.UUID) error
+	PurgeResult(ctx context.Context, id uuid.UUID) error
+	ReassignDriverResults(ctx context.Context, fromDriverID, toDriverID uuid.UUID) ([]uuid.UUID, error)
+	BulkUpsertResults(ctx context.Context, results []*model.Result, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type resultRepository struct {
@@ -23,8 +36,15 @@ func NewResultRepository() ResultRepository {
 }
 
 func (r *resultRepository) CreateResult(ctx context.Context, result *model.Result) error {
-	// TODO: Implement database logic
-	return nil
+	ctx, span := tracing.Start(ctx, "ResultRepository.CreateResult")
+	defer span.End()
+
+	query := `
+		INSERT INTO results (id, race_id, driver_id, constructor_id, number, grid, position, position_text, points, laps, time, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	_, err := db.Executor(ctx).Exec(ctx, query, result.ID, result.RaceID, result.DriverID, result.ConstructorID, result.Number, result.Grid, result.Position, result.PositionText, result.Points, result.Laps, result.Time, result.Status)
+	return err
 }
 
 func (r *resultRepository) GetResult(ctx context.Context, id uuid.UUID) (*model.Result, error) {
@@ -37,12 +57,204 @@ func (r *resultRepository) GetAllResults(ctx context.Context) ([]*model.Result,
 	return nil, nil
 }
 
+// GetRaceResults returns the results of a race in finishing order, with
+// unclassified drivers last.
+func (r *resultRepository) GetRaceResults(ctx context.Context, raceID uuid.UUID) ([]*model.Result, error) {
+	ctx, span := tracing.Start(ctx, "ResultRepository.GetRaceResults")
+	defer span.End()
+
+	query := `
+		SELECT ` + resultColumns + `
+		FROM results r
+		WHERE r.race_id = $1 AND ` + notDeleted(ctx, "r.deleted_at") + `
+		ORDER BY r.position NULLS LAST, r.grid
+	`
+	return queryResults(ctx, query, raceID)
+}
+
+// GetSeasonRaceResults returns the results of one round of the season held
+// in year, in finishing order.
+func (r *resultRepository) GetSeasonRaceResults(ctx context.Context, year, round int) ([]*model.Result, error) {
+	ctx, span := tracing.Start(ctx, "ResultRepository.GetSeasonRaceResults")
+	defer span.End()
+
+	query := `
+		SELECT ` + resultColumns + `
+		FROM results r
+		INNER JOIN races ra ON r.race_id = ra.id
+		INNER JOIN seasons s ON ra.season_id = s.id
+		WHERE s.year = $1 AND ra.round = $2
+		AND ` + notDeleted(ctx, "r.deleted_at") + ` AND ` + notDeleted(ctx, "ra.deleted_at") + `
+		ORDER BY r.position NULLS LAST, r.grid
+	`
+	return queryResults(ctx, query, year, round)
+}
+
+// GetResultsByRaceIDs returns the results of the given races, by race and
+// finishing order.
+func (r *resultRepository) GetResultsByRaceIDs(ctx context.Context, raceIDs []uuid.UUID) ([]*model.Result, error) {
+	ctx, span := tracing.Start(ctx, "ResultRepository.GetResultsByRaceIDs")
+	defer span.End()
+
+	query := `
+		SELECT ` + resultColumns + `
+		FROM results r
+		WHERE r.race_id = ANY($1) AND ` + notDeleted(ctx, "r.deleted_at") + `
+		ORDER BY r.race_id, r.position NULLS LAST, r.grid
+	`
+	return queryResults(ctx, query, raceIDs)
+}
+
+const resultColumns = `r.id, r.race_id, r.driver_id, r.constructor_id, r.number, r.grid, r.position, r.position_text, r.points, r.laps, r.time, r.status, r.deleted_at`
+
+// queryResults runs a query selecting resultColumns.
+func queryResults(ctx context.Context, query string, args ...any) ([]*model.Result, error) {
+	rows, err := db.Executor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*model.Result
+	for rows.Next() {
+		var result model.Result
+		err := rows.Scan(
+			&result.ID,
+			&result.RaceID,
+			&result.DriverID,
+			&result.ConstructorID,
+			&result.Number,
+			&result.Grid,
+			&result.Position,
+			&result.PositionText,
+			&result.Points,
+			&result.Laps,
+			&result.Time,
+			&result.Status,
+			&result.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &result)
+	}
+	return results, rows.Err()
+}
+
 func (r *resultRepository) UpdateResult(ctx context.Context, result *model.Result) error {
 	// TODO: Implement database logic
 	return nil
 }
 
 func (r *resultRepository) DeleteResult(ctx context.Context, id uuid.UUID) error {
-	// TODO: Implement database logic
-	return nil
+	ctx, span := tracing.Start(ctx, "ResultRepository.DeleteResult")
+	defer span.End()
+
+	query := `UPDATE results SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
+	return err
+}
+
+func (r *resultRepository) RestoreResult(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ResultRepository.RestoreResult")
+	defer span.End()
+
+	query := `UPDATE results SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *resultRepository) PurgeResult(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ResultRepository.PurgeResult")
+	defer span.End()
+
+	query := `DELETE FROM results WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *resultRepository) BulkUpsertResults(ctx context.Context, results []*model.Result, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "ResultRepository.BulkUpsertResults")
+	defer span.End()
+
+	return bulkUpsert(ctx, results, mode, upsertResult)
+}
+
+func upsertResult(ctx context.Context, tx pgx.Tx, result *model.Result) (*model.Result, bool, error) {
+	query := `
+		INSERT INTO results (id, race_id, driver_id, constructor_id, number, grid, position, position_text, points, laps, time, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (race_id, driver_id) DO UPDATE
+		SET constructor_id = EXCLUDED.constructor_id, number = EXCLUDED.number, grid = EXCLUDED.grid, position = EXCLUDED.position,
+			position_text = EXCLUDED.position_text, points = EXCLUDED.points, laps = EXCLUDED.laps, time = EXCLUDED.time, status = EXCLUDED.status
+		WHERE results.deleted_at IS NULL
+		RETURNING id, race_id, driver_id, constructor_id, number, grid, position, position_text, points, laps, time, status, (xmax = 0) AS inserted
+	`
+	var saved model.Result
+	var inserted bool
+	err := tx.QueryRow(ctx, query, result.ID, result.RaceID, result.DriverID, result.ConstructorID, result.Number, result.Grid, result.Position, result.PositionText, result.Points, result.Laps, result.Time, result.Status).Scan(
+		&saved.ID,
+		&saved.RaceID,
+		&saved.DriverID,
+		&saved.ConstructorID,
+		&saved.Number,
+		&saved.Grid,
+		&saved.Position,
+		&saved.PositionText,
+		&saved.Points,
+		&saved.Laps,
+		&saved.Time,
+		&saved.Status,
+		&inserted,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	return &saved, inserted, nil
+}
+
+// ReassignDriverResults moves every result of one driver to another and
+// returns the seasons the moved results belong to.
+func (r *resultRepository) ReassignDriverResults(ctx context.Context, fromDriverID, toDriverID uuid.UUID) ([]uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "ResultRepository.ReassignDriverResults")
+	defer span.End()
+
+	var overlap bool
+	err := db.Executor(ctx).QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM results a
+			JOIN results b ON a.race_id = b.race_id
+			WHERE a.driver_id = $1 AND b.driver_id = $2
+		)
+	`, fromDriverID, toDriverID).Scan(&overlap)
+	if err != nil {
+		return nil, err
+	}
+	if overlap {
+		return nil, ErrDriverResultsOverlap
+	}
+
+	query := `
+		WITH moved AS (
+			UPDATE results SET driver_id = $2
+			WHERE driver_id = $1
+			RETURNING race_id
+		)
+		SELECT DISTINCT ra.season_id
+		FROM moved m
+		INNER JOIN races ra ON ra.id = m.race_id
+	`
+	rows, err := db.Executor(ctx).Query(ctx, query, fromDriverID, toDriverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seasonIDs []uuid.UUID
+	for rows.Next() {
+		var seasonID uuid.UUID
+		if err := rows.Scan(&seasonID); err != nil {
+			return nil, err
+		}
+		seasonIDs = append(seasonIDs, seasonID)
+	}
+	return seasonIDs, rows.Err()
 }
@@ -4,15 +4,23 @@ import (
 	"context"
 
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type SeasonRepository interface {
 	CreateSeason(ctx context.Context, season *model.Season) error
 	GetSeason(ctx context.Context, id uuid.UUID) (*model.Season, error)
+	GetSeasonsByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Season, error)
 	GetAllSeasons(ctx context.Context) ([]*model.Season, error)
+	ResolveSeasonYear(ctx context.Context, year int) (uuid.UUID, error)
 	UpdateSeason(ctx context.Context, season *model.Season) error
 	DeleteSeason(ctx context.Context, id uuid.UUID) error
+	RestoreSeason(ctx context.Context, id uuid.UUID) error
+	PurgeSeason(ctx context.Context, id uuid.UUID) error
+	BulkUpsertSeasons(ctx context.Context, seasons []*model.Season, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type seasonRepository struct {
@@ -32,17 +40,106 @@ func (r *seasonRepository) GetSeason(ctx context.Context, id uuid.UUID) (*model.
 	return nil, nil
 }
 
+// GetSeasonsByIDs returns the seasons with the given IDs, in no particular
+// order. IDs that match no season are left out.
+func (r *seasonRepository) GetSeasonsByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Season, error) {
+	ctx, span := tracing.Start(ctx, "SeasonRepository.GetSeasonsByIDs")
+	defer span.End()
+
+	query := `SELECT id, year, url, deleted_at FROM seasons WHERE id = ANY($1) AND ` + notDeleted(ctx, "deleted_at")
+	return queryAll(ctx, scanSeason, query, ids)
+}
+
 func (r *seasonRepository) GetAllSeasons(ctx context.Context) ([]*model.Season, error) {
 	// TODO: Implement database logic
 	return nil, nil
 }
 
+// ResolveSeasonYear returns the ID of the season held in year, or
+// ErrNotFound. Deleted seasons are found too, so that they can be restored by
+// year.
+func (r *seasonRepository) ResolveSeasonYear(ctx context.Context, year int) (uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "SeasonRepository.ResolveSeasonYear")
+	defer span.End()
+
+	var id uuid.UUID
+	err := db.Executor(ctx).QueryRow(ctx, "SELECT id FROM seasons WHERE year = $1", year).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.UUID{}, ErrNotFound
+		}
+		return uuid.UUID{}, err
+	}
+	return id, nil
+}
+
 func (r *seasonRepository) UpdateSeason(ctx context.Context, season *model.Season) error {
 	// TODO: Implement database logic
 	return nil
 }
 
 func (r *seasonRepository) DeleteSeason(ctx context.Context, id uuid.UUID) error {
-	// TODO: Implement database logic
-	return nil
+	ctx, span := tracing.Start(ctx, "SeasonRepository.DeleteSeason")
+	defer span.End()
+
+	query := `UPDATE seasons SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
+	return err
+}
+
+func (r *seasonRepository) RestoreSeason(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "SeasonRepository.RestoreSeason")
+	defer span.End()
+
+	query := `UPDATE seasons SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *seasonRepository) PurgeSeason(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "SeasonRepository.PurgeSeason")
+	defer span.End()
+
+	query := `DELETE FROM seasons WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *seasonRepository) BulkUpsertSeasons(ctx context.Context, seasons []*model.Season, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "SeasonRepository.BulkUpsertSeasons")
+	defer span.End()
+
+	return bulkUpsert(ctx, seasons, mode, upsertSeason)
+}
+
+func upsertSeason(ctx context.Context, tx pgx.Tx, season *model.Season) (*model.Season, bool, error) {
+	query := `
+		INSERT INTO seasons (id, year, url)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (year) DO UPDATE
+		SET url = EXCLUDED.url
+		WHERE seasons.deleted_at IS NULL
+		RETURNING id, year, url, (xmax = 0) AS inserted
+	`
+	var saved model.Season
+	var inserted bool
+	err := tx.QueryRow(ctx, query, season.ID, season.Year, season.URL).Scan(
+		&saved.ID,
+		&saved.Year,
+		&saved.URL,
+		&inserted,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	return &saved, inserted, nil
+}
+
+func scanSeason(rows pgx.Rows) (*model.Season, error) {
+	var season model.Season
+	err := rows.Scan(
+		&season.ID,
+		&season.Year,
+		&season.URL,
+		&season.DeletedAt,
+	)
+	return &season, err
 }
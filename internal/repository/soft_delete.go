@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	ErrNotFound = errors.New("record not found")
+	ErrInUse    = errors.New("record is still referenced by other records")
+)
+
+// foreignKeyViolation is the Postgres SQLSTATE raised when a delete would
+// leave dangling references.
+const foreignKeyViolation = "23503"
+
+type includeDeletedKey struct{}
+
+// WithDeleted returns a context under which repository reads also return
+// soft-deleted rows.
+func WithDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// notDeleted returns the SQL condition that hides soft-deleted rows for the
+// given deleted_at column, or TRUE when ctx asks for deleted rows as well.
+func notDeleted(ctx context.Context, column string) string {
+	if include, _ := ctx.Value(includeDeletedKey{}).(bool); include {
+		return "TRUE"
+	}
+	return column + " IS NULL"
+}
+
+// execAffectingOne runs a restore or purge statement and reports ErrNotFound
+// when no soft-deleted row matched, or ErrInUse when a purge is blocked by a
+// foreign key.
+func execAffectingOne(ctx context.Context, query string, id uuid.UUID) error {
+	tag, err := db.Executor(ctx).Exec(ctx, query, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ErrInUse
+		}
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
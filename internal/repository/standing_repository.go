@@ -3,22 +3,39 @@ package repository
 import (
 	"context"
 
+	"github.com/ChinmayNoob/f1/internal/metrics"
 	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type StandingRepository interface {
 	CreateDriverStanding(ctx context.Context, standing *model.DriverStanding) error
 	GetDriverStanding(ctx context.Context, id uuid.UUID) (*model.DriverStanding, error)
 	GetAllDriverStandings(ctx context.Context) ([]*model.DriverStanding, error)
+	GetSeasonDriverStandings(ctx context.Context, year int) ([]*model.DriverStanding, error)
+	GetDriverStandingsBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.DriverStanding, error)
 	UpdateDriverStanding(ctx context.Context, standing *model.DriverStanding) error
 	DeleteDriverStanding(ctx context.Context, id uuid.UUID) error
+	RestoreDriverStanding(ctx context.Context, id uuid.UUID) error
+	PurgeDriverStanding(ctx context.Context, id uuid.UUID) error
+	BulkUpsertDriverStandings(ctx context.Context, standings []*model.DriverStanding, mode model.BulkMode) (model.BulkResult, error)
 
 	CreateConstructorStanding(ctx context.Context, standing *model.ConstructorStanding) error
 	GetConstructorStanding(ctx context.Context, id uuid.UUID) (*model.ConstructorStanding, error)
 	GetAllConstructorStandings(ctx context.Context) ([]*model.ConstructorStanding, error)
+	GetSeasonConstructorStandings(ctx context.Context, year int) ([]*model.ConstructorStanding, error)
+	GetConstructorStandingsBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.ConstructorStanding, error)
 	UpdateConstructorStanding(ctx context.Context, standing *model.ConstructorStanding) error
 	DeleteConstructorStanding(ctx context.Context, id uuid.UUID) error
+	RestoreConstructorStanding(ctx context.Context, id uuid.UUID) error
+	PurgeConstructorStanding(ctx context.Context, id uuid.UUID) error
+	BulkUpsertConstructorStandings(ctx context.Context, standings []*model.ConstructorStanding, mode model.BulkMode) (model.BulkResult, error)
+
+	DeleteDriverStandingsByDriver(ctx context.Context, driverID uuid.UUID) error
+	RecomputeSeasonStandings(ctx context.Context, seasonID uuid.UUID) error
 }
 
 type standingRepository struct {
@@ -43,14 +60,65 @@ func (r *standingRepository) GetAllDriverStandings(ctx context.Context) ([]*mode
 	return nil, nil
 }
 
+// GetSeasonDriverStandings returns the driver standings of the season held
+// in year, by position.
+func (r *standingRepository) GetSeasonDriverStandings(ctx context.Context, year int) ([]*model.DriverStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingRepository.GetSeasonDriverStandings")
+	defer span.End()
+
+	query := `
+		SELECT ` + driverStandingColumns + `
+		FROM driver_standings ds
+		INNER JOIN seasons s ON ds.season_id = s.id
+		WHERE s.year = $1 AND ` + notDeleted(ctx, "ds.deleted_at") + `
+		ORDER BY ds.position
+	`
+	return queryAll(ctx, scanDriverStanding, query, year)
+}
+
+// GetDriverStandingsBySeasonIDs returns the driver standings of the given
+// seasons, by season and position.
+func (r *standingRepository) GetDriverStandingsBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.DriverStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingRepository.GetDriverStandingsBySeasonIDs")
+	defer span.End()
+
+	query := `
+		SELECT ` + driverStandingColumns + `
+		FROM driver_standings ds
+		WHERE ds.season_id = ANY($1) AND ` + notDeleted(ctx, "ds.deleted_at") + `
+		ORDER BY ds.season_id, ds.position
+	`
+	return queryAll(ctx, scanDriverStanding, query, seasonIDs)
+}
+
 func (r *standingRepository) UpdateDriverStanding(ctx context.Context, standing *model.DriverStanding) error {
 	// TODO: Implement database logic
 	return nil
 }
 
 func (r *standingRepository) DeleteDriverStanding(ctx context.Context, id uuid.UUID) error {
-	// TODO: Implement database logic
-	return nil
+	ctx, span := tracing.Start(ctx, "StandingRepository.DeleteDriverStanding")
+	defer span.End()
+
+	query := `UPDATE driver_standings SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
+	return err
+}
+
+func (r *standingRepository) RestoreDriverStanding(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingRepository.RestoreDriverStanding")
+	defer span.End()
+
+	query := `UPDATE driver_standings SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *standingRepository) PurgeDriverStanding(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingRepository.PurgeDriverStanding")
+	defer span.End()
+
+	query := `DELETE FROM driver_standings WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
 }
 
 func (r *standingRepository) CreateConstructorStanding(ctx context.Context, standing *model.ConstructorStanding) error {
@@ -68,12 +136,236 @@ func (r *standingRepository) GetAllConstructorStandings(ctx context.Context) ([]
 	return nil, nil
 }
 
+// GetSeasonConstructorStandings returns the constructor standings of the
+// season held in year, by position.
+func (r *standingRepository) GetSeasonConstructorStandings(ctx context.Context, year int) ([]*model.ConstructorStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingRepository.GetSeasonConstructorStandings")
+	defer span.End()
+
+	query := `
+		SELECT ` + constructorStandingColumns + `
+		FROM constructor_standings cs
+		INNER JOIN seasons s ON cs.season_id = s.id
+		WHERE s.year = $1 AND ` + notDeleted(ctx, "cs.deleted_at") + `
+		ORDER BY cs.position
+	`
+	return queryAll(ctx, scanConstructorStanding, query, year)
+}
+
+// GetConstructorStandingsBySeasonIDs returns the constructor standings of
+// the given seasons, by season and position.
+func (r *standingRepository) GetConstructorStandingsBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.ConstructorStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingRepository.GetConstructorStandingsBySeasonIDs")
+	defer span.End()
+
+	query := `
+		SELECT ` + constructorStandingColumns + `
+		FROM constructor_standings cs
+		WHERE cs.season_id = ANY($1) AND ` + notDeleted(ctx, "cs.deleted_at") + `
+		ORDER BY cs.season_id, cs.position
+	`
+	return queryAll(ctx, scanConstructorStanding, query, seasonIDs)
+}
+
 func (r *standingRepository) UpdateConstructorStanding(ctx context.Context, standing *model.ConstructorStanding) error {
 	// TODO: Implement database logic
 	return nil
 }
 
 func (r *standingRepository) DeleteConstructorStanding(ctx context.Context, id uuid.UUID) error {
-	// TODO: Implement database logic
-	return nil
+	ctx, span := tracing.Start(ctx, "StandingRepository.DeleteConstructorStanding")
+	defer span.End()
+
+	query := `UPDATE constructor_standings SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+	_, err := db.Executor(ctx).Exec(ctx, query, id)
+	return err
+}
+
+func (r *standingRepository) RestoreConstructorStanding(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingRepository.RestoreConstructorStanding")
+	defer span.End()
+
+	query := `UPDATE constructor_standings SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *standingRepository) PurgeConstructorStanding(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingRepository.PurgeConstructorStanding")
+	defer span.End()
+
+	query := `DELETE FROM constructor_standings WHERE id = $1 AND deleted_at IS NOT NULL`
+	return execAffectingOne(ctx, query, id)
+}
+
+func (r *standingRepository) BulkUpsertDriverStandings(ctx context.Context, standings []*model.DriverStanding, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "StandingRepository.BulkUpsertDriverStandings")
+	defer span.End()
+
+	return bulkUpsert(ctx, standings, mode, upsertDriverStanding)
+}
+
+func (r *standingRepository) BulkUpsertConstructorStandings(ctx context.Context, standings []*model.ConstructorStanding, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "StandingRepository.BulkUpsertConstructorStandings")
+	defer span.End()
+
+	return bulkUpsert(ctx, standings, mode, upsertConstructorStanding)
+}
+
+func upsertDriverStanding(ctx context.Context, tx pgx.Tx, standing *model.DriverStanding) (*model.DriverStanding, bool, error) {
+	query := `
+		INSERT INTO driver_standings (id, season_id, driver_id, position, points, wins)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (season_id, driver_id) DO UPDATE
+		SET position = EXCLUDED.position, points = EXCLUDED.points, wins = EXCLUDED.wins
+		WHERE driver_standings.deleted_at IS NULL
+		RETURNING id, season_id, driver_id, position, points, wins, (xmax = 0) AS inserted
+	`
+	var saved model.DriverStanding
+	var inserted bool
+	err := tx.QueryRow(ctx, query, standing.ID, standing.SeasonID, standing.DriverID, standing.Position, standing.Points, standing.Wins).Scan(
+		&saved.ID,
+		&saved.SeasonID,
+		&saved.DriverID,
+		&saved.Position,
+		&saved.Points,
+		&saved.Wins,
+		&inserted,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	return &saved, inserted, nil
+}
+
+func upsertConstructorStanding(ctx context.Context, tx pgx.Tx, standing *model.ConstructorStanding) (*model.ConstructorStanding, bool, error) {
+	query := `
+		INSERT INTO constructor_standings (id, season_id, constructor_id, position, points, wins)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (season_id, constructor_id) DO UPDATE
+		SET position = EXCLUDED.position, points = EXCLUDED.points, wins = EXCLUDED.wins
+		WHERE constructor_standings.deleted_at IS NULL
+		RETURNING id, season_id, constructor_id, position, points, wins, (xmax = 0) AS inserted
+	`
+	var saved model.ConstructorStanding
+	var inserted bool
+	err := tx.QueryRow(ctx, query, standing.ID, standing.SeasonID, standing.ConstructorID, standing.Position, standing.Points, standing.Wins).Scan(
+		&saved.ID,
+		&saved.SeasonID,
+		&saved.ConstructorID,
+		&saved.Position,
+		&saved.Points,
+		&saved.Wins,
+		&inserted,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	return &saved, inserted, nil
+}
+
+func (r *standingRepository) DeleteDriverStandingsByDriver(ctx context.Context, driverID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingRepository.DeleteDriverStandingsByDriver")
+	defer span.End()
+
+	query := `DELETE FROM driver_standings WHERE driver_id = $1`
+	_, err := db.Executor(ctx).Exec(ctx, query, driverID)
+	return err
+}
+
+// RecomputeSeasonStandings rebuilds the driver and constructor standings of a
+// season from its race results.
+func (r *standingRepository) RecomputeSeasonStandings(ctx context.Context, seasonID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingRepository.RecomputeSeasonStandings")
+	defer span.End()
+
+	queries := []string{
+		`
+		DELETE FROM driver_standings ds
+		WHERE ds.season_id = $1 AND NOT EXISTS (
+			SELECT 1 FROM results res
+			INNER JOIN races ra ON ra.id = res.race_id
+			WHERE ra.season_id = ds.season_id AND res.driver_id = ds.driver_id
+				AND ra.deleted_at IS NULL AND res.deleted_at IS NULL
+		)
+		`,
+		`
+		INSERT INTO driver_standings (id, season_id, driver_id, position, points, wins)
+		SELECT gen_random_uuid(), $1, t.driver_id, RANK() OVER (ORDER BY t.points DESC, t.wins DESC), t.points, t.wins
+		FROM (
+			SELECT res.driver_id, SUM(res.points) AS points, COUNT(*) FILTER (WHERE res.position = 1) AS wins
+			FROM results res
+			INNER JOIN races ra ON ra.id = res.race_id
+			WHERE ra.season_id = $1 AND ra.deleted_at IS NULL AND res.deleted_at IS NULL
+			GROUP BY res.driver_id
+		) t
+		ON CONFLICT (season_id, driver_id) DO UPDATE
+		SET position = EXCLUDED.position, points = EXCLUDED.points, wins = EXCLUDED.wins, deleted_at = NULL
+		`,
+		`
+		DELETE FROM constructor_standings cs
+		WHERE cs.season_id = $1 AND NOT EXISTS (
+			SELECT 1 FROM results res
+			INNER JOIN races ra ON ra.id = res.race_id
+			WHERE ra.season_id = cs.season_id AND res.constructor_id = cs.constructor_id
+				AND ra.deleted_at IS NULL AND res.deleted_at IS NULL
+		)
+		`,
+		`
+		INSERT INTO constructor_standings (id, season_id, constructor_id, position, points, wins)
+		SELECT gen_random_uuid(), $1, t.constructor_id, RANK() OVER (ORDER BY t.points DESC, t.wins DESC), t.points, t.wins
+		FROM (
+			SELECT res.constructor_id, SUM(res.points) AS points, COUNT(*) FILTER (WHERE res.position = 1) AS wins
+			FROM results res
+			INNER JOIN races ra ON ra.id = res.race_id
+			WHERE ra.season_id = $1 AND ra.deleted_at IS NULL AND res.deleted_at IS NULL
+			GROUP BY res.constructor_id
+		) t
+		ON CONFLICT (season_id, constructor_id) DO UPDATE
+		SET position = EXCLUDED.position, points = EXCLUDED.points, wins = EXCLUDED.wins, deleted_at = NULL
+		`,
+	}
+
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		for _, query := range queries {
+			if _, err := db.Executor(ctx).Exec(ctx, query, seasonID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	metrics.ObserveStandingsRecompute(err)
+	return err
+}
+
+const (
+	driverStandingColumns      = `ds.id, ds.season_id, ds.driver_id, ds.position, ds.points, ds.wins, ds.deleted_at`
+	constructorStandingColumns = `cs.id, cs.season_id, cs.constructor_id, cs.position, cs.points, cs.wins, cs.deleted_at`
+)
+
+func scanDriverStanding(rows pgx.Rows) (*model.DriverStanding, error) {
+	var standing model.DriverStanding
+	err := rows.Scan(
+		&standing.ID,
+		&standing.SeasonID,
+		&standing.DriverID,
+		&standing.Position,
+		&standing.Points,
+		&standing.Wins,
+		&standing.DeletedAt,
+	)
+	return &standing, err
+}
+
+func scanConstructorStanding(rows pgx.Rows) (*model.ConstructorStanding, error) {
+	var standing model.ConstructorStanding
+	err := rows.Scan(
+		&standing.ID,
+		&standing.SeasonID,
+		&standing.ConstructorID,
+		&standing.Position,
+		&standing.Points,
+		&standing.Wins,
+		&standing.DeletedAt,
+	)
+	return &standing, err
 }
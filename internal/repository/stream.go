@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/ChinmayNoob/f1/pkg/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// streamRows runs query and yields its rows, scanned by scan, as they are
+// read, so that only one row is held at a time. The rows are closed when the
+// caller stops early, and the query is cancelled with ctx, as when the client
+// disconnects.
+func streamRows[T any](ctx context.Context, scan func(pgx.Rows) (T, error), query string, args ...any) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		rows, err := db.Executor(ctx).Query(ctx, query, args...)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			v, err := scan(rows)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// queryAll runs query and returns every row, scanned by scan.
+func queryAll[T any](ctx context.Context, scan func(pgx.Rows) (T, error), query string, args ...any) ([]T, error) {
+	var all []T
+	for v, err := range streamRows(ctx, scan, query, args...) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, v)
+	}
+	return all, nil
+}
+
+// conditions collects the WHERE conditions of a filtered list with their
+// arguments.
+type conditions struct {
+	where []string
+	args  []any
+}
+
+// equal adds column = value when value is set: a non-empty string or a
+// non-nil pointer.
+func (c *conditions) equal(column string, value any) {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return
+		}
+	case *int:
+		if v == nil {
+			return
+		}
+		value = *v
+	case *bool:
+		if v == nil {
+			return
+		}
+		value = *v
+	}
+	c.args = append(c.args, value)
+	c.where = append(c.where, column+" = $"+strconv.Itoa(len(c.args)))
+}
+
+func (c *conditions) sql() string {
+	if len(c.where) == 0 {
+		return "TRUE"
+	}
+	return strings.Join(c.where, " AND ")
+}
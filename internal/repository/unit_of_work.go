@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/requestctx"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
+)
+
+// UnitOfWork groups repository calls into a single transaction. Repository
+// methods called with the context passed to fn join that transaction, so they
+// commit or roll back together. The actor and request ID of ctx are attached
+// to the transaction so the audit log can attribute its changes.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type unitOfWork struct{}
+
+func NewUnitOfWork() UnitOfWork {
+	return &unitOfWork{}
+}
+
+func (u *unitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := tracing.Start(ctx, "UnitOfWork.Do")
+	defer span.End()
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		query := `SELECT set_config('f1.actor', $1, true), set_config('f1.request_id', $2, true)`
+		if _, err := db.Executor(ctx).Exec(ctx, query, requestctx.Actor(ctx), requestctx.RequestID(ctx)); err != nil {
+			return err
+		}
+		return fn(ctx)
+	})
+}
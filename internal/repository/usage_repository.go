@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/ChinmayNoob/f1/pkg/db"
+)
+
+type UsageRepository interface {
+	AddUsage(ctx context.Context, counters []model.UsageCounter) error
+	// GetUsage returns the hourly counters in [from, to). Empty client or
+	// route match everything.
+	GetUsage(ctx context.Context, from, to time.Time, client, route string) ([]model.UsageCounter, error)
+}
+
+type usageRepository struct{}
+
+func NewUsageRepository() UsageRepository {
+	return &usageRepository{}
+}
+
+func (r *usageRepository) AddUsage(ctx context.Context, counters []model.UsageCounter) error {
+	ctx, span := tracing.Start(ctx, "UsageRepository.AddUsage")
+	defer span.End()
+
+	query := `
+		INSERT INTO api_usage_hourly (hour, client, route, status, requests, total_duration_ms, latency_buckets)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (hour, client, route, status) DO UPDATE
+		SET requests = api_usage_hourly.requests + EXCLUDED.requests,
+			total_duration_ms = api_usage_hourly.total_duration_ms + EXCLUDED.total_duration_ms,
+			latency_buckets = ARRAY(
+				SELECT a + b
+				FROM unnest(api_usage_hourly.latency_buckets, EXCLUDED.latency_buckets) WITH ORDINALITY AS t(a, b, i)
+				ORDER BY i
+			)
+	`
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		for _, c := range counters {
+			_, err := db.Executor(ctx).Exec(ctx, query, c.Hour, c.Client, c.Route, c.Status, c.Requests, c.TotalDurationMs, c.LatencyBuckets)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *usageRepository) GetUsage(ctx context.Context, from, to time.Time, client, route string) ([]model.UsageCounter, error) {
+	ctx, span := tracing.Start(ctx, "UsageRepository.GetUsage")
+	defer span.End()
+
+	query := `
+		SELECT hour, client, route, status, requests, total_duration_ms, latency_buckets
+		FROM api_usage_hourly
+		WHERE hour >= $1 AND hour < $2
+			AND ($3 = '' OR client = $3)
+			AND ($4 = '' OR route = $4)
+		ORDER BY hour, client, route, status
+	`
+	rows, err := db.Executor(ctx).Query(ctx, query, from, to, client, route)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counters []model.UsageCounter
+	for rows.Next() {
+		var c model.UsageCounter
+		if err := rows.Scan(&c.Hour, &c.Client, &c.Route, &c.Status, &c.Requests, &c.TotalDurationMs, &c.LatencyBuckets); err != nil {
+			return nil, err
+		}
+		counters = append(counters, c)
+	}
+	return counters, rows.Err()
+}
@@ -0,0 +1,46 @@
+package requestctx
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+)
+
+type actorKey struct{}
+type requestIDKey struct{}
+type principalKey struct{}
+
+// WithActor records who is making the request.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor returns the actor stored by WithActor, or "" when there is none.
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// WithRequestID records the ID used to correlate everything done for a
+// request.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the ID stored by WithRequestID, or "" when there is none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// WithPrincipal records the authenticated caller of the request.
+func WithPrincipal(ctx context.Context, principal model.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// Principal returns the caller stored by WithPrincipal. ok is false when the
+// request has not been authenticated.
+func Principal(ctx context.Context) (principal model.Principal, ok bool) {
+	principal, ok = ctx.Value(principalKey{}).(model.Principal)
+	return principal, ok
+}
@@ -0,0 +1,15 @@
+package router
+
+import "net/http"
+
+// Middleware wraps a handler with behaviour that runs around it.
+type Middleware func(http.Handler) http.Handler
+
+// Chain wraps h in middlewares. The first middleware is the outermost, so it
+// sees the request first and the response last.
+func Chain(h http.Handler, middlewares ...Middleware) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
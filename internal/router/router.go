@@ -2,10 +2,19 @@ package router
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/ChinmayNoob/f1/internal/handler"
+	"github.com/ChinmayNoob/f1/internal/middleware"
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/openapi"
 )
 
+// APIPrefix is the path prefix of the current API version.
+const APIPrefix = "/v1"
+
+// SetupRoutes registers the API routes on mux and returns them, for the
+// OpenAPI document.
 func SetupRoutes(
 	mux *http.ServeMux,
 	constructorHandler *handler.ConstructorHandler,
@@ -15,196 +24,170 @@ func SetupRoutes(
 	raceHandler *handler.RaceHandler,
 	resultHandler *handler.ResultHandler,
 	standingHandler *handler.StandingHandler,
-) {
-	mux.HandleFunc("/constructors", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			constructorHandler.GetConstructor(w, r)
-		case http.MethodPost:
-			constructorHandler.CreateConstructor(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/constructors/", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			constructorHandler.GetConstructorByID(w, r)
-		case http.MethodPut:
-			constructorHandler.UpdateConstructor(w, r)
-		case http.MethodDelete:
-			constructorHandler.DeleteConstructor(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/drivers", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			driverHandler.GetDriver(w, r)
-		case http.MethodPost:
-			driverHandler.CreateDriver(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/drivers/", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			driverHandler.GetDriverByID(w, r)
-		case http.MethodPut:
-			driverHandler.UpdateDriver(w, r)
-		case http.MethodDelete:
-			driverHandler.DeleteDriver(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/circuits", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			circuitHandler.GetCircuit(w, r)
-		case http.MethodPost:
-			circuitHandler.CreateCircuit(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/circuits/", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			circuitHandler.GetCircuitByID(w, r)
-		case http.MethodPut:
-			circuitHandler.UpdateCircuit(w, r)
-		case http.MethodDelete:
-			circuitHandler.DeleteCircuit(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/seasons", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			seasonHandler.GetSeason(w, r)
-		case http.MethodPost:
-			seasonHandler.CreateSeason(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/seasons/", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			seasonHandler.GetSeasonByID(w, r)
-		case http.MethodPut:
-			seasonHandler.UpdateSeason(w, r)
-		case http.MethodDelete:
-			seasonHandler.DeleteSeason(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/races", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			raceHandler.GetRace(w, r)
-		case http.MethodPost:
-			raceHandler.CreateRace(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/races/", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			raceHandler.GetRaceByID(w, r)
-		case http.MethodPut:
-			raceHandler.UpdateRace(w, r)
-		case http.MethodDelete:
-			raceHandler.DeleteRace(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/results", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			resultHandler.GetResult(w, r)
-		case http.MethodPost:
-			resultHandler.CreateResult(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/results/", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			resultHandler.GetResultByID(w, r)
-		case http.MethodPut:
-			resultHandler.UpdateResult(w, r)
-		case http.MethodDelete:
-			resultHandler.DeleteResult(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/driver-standings", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			standingHandler.GetDriverStanding(w, r)
-		case http.MethodPost:
-			standingHandler.CreateDriverStanding(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/driver-standings/", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			standingHandler.GetDriverStandingByID(w, r)
-		case http.MethodPut:
-			standingHandler.UpdateDriverStanding(w, r)
-		case http.MethodDelete:
-			standingHandler.DeleteDriverStanding(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/constructor-standings", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			standingHandler.GetConstructorStanding(w, r)
-		case http.MethodPost:
-			standingHandler.CreateConstructorStanding(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
-
-	mux.HandleFunc("/constructor-standings/", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodGet:
-			standingHandler.GetConstructorStandingByID(w, r)
-		case http.MethodPut:
-			standingHandler.UpdateConstructorStanding(w, r)
-		case http.MethodDelete:
-			standingHandler.DeleteConstructorStanding(w, r)
-		default:
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		}
-	})
+	auditHandler *handler.AuditHandler,
+	usageHandler *handler.UsageHandler,
+	queryStatsHandler *handler.QueryStatsHandler,
+	metricsHandler http.Handler,
+	batchHandler *handler.BatchHandler,
+	graphqlHandler *handler.GraphQLHandler,
+) []openapi.Route {
+	// Every route is a "METHOD /path" pattern with the scope it needs, served
+	// under APIPrefix. The mux answers 405 with an Allow header for other
+	// methods. Routes that predate APIPrefix are also served at their old
+	// unversioned path, marked deprecated; routes added since use v1.
+	var routes []openapi.Route
+	scopes := make(map[string]model.Scope)
+	v1 := func(pattern string, scope model.Scope, h http.HandlerFunc) {
+		method, path, _ := strings.Cut(pattern, " ")
+		mux.Handle(method+" "+APIPrefix+path, middleware.RequireScope(scope)(middleware.Negotiate(h)))
+		routes = append(routes, openapi.Route{Pattern: method + " " + APIPrefix + path, Scope: scope})
+		scopes[method+" "+APIPrefix+path] = scope
+	}
+	route := func(pattern string, scope model.Scope, h http.HandlerFunc) {
+		v1(pattern, scope, h)
+		mux.Handle(pattern, middleware.Deprecated(APIPrefix)(middleware.RequireScope(scope)(middleware.Negotiate(h))))
+		routes = append(routes, openapi.Route{Pattern: pattern, Scope: scope, Deprecated: true})
+		scopes[pattern] = scope
+	}
+
+	// Constructors, drivers and circuits can be addressed by ref as well as
+	// by ID, e.g. /v1/drivers/hamilton, and seasons by year.
+	constructorRef := constructorHandler.ResolveRef
+	driverRef := driverHandler.ResolveRef
+	circuitRef := circuitHandler.ResolveRef
+	seasonRef := seasonHandler.ResolveRef
+
+	route("GET /constructors", model.ScopeRead, constructorHandler.GetConstructor)
+	route("POST /constructors", model.ScopeWrite, constructorHandler.CreateConstructor)
+	route("POST /constructors/bulk", model.ScopeWrite, constructorHandler.BulkUpsertConstructors)
+	route("GET /constructors/{id}", model.ScopeRead, handler.ByRef(constructorRef, constructorHandler.GetConstructorByID))
+	route("PUT /constructors/{id}", model.ScopeWrite, handler.ByRef(constructorRef, constructorHandler.UpdateConstructor))
+	route("DELETE /constructors/{id}", model.ScopeWrite, handler.ByRef(constructorRef, constructorHandler.DeleteConstructor))
+	route("POST /constructors/{id}/restore", model.ScopeAdmin, handler.ByRef(constructorRef, constructorHandler.RestoreConstructor))
+	route("POST /constructors/{id}/purge", model.ScopeAdmin, handler.ByRef(constructorRef, constructorHandler.PurgeConstructor))
+	route("GET /constructors/{id}/history", model.ScopeRead, handler.ByRef(constructorRef, auditHandler.GetHistory("constructors")))
+	route("POST /constructors/{id}/history/{version}/revert", model.ScopeAdmin, handler.ByRef(constructorRef, auditHandler.RevertToVersion("constructors")))
+
+	route("GET /drivers", model.ScopeRead, driverHandler.GetDriver)
+	route("POST /drivers", model.ScopeWrite, driverHandler.CreateDriver)
+	route("POST /drivers/bulk", model.ScopeWrite, driverHandler.BulkUpsertDrivers)
+	route("GET /drivers/{id}", model.ScopeRead, handler.ByRef(driverRef, driverHandler.GetDriverByID))
+	route("PUT /drivers/{id}", model.ScopeWrite, handler.ByRef(driverRef, driverHandler.UpdateDriver))
+	route("DELETE /drivers/{id}", model.ScopeWrite, handler.ByRef(driverRef, driverHandler.DeleteDriver))
+	route("POST /drivers/{id}/merge", model.ScopeWrite, handler.ByRef(driverRef, driverHandler.MergeDriver))
+	route("POST /drivers/{id}/restore", model.ScopeAdmin, handler.ByRef(driverRef, driverHandler.RestoreDriver))
+	route("POST /drivers/{id}/purge", model.ScopeAdmin, handler.ByRef(driverRef, driverHandler.PurgeDriver))
+	route("GET /drivers/{id}/history", model.ScopeRead, handler.ByRef(driverRef, auditHandler.GetHistory("drivers")))
+	route("POST /drivers/{id}/history/{version}/revert", model.ScopeAdmin, handler.ByRef(driverRef, auditHandler.RevertToVersion("drivers")))
+
+	route("GET /circuits", model.ScopeRead, circuitHandler.GetCircuit)
+	route("POST /circuits", model.ScopeWrite, circuitHandler.CreateCircuit)
+	route("POST /circuits/bulk", model.ScopeWrite, circuitHandler.BulkUpsertCircuits)
+	route("GET /circuits/{id}", model.ScopeRead, handler.ByRef(circuitRef, circuitHandler.GetCircuitByID))
+	route("PUT /circuits/{id}", model.ScopeWrite, handler.ByRef(circuitRef, circuitHandler.UpdateCircuit))
+	route("DELETE /circuits/{id}", model.ScopeWrite, handler.ByRef(circuitRef, circuitHandler.DeleteCircuit))
+	route("POST /circuits/{id}/restore", model.ScopeAdmin, handler.ByRef(circuitRef, circuitHandler.RestoreCircuit))
+	route("POST /circuits/{id}/purge", model.ScopeAdmin, handler.ByRef(circuitRef, circuitHandler.PurgeCircuit))
+	route("GET /circuits/{id}/history", model.ScopeRead, handler.ByRef(circuitRef, auditHandler.GetHistory("circuits")))
+	route("POST /circuits/{id}/history/{version}/revert", model.ScopeAdmin, handler.ByRef(circuitRef, auditHandler.RevertToVersion("circuits")))
+
+	route("GET /seasons", model.ScopeRead, seasonHandler.GetSeason)
+	route("POST /seasons", model.ScopeWrite, seasonHandler.CreateSeason)
+	route("POST /seasons/bulk", model.ScopeWrite, seasonHandler.BulkUpsertSeasons)
+	route("GET /seasons/{id}", model.ScopeRead, handler.ByRef(seasonRef, seasonHandler.GetSeasonByID))
+	route("PUT /seasons/{id}", model.ScopeWrite, handler.ByRef(seasonRef, seasonHandler.UpdateSeason))
+	route("DELETE /seasons/{id}", model.ScopeWrite, handler.ByRef(seasonRef, seasonHandler.DeleteSeason))
+	route("POST /seasons/{id}/restore", model.ScopeAdmin, handler.ByRef(seasonRef, seasonHandler.RestoreSeason))
+	route("POST /seasons/{id}/purge", model.ScopeAdmin, handler.ByRef(seasonRef, seasonHandler.PurgeSeason))
+	route("GET /seasons/{id}/history", model.ScopeRead, handler.ByRef(seasonRef, auditHandler.GetHistory("seasons")))
+	route("POST /seasons/{id}/history/{version}/revert", model.ScopeAdmin, handler.ByRef(seasonRef, auditHandler.RevertToVersion("seasons")))
+
+	// Seasons are addressed by year and races by round within them.
+	v1("GET /seasons/{year}/races", model.ScopeRead, raceHandler.GetSeasonRaces)
+	v1("GET /seasons/{year}/races/{round}", model.ScopeRead, raceHandler.GetSeasonRace)
+	v1("GET /seasons/{year}/races/{round}/results", model.ScopeRead, resultHandler.GetSeasonRaceResults)
+	v1("GET /seasons/{year}/driver-standings", model.ScopeRead, standingHandler.GetSeasonDriverStandings)
+	v1("GET /seasons/{year}/constructor-standings", model.ScopeRead, standingHandler.GetSeasonConstructorStandings)
+
+	route("GET /races", model.ScopeRead, raceHandler.GetRace)
+	route("POST /races", model.ScopeWrite, raceHandler.CreateRace)
+	route("POST /races/bulk", model.ScopeWrite, raceHandler.BulkUpsertRaces)
+	route("GET /races/{id}", model.ScopeRead, raceHandler.GetRaceByID)
+	route("PUT /races/{id}", model.ScopeWrite, raceHandler.UpdateRace)
+	route("DELETE /races/{id}", model.ScopeWrite, raceHandler.DeleteRace)
+	route("POST /races/{id}/restore", model.ScopeAdmin, raceHandler.RestoreRace)
+	route("POST /races/{id}/purge", model.ScopeAdmin, raceHandler.PurgeRace)
+	route("GET /races/{id}/history", model.ScopeRead, auditHandler.GetHistory("races"))
+	route("POST /races/{id}/history/{version}/revert", model.ScopeAdmin, auditHandler.RevertToVersion("races"))
+	v1("GET /races/{id}/results", model.ScopeRead, resultHandler.GetRaceResults)
+
+	route("GET /results", model.ScopeRead, resultHandler.GetResult)
+	route("POST /results", model.ScopeWrite, resultHandler.CreateResult)
+	route("POST /results/bulk", model.ScopeWrite, resultHandler.BulkUpsertResults)
+	route("GET /results/{id}", model.ScopeRead, resultHandler.GetResultByID)
+	route("PUT /results/{id}", model.ScopeWrite, resultHandler.UpdateResult)
+	route("DELETE /results/{id}", model.ScopeWrite, resultHandler.DeleteResult)
+	route("POST /results/{id}/restore", model.ScopeAdmin, resultHandler.RestoreResult)
+	route("POST /results/{id}/purge", model.ScopeAdmin, resultHandler.PurgeResult)
+	route("GET /results/{id}/history", model.ScopeRead, auditHandler.GetHistory("results"))
+	route("POST /results/{id}/history/{version}/revert", model.ScopeAdmin, auditHandler.RevertToVersion("results"))
+
+	route("GET /driver-standings", model.ScopeRead, standingHandler.GetDriverStanding)
+	route("POST /driver-standings", model.ScopeWrite, standingHandler.CreateDriverStanding)
+	route("POST /driver-standings/bulk", model.ScopeWrite, standingHandler.BulkUpsertDriverStandings)
+	route("GET /driver-standings/{id}", model.ScopeRead, standingHandler.GetDriverStandingByID)
+	route("PUT /driver-standings/{id}", model.ScopeWrite, standingHandler.UpdateDriverStanding)
+	route("DELETE /driver-standings/{id}", model.ScopeWrite, standingHandler.DeleteDriverStanding)
+	route("POST /driver-standings/{id}/restore", model.ScopeAdmin, standingHandler.RestoreDriverStanding)
+	route("POST /driver-standings/{id}/purge", model.ScopeAdmin, standingHandler.PurgeDriverStanding)
+	route("GET /driver-standings/{id}/history", model.ScopeRead, auditHandler.GetHistory("driver-standings"))
+	route("POST /driver-standings/{id}/history/{version}/revert", model.ScopeAdmin, auditHandler.RevertToVersion("driver-standings"))
+
+	route("GET /constructor-standings", model.ScopeRead, standingHandler.GetConstructorStanding)
+	route("POST /constructor-standings", model.ScopeWrite, standingHandler.CreateConstructorStanding)
+	route("POST /constructor-standings/bulk", model.ScopeWrite, standingHandler.BulkUpsertConstructorStandings)
+	route("GET /constructor-standings/{id}", model.ScopeRead, standingHandler.GetConstructorStandingByID)
+	route("PUT /constructor-standings/{id}", model.ScopeWrite, standingHandler.UpdateConstructorStanding)
+	route("DELETE /constructor-standings/{id}", model.ScopeWrite, standingHandler.DeleteConstructorStanding)
+	route("POST /constructor-standings/{id}/restore", model.ScopeAdmin, standingHandler.RestoreConstructorStanding)
+	route("POST /constructor-standings/{id}/purge", model.ScopeAdmin, standingHandler.PurgeConstructorStanding)
+	route("GET /constructor-standings/{id}/history", model.ScopeRead, auditHandler.GetHistory("constructor-standings"))
+	route("POST /constructor-standings/{id}/history/{version}/revert", model.ScopeAdmin, auditHandler.RevertToVersion("constructor-standings"))
+
+	route("GET /admin/usage", model.ScopeAdmin, usageHandler.GetUsageReport)
+
+	// Optional features pass a nil handler when they are turned off.
+	if queryStatsHandler != nil {
+		route("GET /admin/queries", model.ScopeAdmin, queryStatsHandler.GetQueryStats)
+		route("GET /admin/queries/{id}/explain", model.ScopeAdmin, queryStatsHandler.ExplainQuery)
+	}
+	if metricsHandler != nil {
+		// Scrapers expect /metrics at the root, so it is not versioned.
+		mux.Handle("GET /metrics", middleware.RequireScope(model.ScopeAdmin)(metricsHandler))
+		routes = append(routes, openapi.Route{Pattern: "GET /metrics", Scope: model.ScopeAdmin})
+		scopes["GET /metrics"] = model.ScopeAdmin
+	}
+	if graphqlHandler != nil {
+		// The schema is read-only, so queries need only the read scope.
+		v1("POST /graphql", model.ScopeRead, graphqlHandler.Query)
+	}
+
+	// A batch needs the highest scope of its operations, and each operation is
+	// authorized again on its own route.
+	route("POST /batch", model.ScopeRead, batchHandler.ExecuteBatch(scopes))
+
+	return routes
+}
+
+// Root serves the health probes and the API documentation directly and
+// everything else through api, so they skip authentication, rate limits and
+// usage accounting.
+func Root(api http.Handler, healthHandler *handler.HealthHandler, docsHandler *handler.DocsHandler) http.Handler {
+	root := http.NewServeMux()
+	root.HandleFunc("GET /healthz", healthHandler.Live)
+	root.HandleFunc("GET /readyz", healthHandler.Ready)
+	root.HandleFunc("GET /openapi.json", docsHandler.OpenAPI)
+	root.HandleFunc("GET /docs", docsHandler.Docs)
+	root.Handle("/", api)
+	return root
 }
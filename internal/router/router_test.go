@@ -0,0 +1,104 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/handler"
+	"github.com/ChinmayNoob/f1/internal/openapi"
+)
+
+// allRoutes registers every route, optional features included.
+func allRoutes() []openapi.Route {
+	return SetupRoutes(http.NewServeMux(), nil, nil, nil, nil, nil, nil, nil, nil, nil, &handler.QueryStatsHandler{}, http.NotFoundHandler(), nil, &handler.GraphQLHandler{})
+}
+
+func TestRoutesAreInOpenAPIDocument(t *testing.T) {
+	routes := allRoutes()
+	for _, pattern := range openapi.Uncovered(routes) {
+		t.Errorf("route %q has no OpenAPI description; add it to internal/openapi/operations.go", pattern)
+	}
+
+	doc := openapi.Build(routes)
+	operationIDs := make(map[string]string)
+	for _, route := range routes {
+		if route.Deprecated {
+			continue
+		}
+		method, path, _ := strings.Cut(route.Pattern, " ")
+		op := doc.Paths[path][strings.ToLower(method)]
+		if op == nil {
+			t.Errorf("route %q is missing from the document", route.Pattern)
+			continue
+		}
+		if other, ok := operationIDs[op.OperationID]; ok {
+			t.Errorf("routes %q and %q share the operation ID %q", other, route.Pattern, op.OperationID)
+		}
+		operationIDs[op.OperationID] = route.Pattern
+		for _, param := range op.Parameters {
+			if param.In == "path" && !strings.Contains(path, "{"+param.Name+"}") {
+				t.Errorf("route %q documents unknown path parameter %q", route.Pattern, param.Name)
+			}
+		}
+	}
+}
+
+func TestDeprecatedAliasesHaveASuccessor(t *testing.T) {
+	routes := allRoutes()
+	registered := make(map[string]bool)
+	for _, route := range routes {
+		registered[route.Pattern] = true
+	}
+	for _, route := range routes {
+		if !route.Deprecated {
+			continue
+		}
+		method, path, _ := strings.Cut(route.Pattern, " ")
+		if successor := method + " " + APIPrefix + path; !registered[successor] {
+			t.Errorf("deprecated route %q has no successor %q", route.Pattern, successor)
+		}
+	}
+}
+
+func TestStubRoutesAreMarkedUnimplemented(t *testing.T) {
+	doc := openapi.Build(allRoutes())
+	tests := []struct {
+		pattern       string
+		unimplemented bool
+	}{
+		{"GET /v1/seasons", true},
+		{"POST /v1/seasons", true},
+		{"GET /v1/seasons/{id}", true},
+		{"PUT /v1/seasons/{id}", true},
+		{"GET /v1/races/{id}", true},
+		{"GET /v1/results", true},
+		{"PUT /v1/driver-standings/{id}", true},
+		{"POST /v1/constructor-standings", true},
+		{"POST /v1/races", false},
+		{"DELETE /v1/seasons/{id}", false},
+		{"POST /v1/results/bulk", false},
+		{"GET /v1/seasons/{year}/races", false},
+		{"GET /v1/races/{id}/results", false},
+		{"GET /v1/drivers", false},
+	}
+	for _, tt := range tests {
+		method, path, _ := strings.Cut(tt.pattern, " ")
+		op := doc.Paths[path][strings.ToLower(method)]
+		if op == nil {
+			t.Errorf("route %q is missing from the document", tt.pattern)
+			continue
+		}
+		if op.Unimplemented != tt.unimplemented {
+			t.Errorf("route %q: unimplemented = %t, want %t", tt.pattern, op.Unimplemented, tt.unimplemented)
+		}
+		if !op.Unimplemented {
+			continue
+		}
+		for status, response := range op.Responses {
+			if status != "default" && len(response.Content) > 0 {
+				t.Errorf("route %q documents a %s response body", tt.pattern, status)
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+	"github.com/google/uuid"
+)
+
+var ErrNoVersionState = errors.New("history entry has no state to revert to")
+
+type AuditService interface {
+	GetHistory(ctx context.Context, entityType string, entityID uuid.UUID, page, limit int) ([]model.AuditEntry, error)
+	RevertToVersion(ctx context.Context, entityType string, entityID uuid.UUID, entryID int64) (json.RawMessage, error)
+}
+
+type auditService struct {
+	repo repository.AuditRepository
+	uow  repository.UnitOfWork
+}
+
+func NewAuditService(repo repository.AuditRepository, uow repository.UnitOfWork) AuditService {
+	return &auditService{
+		repo: repo,
+		uow:  uow,
+	}
+}
+
+func (s *auditService) GetHistory(ctx context.Context, entityType string, entityID uuid.UUID, page, limit int) ([]model.AuditEntry, error) {
+	ctx, span := tracing.Start(ctx, "AuditService.GetHistory")
+	defer span.End()
+
+	return s.repo.GetHistory(ctx, entityType, entityID, page, limit)
+}
+
+// RevertToVersion puts an entity back into the state recorded by a history
+// entry. The revert is itself recorded in the history.
+func (s *auditService) RevertToVersion(ctx context.Context, entityType string, entityID uuid.UUID, entryID int64) (json.RawMessage, error) {
+	ctx, span := tracing.Start(ctx, "AuditService.RevertToVersion")
+	defer span.End()
+
+	return inUnitOfWork(ctx, s.uow, func(ctx context.Context) (json.RawMessage, error) {
+		entry, err := s.repo.GetAuditEntry(ctx, entityType, entityID, entryID)
+		if err != nil {
+			return nil, err
+		}
+		if string(entry.After) == "null" {
+			return nil, ErrNoVersionState
+		}
+		if err := s.repo.RestoreVersion(ctx, entityType, entry.After); err != nil {
+			return nil, err
+		}
+		return entry.After, nil
+	})
+}
@@ -2,75 +2,179 @@ package service
 
 import (
 	"context"
+	"iter"
 
+	"github.com/ChinmayNoob/f1/internal/metrics"
 	"github.com/ChinmayNoob/f1/internal/model"
 	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/google/uuid"
 )
 
 type CircuitService interface {
 	CreateCircuit(ctx context.Context, circuit model.Circuit) (model.Circuit, error)
 	GetAllCircuits(ctx context.Context, page, limit int) ([]model.Circuit, error)
+	StreamCircuits(ctx context.Context, filter model.CircuitFilter) iter.Seq2[model.Circuit, error]
 	GetCircuitByID(ctx context.Context, id uuid.UUID) (model.Circuit, error)
+	GetCircuitsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Circuit, error)
 	GetCircuitByRef(ctx context.Context, ref string) (model.Circuit, error)
 	GetCircuitByName(ctx context.Context, name string, page, limit int) ([]model.Circuit, error)
 	GetCircuitByLocation(ctx context.Context, location string, page, limit int) ([]model.Circuit, error)
 	GetCircuitByCountry(ctx context.Context, country string, page, limit int) ([]model.Circuit, error)
 	GetCircuitByCurrent(ctx context.Context, current bool) ([]model.Circuit, error)
 	GetCircuitByURL(ctx context.Context, url string) (model.Circuit, error)
+	ResolveCircuitRef(ctx context.Context, ref string) (uuid.UUID, error)
 	UpdateCircuit(ctx context.Context, id uuid.UUID, circuit model.Circuit) (model.Circuit, error)
 	DeleteCircuit(ctx context.Context, id uuid.UUID) error
+	RestoreCircuit(ctx context.Context, id uuid.UUID) error
+	PurgeCircuit(ctx context.Context, id uuid.UUID) error
+	BulkUpsertCircuits(ctx context.Context, circuits []model.Circuit, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type circuitService struct {
 	repo repository.CircuitRepository
+	uow  repository.UnitOfWork
 }
 
-func NewCircuitService(r repository.CircuitRepository) CircuitService {
-	return &circuitService{repo: r}
+func NewCircuitService(r repository.CircuitRepository, uow repository.UnitOfWork) CircuitService {
+	return &circuitService{
+		repo: r,
+		uow:  uow,
+	}
 }
 
 func (s *circuitService) CreateCircuit(ctx context.Context, circuit model.Circuit) (model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.CreateCircuit")
+	defer span.End()
+
 	circuit.ID = uuid.New()
-	return s.repo.CreateCircuit(ctx, circuit)
+	return inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.Circuit, error) {
+		return s.repo.CreateCircuit(ctx, circuit)
+	})
 }
 
 func (s *circuitService) GetAllCircuits(ctx context.Context, page, limit int) ([]model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetAllCircuits")
+	defer span.End()
+
 	return s.repo.GetAllCircuits(ctx, page, limit)
 }
 
+func (s *circuitService) StreamCircuits(ctx context.Context, filter model.CircuitFilter) iter.Seq2[model.Circuit, error] {
+	return tracing.Seq2(ctx, "CircuitService.StreamCircuits", func(ctx context.Context) iter.Seq2[model.Circuit, error] {
+		return s.repo.StreamCircuits(ctx, filter)
+	})
+}
+
 func (s *circuitService) GetCircuitByID(ctx context.Context, id uuid.UUID) (model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetCircuitByID")
+	defer span.End()
+
 	return s.repo.GetCircuitByID(ctx, id)
 }
 
+func (s *circuitService) GetCircuitsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetCircuitsByIDs")
+	defer span.End()
+
+	return s.repo.GetCircuitsByIDs(ctx, ids)
+}
+
 func (s *circuitService) GetCircuitByRef(ctx context.Context, ref string) (model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetCircuitByRef")
+	defer span.End()
+
 	return s.repo.GetCircuitByRef(ctx, ref)
 }
 
 func (s *circuitService) GetCircuitByName(ctx context.Context, name string, page, limit int) ([]model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetCircuitByName")
+	defer span.End()
+
 	return s.repo.GetCircuitByName(ctx, name, page, limit)
 }
 
 func (s *circuitService) GetCircuitByLocation(ctx context.Context, location string, page, limit int) ([]model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetCircuitByLocation")
+	defer span.End()
+
 	return s.repo.GetCircuitByLocation(ctx, location, page, limit)
 }
 
 func (s *circuitService) GetCircuitByCountry(ctx context.Context, country string, page, limit int) ([]model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetCircuitByCountry")
+	defer span.End()
+
 	return s.repo.GetCircuitByCountry(ctx, country, page, limit)
 }
 
 func (s *circuitService) GetCircuitByCurrent(ctx context.Context, current bool) ([]model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetCircuitByCurrent")
+	defer span.End()
+
 	return s.repo.GetCircuitByCurrent(ctx, current)
 }
 
 func (s *circuitService) GetCircuitByURL(ctx context.Context, url string) (model.Circuit, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.GetCircuitByURL")
+	defer span.End()
+
 	return s.repo.GetCircuitByURL(ctx, url)
 }
 
+func (s *circuitService) ResolveCircuitRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.ResolveCircuitRef")
+	defer span.End()
+
+	return s.repo.ResolveCircuitRef(ctx, ref)
+}
+
 func (s *circuitService) UpdateCircuit(ctx context.Context, id uuid.UUID, circuit model.Circuit) (model.Circuit, error) {
-	return s.repo.UpdateCircuit(ctx, id, circuit)
+	ctx, span := tracing.Start(ctx, "CircuitService.UpdateCircuit")
+	defer span.End()
+
+	return inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.Circuit, error) {
+		return s.repo.UpdateCircuit(ctx, id, circuit)
+	})
 }
 
 func (s *circuitService) DeleteCircuit(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteCircuit(ctx, id)
+	ctx, span := tracing.Start(ctx, "CircuitService.DeleteCircuit")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.DeleteCircuit(ctx, id)
+	})
+}
+
+func (s *circuitService) RestoreCircuit(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "CircuitService.RestoreCircuit")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.RestoreCircuit(ctx, id)
+	})
+}
+
+func (s *circuitService) PurgeCircuit(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "CircuitService.PurgeCircuit")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.PurgeCircuit(ctx, id)
+	})
+}
+
+func (s *circuitService) BulkUpsertCircuits(ctx context.Context, circuits []model.Circuit, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "CircuitService.BulkUpsertCircuits")
+	defer span.End()
+
+	for i := range circuits {
+		circuits[i].ID = uuid.New()
+	}
+	result, err := inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.BulkResult, error) {
+		return s.repo.BulkUpsertCircuits(ctx, circuits, mode)
+	})
+	metrics.ObserveImport("circuits", result, err)
+	return result, err
 }
@@ -2,60 +2,163 @@ package service
 
 import (
 	"context"
+	"iter"
 
+	"github.com/ChinmayNoob/f1/internal/metrics"
 	"github.com/ChinmayNoob/f1/internal/model"
 	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/google/uuid"
 )
 
 type ConstructorService interface {
 	CreateConstructor(ctx context.Context, constructor model.Constructor) (model.Constructor, error)
 	GetAllConstructors(ctx context.Context, page, limit int) ([]model.Constructor, error)
+	StreamConstructors(ctx context.Context, filter model.ConstructorFilter) iter.Seq2[model.Constructor, error]
 	GetConstructorByName(ctx context.Context, name string, page, limit int) ([]model.Constructor, error)
 	GetConstructorByID(ctx context.Context, id uuid.UUID) (model.Constructor, error)
+	GetConstructorsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Constructor, error)
+	GetDriverConstructors(ctx context.Context, driverIDs []uuid.UUID) (map[uuid.UUID]model.Constructor, error)
 	GetConstructorByNationality(ctx context.Context, nationality string, page, limit int) ([]model.Constructor, error)
 	GetConstructorByRef(ctx context.Context, ref string) (model.Constructor, error)
+	ResolveConstructorRef(ctx context.Context, ref string) (uuid.UUID, error)
 	UpdateConstructor(ctx context.Context, id uuid.UUID, constructor model.Constructor) (model.Constructor, error)
 	DeleteConstructor(ctx context.Context, id uuid.UUID) error
+	RestoreConstructor(ctx context.Context, id uuid.UUID) error
+	PurgeConstructor(ctx context.Context, id uuid.UUID) error
+	BulkUpsertConstructors(ctx context.Context, constructors []model.Constructor, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type constructorService struct {
 	repo repository.ConstructorRepository
+	uow  repository.UnitOfWork
 }
 
-func NewConstructorService(r repository.ConstructorRepository) ConstructorService {
-	return &constructorService{repo: r}
+func NewConstructorService(r repository.ConstructorRepository, uow repository.UnitOfWork) ConstructorService {
+	return &constructorService{
+		repo: r,
+		uow:  uow,
+	}
 }
 
 func (s *constructorService) CreateConstructor(ctx context.Context, constructor model.Constructor) (model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.CreateConstructor")
+	defer span.End()
+
 	constructor.ID = uuid.New()
-	return s.repo.CreateConstructor(ctx, constructor)
+	return inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.Constructor, error) {
+		return s.repo.CreateConstructor(ctx, constructor)
+	})
 }
 
 func (s *constructorService) GetAllConstructors(ctx context.Context, page, limit int) ([]model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.GetAllConstructors")
+	defer span.End()
+
 	return s.repo.GetAllConstructors(ctx, page, limit)
 }
 
+func (s *constructorService) StreamConstructors(ctx context.Context, filter model.ConstructorFilter) iter.Seq2[model.Constructor, error] {
+	return tracing.Seq2(ctx, "ConstructorService.StreamConstructors", func(ctx context.Context) iter.Seq2[model.Constructor, error] {
+		return s.repo.StreamConstructors(ctx, filter)
+	})
+}
+
 func (s *constructorService) GetConstructorByName(ctx context.Context, name string, page, limit int) ([]model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.GetConstructorByName")
+	defer span.End()
+
 	return s.repo.GetConstructorByName(ctx, name, page, limit)
 }
 
 func (s *constructorService) GetConstructorByNationality(ctx context.Context, nationality string, page, limit int) ([]model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.GetConstructorByNationality")
+	defer span.End()
+
 	return s.repo.GetConstructorByNationality(ctx, nationality, page, limit)
 }
 
 func (s *constructorService) GetConstructorByRef(ctx context.Context, ref string) (model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.GetConstructorByRef")
+	defer span.End()
+
 	return s.repo.GetConstructorByRef(ctx, ref)
 }
 
 func (s *constructorService) GetConstructorByID(ctx context.Context, id uuid.UUID) (model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.GetConstructorByID")
+	defer span.End()
+
 	return s.repo.GetConstructorByID(ctx, id)
 }
 
+func (s *constructorService) GetConstructorsByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.GetConstructorsByIDs")
+	defer span.End()
+
+	return s.repo.GetConstructorsByIDs(ctx, ids)
+}
+
+func (s *constructorService) GetDriverConstructors(ctx context.Context, driverIDs []uuid.UUID) (map[uuid.UUID]model.Constructor, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.GetDriverConstructors")
+	defer span.End()
+
+	return s.repo.GetDriverConstructors(ctx, driverIDs)
+}
+
+func (s *constructorService) ResolveConstructorRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.ResolveConstructorRef")
+	defer span.End()
+
+	return s.repo.ResolveConstructorRef(ctx, ref)
+}
+
 func (s *constructorService) UpdateConstructor(ctx context.Context, id uuid.UUID, constructor model.Constructor) (model.Constructor, error) {
-	return s.repo.UpdateConstructor(ctx, id, constructor)
+	ctx, span := tracing.Start(ctx, "ConstructorService.UpdateConstructor")
+	defer span.End()
+
+	return inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.Constructor, error) {
+		return s.repo.UpdateConstructor(ctx, id, constructor)
+	})
 }
 
 func (s *constructorService) DeleteConstructor(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteConstructor(ctx, id)
+	ctx, span := tracing.Start(ctx, "ConstructorService.DeleteConstructor")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.DeleteConstructor(ctx, id)
+	})
+}
+
+func (s *constructorService) RestoreConstructor(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ConstructorService.RestoreConstructor")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.RestoreConstructor(ctx, id)
+	})
+}
+
+func (s *constructorService) PurgeConstructor(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ConstructorService.PurgeConstructor")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.PurgeConstructor(ctx, id)
+	})
+}
+
+func (s *constructorService) BulkUpsertConstructors(ctx context.Context, constructors []model.Constructor, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "ConstructorService.BulkUpsertConstructors")
+	defer span.End()
+
+	for i := range constructors {
+		constructors[i].ID = uuid.New()
+	}
+	result, err := inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.BulkResult, error) {
+		return s.repo.BulkUpsertConstructors(ctx, constructors, mode)
+	})
+	metrics.ObserveImport("constructors", result, err)
+	return result, err
 }
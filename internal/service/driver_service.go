@@ -2,90 +2,258 @@ package service
 
 import (
 	"context"
+	"errors"
+	"iter"
 
+	"github.com/ChinmayNoob/f1/internal/metrics"
 	"github.com/ChinmayNoob/f1/internal/model"
 	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/google/uuid"
 )
 
 type DriverService interface {
 	CreateDriver(ctx context.Context, driver model.Driver) (model.Driver, error)
 	GetAllDrivers(ctx context.Context, page, limit int) ([]model.Driver, error)
+	StreamDrivers(ctx context.Context, filter model.DriverFilter) iter.Seq2[model.Driver, error]
 	GetDriverByFirstName(ctx context.Context, firstName string, page, limit int) ([]model.Driver, error)
 	GetDriverByLastName(ctx context.Context, lastName string, page, limit int) ([]model.Driver, error)
 	GetDriverByTeam(ctx context.Context, constructorName string, page, limit int) ([]model.Driver, error)
 	GetDriverByID(ctx context.Context, id uuid.UUID) (model.Driver, error)
+	GetDriversByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Driver, error)
 	GetDriverByRef(ctx context.Context, ref string) (model.Driver, error)
 	GetDriverByCode(ctx context.Context, code string) (model.Driver, error)
 	GetDriverByNumber(ctx context.Context, number int) (model.Driver, error)
 	GetDriverByNationality(ctx context.Context, nationality string, page, limit int) ([]model.Driver, error)
 	GetDriverByStatus(ctx context.Context, status string, page, limit int) ([]model.Driver, error)
 	GetDriverByURL(ctx context.Context, url string) (model.Driver, error)
+	ResolveDriverRef(ctx context.Context, ref string) (uuid.UUID, error)
 	UpdateDriver(ctx context.Context, id uuid.UUID, driver model.Driver) (model.Driver, error)
 	DeleteDriver(ctx context.Context, id uuid.UUID) error
+	RestoreDriver(ctx context.Context, id uuid.UUID) error
+	PurgeDriver(ctx context.Context, id uuid.UUID) error
+	MergeDrivers(ctx context.Context, sourceID, targetID uuid.UUID) (model.Driver, error)
+	BulkUpsertDrivers(ctx context.Context, drivers []model.Driver, mode model.BulkMode) (model.BulkResult, error)
 }
 
+var (
+	ErrDriverNotFound = errors.New("driver not found")
+	ErrSelfMerge      = errors.New("cannot merge a driver into itself")
+)
+
 type driverService struct {
-	repo repository.DriverRepository
+	repo         repository.DriverRepository
+	resultRepo   repository.ResultRepository
+	standingRepo repository.StandingRepository
+	uow          repository.UnitOfWork
 }
 
-func NewDriverService(r repository.DriverRepository) DriverService {
-	return &driverService{repo: r}
+func NewDriverService(r repository.DriverRepository, resultRepo repository.ResultRepository, standingRepo repository.StandingRepository, uow repository.UnitOfWork) DriverService {
+	return &driverService{
+		repo:         r,
+		resultRepo:   resultRepo,
+		standingRepo: standingRepo,
+		uow:          uow,
+	}
 }
 
 func (s *driverService) CreateDriver(ctx context.Context, driver model.Driver) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.CreateDriver")
+	defer span.End()
+
 	driver.ID = uuid.New()
-	return s.repo.CreateDriver(ctx, driver)
+	return inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.Driver, error) {
+		return s.repo.CreateDriver(ctx, driver)
+	})
 }
 
 func (s *driverService) GetAllDrivers(ctx context.Context, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetAllDrivers")
+	defer span.End()
+
 	return s.repo.GetAllDrivers(ctx, page, limit)
 }
 
+func (s *driverService) StreamDrivers(ctx context.Context, filter model.DriverFilter) iter.Seq2[model.Driver, error] {
+	return tracing.Seq2(ctx, "DriverService.StreamDrivers", func(ctx context.Context) iter.Seq2[model.Driver, error] {
+		return s.repo.StreamDrivers(ctx, filter)
+	})
+}
+
 func (s *driverService) GetDriverByFirstName(ctx context.Context, firstName string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByFirstName")
+	defer span.End()
+
 	return s.repo.GetDriverByFirstName(ctx, firstName, page, limit)
 }
 
 func (s *driverService) GetDriverByLastName(ctx context.Context, lastName string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByLastName")
+	defer span.End()
+
 	return s.repo.GetDriverByLastName(ctx, lastName, page, limit)
 }
 
 func (s *driverService) GetDriverByTeam(ctx context.Context, constructorName string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByTeam")
+	defer span.End()
+
 	return s.repo.GetDriverByTeam(ctx, constructorName, page, limit)
 }
 
 func (s *driverService) GetDriverByID(ctx context.Context, id uuid.UUID) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByID")
+	defer span.End()
+
 	return s.repo.GetDriverByID(ctx, id)
 }
 
+func (s *driverService) GetDriversByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriversByIDs")
+	defer span.End()
+
+	return s.repo.GetDriversByIDs(ctx, ids)
+}
+
 func (s *driverService) GetDriverByRef(ctx context.Context, ref string) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByRef")
+	defer span.End()
+
 	return s.repo.GetDriverByRef(ctx, ref)
 }
 
 func (s *driverService) GetDriverByCode(ctx context.Context, code string) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByCode")
+	defer span.End()
+
 	return s.repo.GetDriverByCode(ctx, code)
 }
 
 func (s *driverService) GetDriverByNumber(ctx context.Context, number int) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByNumber")
+	defer span.End()
+
 	return s.repo.GetDriverByNumber(ctx, number)
 }
 
 func (s *driverService) GetDriverByNationality(ctx context.Context, nationality string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByNationality")
+	defer span.End()
+
 	return s.repo.GetDriverByNationality(ctx, nationality, page, limit)
 }
 
 func (s *driverService) GetDriverByStatus(ctx context.Context, status string, page, limit int) ([]model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByStatus")
+	defer span.End()
+
 	return s.repo.GetDriverByStatus(ctx, status, page, limit)
 }
 
 func (s *driverService) GetDriverByURL(ctx context.Context, url string) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.GetDriverByURL")
+	defer span.End()
+
 	return s.repo.GetDriverByURL(ctx, url)
 }
 
+func (s *driverService) ResolveDriverRef(ctx context.Context, ref string) (uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.ResolveDriverRef")
+	defer span.End()
+
+	return s.repo.ResolveDriverRef(ctx, ref)
+}
+
 func (s *driverService) UpdateDriver(ctx context.Context, id uuid.UUID, driver model.Driver) (model.Driver, error) {
-	return s.repo.UpdateDriver(ctx, id, driver)
+	ctx, span := tracing.Start(ctx, "DriverService.UpdateDriver")
+	defer span.End()
+
+	return inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.Driver, error) {
+		return s.repo.UpdateDriver(ctx, id, driver)
+	})
 }
 
 func (s *driverService) DeleteDriver(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteDriver(ctx, id)
+	ctx, span := tracing.Start(ctx, "DriverService.DeleteDriver")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.DeleteDriver(ctx, id)
+	})
+}
+
+func (s *driverService) RestoreDriver(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "DriverService.RestoreDriver")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.RestoreDriver(ctx, id)
+	})
+}
+
+func (s *driverService) PurgeDriver(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "DriverService.PurgeDriver")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.PurgeDriver(ctx, id)
+	})
+}
+
+func (s *driverService) BulkUpsertDrivers(ctx context.Context, drivers []model.Driver, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.BulkUpsertDrivers")
+	defer span.End()
+
+	for i := range drivers {
+		drivers[i].ID = uuid.New()
+	}
+	result, err := inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.BulkResult, error) {
+		return s.repo.BulkUpsertDrivers(ctx, drivers, mode)
+	})
+	metrics.ObserveImport("drivers", result, err)
+	return result, err
+}
+
+// MergeDrivers folds the source driver into the target: the source's results
+// move to the target, the affected season standings are recomputed and the
+// source driver is deleted, all in one transaction.
+func (s *driverService) MergeDrivers(ctx context.Context, sourceID, targetID uuid.UUID) (model.Driver, error) {
+	ctx, span := tracing.Start(ctx, "DriverService.MergeDrivers")
+	defer span.End()
+
+	if sourceID == targetID {
+		return model.Driver{}, ErrSelfMerge
+	}
+
+	var merged model.Driver
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		for _, id := range []uuid.UUID{sourceID, targetID} {
+			driver, err := s.repo.GetDriverByID(ctx, id)
+			if err != nil {
+				return err
+			}
+			if driver.ID == (uuid.UUID{}) {
+				return ErrDriverNotFound
+			}
+			merged = driver
+		}
+
+		seasonIDs, err := s.resultRepo.ReassignDriverResults(ctx, sourceID, targetID)
+		if err != nil {
+			return err
+		}
+		if err := s.standingRepo.DeleteDriverStandingsByDriver(ctx, sourceID); err != nil {
+			return err
+		}
+		for _, seasonID := range seasonIDs {
+			if err := s.standingRepo.RecomputeSeasonStandings(ctx, seasonID); err != nil {
+				return err
+			}
+		}
+		return s.repo.DeleteDriver(ctx, sourceID)
+	})
+	if err != nil {
+		return model.Driver{}, err
+	}
+	return merged, nil
 }
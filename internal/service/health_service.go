@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+)
+
+const readinessCheckTimeout = 2 * time.Second
+
+type HealthService interface {
+	// Ready checks the dependencies the API needs to serve requests.
+	Ready(ctx context.Context) model.Readiness
+	// Drain marks the server as shutting down, after which it is never ready.
+	Drain()
+}
+
+type healthService struct {
+	repo     repository.HealthRepository
+	draining atomic.Bool
+}
+
+func NewHealthService(repo repository.HealthRepository) HealthService {
+	return &healthService{repo: repo}
+}
+
+func (s *healthService) Ready(ctx context.Context) model.Readiness {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+
+	readiness := model.Readiness{
+		Status: model.HealthReady,
+		Dependencies: []model.DependencyStatus{
+			check("database", func() (string, error) {
+				return "", s.repo.Ping(ctx)
+			}),
+			check("migrations", func() (string, error) {
+				applied, latest, err := s.repo.GetMigrationVersions(ctx)
+				if err != nil {
+					return "", err
+				}
+				detail := fmt.Sprintf("version %d of %d", applied, latest)
+				if applied < latest {
+					return detail, fmt.Errorf("%s, migrations pending", detail)
+				}
+				return detail, nil
+			}),
+		},
+	}
+	if s.draining.Load() {
+		readiness.Dependencies = append(readiness.Dependencies, model.DependencyStatus{
+			Name:   "server",
+			Status: model.HealthFailing,
+			Detail: "shutting down",
+		})
+	}
+
+	for _, dep := range readiness.Dependencies {
+		if dep.Status != model.HealthOK {
+			readiness.Status = model.HealthNotReady
+		}
+	}
+	return readiness
+}
+
+func (s *healthService) Drain() {
+	s.draining.Store(true)
+}
+
+// check runs fn and reports how it went and how long it took.
+func check(name string, fn func() (string, error)) model.DependencyStatus {
+	start := time.Now()
+	detail, err := fn()
+	status := model.DependencyStatus{
+		Name:      name,
+		Status:    model.HealthOK,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+		Detail:    detail,
+	}
+	if err != nil {
+		status.Status = model.HealthFailing
+		status.Detail = err.Error()
+	}
+	return status
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/querystats"
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+)
+
+var (
+	ErrNotExplainable = errors.New("only SELECT, INSERT, UPDATE and DELETE statements can be explained")
+	ErrNotAnalyzable  = errors.New("only SELECT statements and WITH queries without INSERT, UPDATE, DELETE or MERGE can be analyzed")
+)
+
+// writeKeywords mark statements that write rows, which are not run to
+// analyze them.
+var writeKeywords = map[string]bool{"INSERT": true, "UPDATE": true, "DELETE": true, "MERGE": true}
+
+type QueryStatsService interface {
+	GetQueryStats(ctx context.Context) []model.QueryStatement
+	ExplainStatement(ctx context.Context, id string, analyze bool) (model.QueryPlan, error)
+}
+
+type queryStatsService struct {
+	stats *querystats.Collector
+	repo  repository.QueryPlanRepository
+}
+
+func NewQueryStatsService(stats *querystats.Collector, repo repository.QueryPlanRepository) QueryStatsService {
+	return &queryStatsService{stats: stats, repo: repo}
+}
+
+func (s *queryStatsService) GetQueryStats(ctx context.Context) []model.QueryStatement {
+	return s.stats.Statements()
+}
+
+// ExplainStatement explains a tracked statement with the arguments of its
+// last call. Only reads are analyzed, since analyzing runs the statement.
+func (s *queryStatsService) ExplainStatement(ctx context.Context, id string, analyze bool) (model.QueryPlan, error) {
+	ctx, span := tracing.Start(ctx, "QueryStatsService.ExplainStatement")
+	defer span.End()
+
+	stmt, sql, args, ok := s.stats.Statement(id)
+	if !ok {
+		return model.QueryPlan{}, repository.ErrNotFound
+	}
+	operation, _, _ := strings.Cut(stmt.SQL, " ")
+	switch strings.ToUpper(operation) {
+	case "SELECT", "WITH", "INSERT", "UPDATE", "DELETE":
+	default:
+		return model.QueryPlan{}, ErrNotExplainable
+	}
+	if analyze && !readOnly(stmt.SQL) {
+		return model.QueryPlan{}, ErrNotAnalyzable
+	}
+
+	plan, err := s.repo.ExplainQuery(ctx, sql, args, analyze)
+	if err != nil {
+		return model.QueryPlan{}, err
+	}
+	return model.QueryPlan{Statement: stmt, Analyzed: analyze, Plan: plan}, nil
+}
+
+// readOnly reports whether sql, with its literals sanitized, is a SELECT or
+// a WITH query that neither writes nor locks rows: none of its words is a
+// write keyword, which also rules out SELECT ... FOR UPDATE.
+func readOnly(sql string) bool {
+	words := strings.FieldsFunc(strings.ToUpper(sql), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '_'
+	})
+	if len(words) == 0 || (words[0] != "SELECT" && words[0] != "WITH") {
+		return false
+	}
+	for _, word := range words {
+		if writeKeywords[word] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/querystats"
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+// planRepository returns a one line plan and records what it explained.
+type planRepository struct {
+	explained bool
+	analyzed  bool
+}
+
+func (r *planRepository) ExplainQuery(_ context.Context, _ string, _ []any, analyze bool) ([]string, error) {
+	r.explained, r.analyzed = true, analyze
+	return []string{"Seq Scan on drivers"}, nil
+}
+
+func TestExplainStatement(t *testing.T) {
+	tests := []struct {
+		name    string
+		sql     string
+		analyze bool
+		wantErr error
+	}{
+		{name: "select", sql: "SELECT id FROM drivers WHERE ref = $1"},
+		{name: "analyze select", sql: "SELECT id FROM drivers WHERE ref = $1", analyze: true},
+		{name: "analyze read-only with", sql: "WITH d AS (SELECT id FROM drivers) SELECT * FROM d", analyze: true},
+		{name: "insert", sql: "INSERT INTO drivers (ref) VALUES ($1)"},
+		{name: "analyze insert", sql: "INSERT INTO drivers (ref) VALUES ($1)", analyze: true, wantErr: ErrNotAnalyzable},
+		{name: "analyze update", sql: "UPDATE drivers SET number = $2 WHERE id = $1", analyze: true, wantErr: ErrNotAnalyzable},
+		{name: "analyze delete", sql: "DELETE FROM drivers WHERE id = $1", analyze: true, wantErr: ErrNotAnalyzable},
+		{name: "analyze with a delete", sql: "WITH gone AS (DELETE FROM drivers WHERE id = $1 RETURNING id) SELECT * FROM gone", analyze: true, wantErr: ErrNotAnalyzable},
+		{name: "analyze with an insert", sql: "with d as (insert into drivers (ref) values ($1) returning id) select * from d", analyze: true, wantErr: ErrNotAnalyzable},
+		{name: "analyze with a merge", sql: "WITH s AS (SELECT $1 AS ref) MERGE INTO drivers USING s ON drivers.ref = s.ref WHEN NOT MATCHED THEN INSERT (ref) VALUES (s.ref)", analyze: true, wantErr: ErrNotAnalyzable},
+		{name: "analyze select for update", sql: "SELECT id FROM drivers WHERE id = $1 FOR UPDATE", analyze: true, wantErr: ErrNotAnalyzable},
+		{name: "analyze a write keyword in a literal", sql: "SELECT id FROM audit_log WHERE action = 'DELETE'", analyze: true},
+		{name: "set", sql: "SET statement_timeout = 0", wantErr: ErrNotExplainable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := querystats.NewCollector(0, false)
+			ctx := stats.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: tt.sql, Args: []any{"hamilton"}})
+			stats.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+			id := stats.Statements()[0].ID
+
+			repo := &planRepository{}
+			plan, err := NewQueryStatsService(stats, repo).ExplainStatement(context.Background(), id, tt.analyze)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ExplainStatement() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if repo.explained {
+					t.Errorf("statement was explained")
+				}
+				return
+			}
+			if !repo.explained || repo.analyzed != tt.analyze || plan.Analyzed != tt.analyze {
+				t.Errorf("explained = %t, analyzed = %t, plan.Analyzed = %t, want analyzed %t", repo.explained, repo.analyzed, plan.Analyzed, tt.analyze)
+			}
+		})
+	}
+
+	_, err := NewQueryStatsService(querystats.NewCollector(0, false), &planRepository{}).ExplainStatement(context.Background(), "unknown", false)
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("ExplainStatement() of an unknown statement: error = %v, want ErrNotFound", err)
+	}
+}
@@ -3,43 +3,167 @@ package service
 import (
 	"context"
 
+	"github.com/ChinmayNoob/f1/internal/metrics"
 	"github.com/ChinmayNoob/f1/internal/model"
 	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/google/uuid"
 )
 
 type RaceService interface {
 	CreateRace(ctx context.Context, race *model.Race) error
+	CreateRaceWithResults(ctx context.Context, race *model.Race, results []*model.Result) error
 	GetRace(ctx context.Context, id uuid.UUID) (*model.Race, error)
 	GetAllRaces(ctx context.Context) ([]*model.Race, error)
+	GetSeasonRaces(ctx context.Context, year int) ([]*model.Race, error)
+	GetSeasonRace(ctx context.Context, year, round int) (*model.Race, error)
+	GetRacesByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Race, error)
+	GetRacesBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.Race, error)
 	UpdateRace(ctx context.Context, race *model.Race) error
 	DeleteRace(ctx context.Context, id uuid.UUID) error
+	RestoreRace(ctx context.Context, id uuid.UUID) error
+	PurgeRace(ctx context.Context, id uuid.UUID) error
+	BulkUpsertRaces(ctx context.Context, races []*model.Race, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type raceService struct {
-	repo repository.RaceRepository
+	repo         repository.RaceRepository
+	resultRepo   repository.ResultRepository
+	standingRepo repository.StandingRepository
+	uow          repository.UnitOfWork
 }
 
-func NewRaceService(repo repository.RaceRepository) RaceService {
-	return &raceService{repo: repo}
+func NewRaceService(repo repository.RaceRepository, resultRepo repository.ResultRepository, standingRepo repository.StandingRepository, uow repository.UnitOfWork) RaceService {
+	return &raceService{
+		repo:         repo,
+		resultRepo:   resultRepo,
+		standingRepo: standingRepo,
+		uow:          uow,
+	}
 }
 
 func (s *raceService) CreateRace(ctx context.Context, race *model.Race) error {
-	return s.repo.CreateRace(ctx, race)
+	ctx, span := tracing.Start(ctx, "RaceService.CreateRace")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.CreateRace(ctx, race)
+	})
+}
+
+// CreateRaceWithResults stores a race together with its results and refreshes
+// the season standings. Either all of it is written or none of it is.
+func (s *raceService) CreateRaceWithResults(ctx context.Context, race *model.Race, results []*model.Result) error {
+	ctx, span := tracing.Start(ctx, "RaceService.CreateRaceWithResults")
+	defer span.End()
+
+	race.ID = uuid.New()
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateRace(ctx, race); err != nil {
+			return err
+		}
+		for _, result := range results {
+			result.ID = uuid.New()
+			result.RaceID = race.ID
+			if err := s.resultRepo.CreateResult(ctx, result); err != nil {
+				return err
+			}
+		}
+		if len(results) == 0 {
+			return nil
+		}
+		return s.standingRepo.RecomputeSeasonStandings(ctx, race.SeasonID)
+	})
 }
 
 func (s *raceService) GetRace(ctx context.Context, id uuid.UUID) (*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceService.GetRace")
+	defer span.End()
+
 	return s.repo.GetRace(ctx, id)
 }
 
 func (s *raceService) GetAllRaces(ctx context.Context) ([]*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceService.GetAllRaces")
+	defer span.End()
+
 	return s.repo.GetAllRaces(ctx)
 }
 
+func (s *raceService) GetSeasonRaces(ctx context.Context, year int) ([]*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceService.GetSeasonRaces")
+	defer span.End()
+
+	return s.repo.GetSeasonRaces(ctx, year)
+}
+
+func (s *raceService) GetSeasonRace(ctx context.Context, year, round int) (*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceService.GetSeasonRace")
+	defer span.End()
+
+	return s.repo.GetSeasonRace(ctx, year, round)
+}
+
+func (s *raceService) GetRacesByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceService.GetRacesByIDs")
+	defer span.End()
+
+	return s.repo.GetRacesByIDs(ctx, ids)
+}
+
+func (s *raceService) GetRacesBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.Race, error) {
+	ctx, span := tracing.Start(ctx, "RaceService.GetRacesBySeasonIDs")
+	defer span.End()
+
+	return s.repo.GetRacesBySeasonIDs(ctx, seasonIDs)
+}
+
 func (s *raceService) UpdateRace(ctx context.Context, race *model.Race) error {
-	return s.repo.UpdateRace(ctx, race)
+	ctx, span := tracing.Start(ctx, "RaceService.UpdateRace")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.UpdateRace(ctx, race)
+	})
 }
 
 func (s *raceService) DeleteRace(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteRace(ctx, id)
+	ctx, span := tracing.Start(ctx, "RaceService.DeleteRace")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.DeleteRace(ctx, id)
+	})
+}
+
+func (s *raceService) RestoreRace(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "RaceService.RestoreRace")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.RestoreRace(ctx, id)
+	})
+}
+
+func (s *raceService) PurgeRace(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "RaceService.PurgeRace")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.PurgeRace(ctx, id)
+	})
+}
+
+func (s *raceService) BulkUpsertRaces(ctx context.Context, races []*model.Race, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "RaceService.BulkUpsertRaces")
+	defer span.End()
+
+	for _, race := range races {
+		race.ID = uuid.New()
+	}
+	result, err := inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.BulkResult, error) {
+		return s.repo.BulkUpsertRaces(ctx, races, mode)
+	})
+	metrics.ObserveImport("races", result, err)
+	return result, err
 }
@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 
+	"github.com/ChinmayNoob/f1/internal/metrics"
 	"github.com/ChinmayNoob/f1/internal/model"
 	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/google/uuid"
 )
 
@@ -12,34 +14,118 @@ type ResultService interface {
 	CreateResult(ctx context.Context, result *model.Result) error
 	GetResult(ctx context.Context, id uuid.UUID) (*model.Result, error)
 	GetAllResults(ctx context.Context) ([]*model.Result, error)
+	GetRaceResults(ctx context.Context, raceID uuid.UUID) ([]*model.Result, error)
+	GetSeasonRaceResults(ctx context.Context, year, round int) ([]*model.Result, error)
+	GetResultsByRaceIDs(ctx context.Context, raceIDs []uuid.UUID) ([]*model.Result, error)
 	UpdateResult(ctx context.Context, result *model.Result) error
 	DeleteResult(ctx context.Context, id uuid.UUID) error
+	RestoreResult(ctx context.Context, id uuid.UUID) error
+	PurgeResult(ctx context.Context, id uuid.UUID) error
+	BulkUpsertResults(ctx context.Context, results []*model.Result, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type resultService struct {
 	repo repository.ResultRepository
+	uow  repository.UnitOfWork
 }
 
-func NewResultService(repo repository.ResultRepository) ResultService {
-	return &resultService{repo: repo}
+func NewResultService(repo repository.ResultRepository, uow repository.UnitOfWork) ResultService {
+	return &resultService{
+		repo: repo,
+		uow:  uow,
+	}
 }
 
 func (s *resultService) CreateResult(ctx context.Context, result *model.Result) error {
-	return s.repo.CreateResult(ctx, result)
+	ctx, span := tracing.Start(ctx, "ResultService.CreateResult")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.CreateResult(ctx, result)
+	})
 }
 
 func (s *resultService) GetResult(ctx context.Context, id uuid.UUID) (*model.Result, error) {
+	ctx, span := tracing.Start(ctx, "ResultService.GetResult")
+	defer span.End()
+
 	return s.repo.GetResult(ctx, id)
 }
 
 func (s *resultService) GetAllResults(ctx context.Context) ([]*model.Result, error) {
+	ctx, span := tracing.Start(ctx, "ResultService.GetAllResults")
+	defer span.End()
+
 	return s.repo.GetAllResults(ctx)
 }
 
+func (s *resultService) GetRaceResults(ctx context.Context, raceID uuid.UUID) ([]*model.Result, error) {
+	ctx, span := tracing.Start(ctx, "ResultService.GetRaceResults")
+	defer span.End()
+
+	return s.repo.GetRaceResults(ctx, raceID)
+}
+
+func (s *resultService) GetSeasonRaceResults(ctx context.Context, year, round int) ([]*model.Result, error) {
+	ctx, span := tracing.Start(ctx, "ResultService.GetSeasonRaceResults")
+	defer span.End()
+
+	return s.repo.GetSeasonRaceResults(ctx, year, round)
+}
+
+func (s *resultService) GetResultsByRaceIDs(ctx context.Context, raceIDs []uuid.UUID) ([]*model.Result, error) {
+	ctx, span := tracing.Start(ctx, "ResultService.GetResultsByRaceIDs")
+	defer span.End()
+
+	return s.repo.GetResultsByRaceIDs(ctx, raceIDs)
+}
+
 func (s *resultService) UpdateResult(ctx context.Context, result *model.Result) error {
-	return s.repo.UpdateResult(ctx, result)
+	ctx, span := tracing.Start(ctx, "ResultService.UpdateResult")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.UpdateResult(ctx, result)
+	})
 }
 
 func (s *resultService) DeleteResult(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteResult(ctx, id)
+	ctx, span := tracing.Start(ctx, "ResultService.DeleteResult")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.DeleteResult(ctx, id)
+	})
+}
+
+func (s *resultService) RestoreResult(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ResultService.RestoreResult")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.RestoreResult(ctx, id)
+	})
+}
+
+func (s *resultService) PurgeResult(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "ResultService.PurgeResult")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.PurgeResult(ctx, id)
+	})
+}
+
+func (s *resultService) BulkUpsertResults(ctx context.Context, results []*model.Result, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "ResultService.BulkUpsertResults")
+	defer span.End()
+
+	for _, result := range results {
+		result.ID = uuid.New()
+	}
+	result, err := inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.BulkResult, error) {
+		return s.repo.BulkUpsertResults(ctx, results, mode)
+	})
+	metrics.ObserveImport("results", result, err)
+	return result, err
 }
@@ -3,43 +3,121 @@ package service
 import (
 	"context"
 
+	"github.com/ChinmayNoob/f1/internal/metrics"
 	"github.com/ChinmayNoob/f1/internal/model"
 	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/google/uuid"
 )
 
 type SeasonService interface {
 	CreateSeason(ctx context.Context, season *model.Season) error
 	GetSeason(ctx context.Context, id uuid.UUID) (*model.Season, error)
+	GetSeasonsByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Season, error)
 	GetAllSeasons(ctx context.Context) ([]*model.Season, error)
+	ResolveSeasonYear(ctx context.Context, year int) (uuid.UUID, error)
 	UpdateSeason(ctx context.Context, season *model.Season) error
 	DeleteSeason(ctx context.Context, id uuid.UUID) error
+	RestoreSeason(ctx context.Context, id uuid.UUID) error
+	PurgeSeason(ctx context.Context, id uuid.UUID) error
+	BulkUpsertSeasons(ctx context.Context, seasons []*model.Season, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type seasonService struct {
 	repo repository.SeasonRepository
+	uow  repository.UnitOfWork
 }
 
-func NewSeasonService(repo repository.SeasonRepository) SeasonService {
-	return &seasonService{repo: repo}
+func NewSeasonService(repo repository.SeasonRepository, uow repository.UnitOfWork) SeasonService {
+	return &seasonService{
+		repo: repo,
+		uow:  uow,
+	}
 }
 
 func (s *seasonService) CreateSeason(ctx context.Context, season *model.Season) error {
-	return s.repo.CreateSeason(ctx, season)
+	ctx, span := tracing.Start(ctx, "SeasonService.CreateSeason")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.CreateSeason(ctx, season)
+	})
 }
 
 func (s *seasonService) GetSeason(ctx context.Context, id uuid.UUID) (*model.Season, error) {
+	ctx, span := tracing.Start(ctx, "SeasonService.GetSeason")
+	defer span.End()
+
 	return s.repo.GetSeason(ctx, id)
 }
 
+func (s *seasonService) GetSeasonsByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Season, error) {
+	ctx, span := tracing.Start(ctx, "SeasonService.GetSeasonsByIDs")
+	defer span.End()
+
+	return s.repo.GetSeasonsByIDs(ctx, ids)
+}
+
 func (s *seasonService) GetAllSeasons(ctx context.Context) ([]*model.Season, error) {
+	ctx, span := tracing.Start(ctx, "SeasonService.GetAllSeasons")
+	defer span.End()
+
 	return s.repo.GetAllSeasons(ctx)
 }
 
+func (s *seasonService) ResolveSeasonYear(ctx context.Context, year int) (uuid.UUID, error) {
+	ctx, span := tracing.Start(ctx, "SeasonService.ResolveSeasonYear")
+	defer span.End()
+
+	return s.repo.ResolveSeasonYear(ctx, year)
+}
+
 func (s *seasonService) UpdateSeason(ctx context.Context, season *model.Season) error {
-	return s.repo.UpdateSeason(ctx, season)
+	ctx, span := tracing.Start(ctx, "SeasonService.UpdateSeason")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.UpdateSeason(ctx, season)
+	})
 }
 
 func (s *seasonService) DeleteSeason(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteSeason(ctx, id)
+	ctx, span := tracing.Start(ctx, "SeasonService.DeleteSeason")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.DeleteSeason(ctx, id)
+	})
+}
+
+func (s *seasonService) RestoreSeason(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "SeasonService.RestoreSeason")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.RestoreSeason(ctx, id)
+	})
+}
+
+func (s *seasonService) PurgeSeason(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "SeasonService.PurgeSeason")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.PurgeSeason(ctx, id)
+	})
+}
+
+func (s *seasonService) BulkUpsertSeasons(ctx context.Context, seasons []*model.Season, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "SeasonService.BulkUpsertSeasons")
+	defer span.End()
+
+	for _, season := range seasons {
+		season.ID = uuid.New()
+	}
+	result, err := inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.BulkResult, error) {
+		return s.repo.BulkUpsertSeasons(ctx, seasons, mode)
+	})
+	metrics.ObserveImport("seasons", result, err)
+	return result, err
 }
@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 
+	"github.com/ChinmayNoob/f1/internal/metrics"
 	"github.com/ChinmayNoob/f1/internal/model"
 	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
 	"github.com/google/uuid"
 )
 
@@ -12,60 +14,208 @@ type StandingService interface {
 	CreateDriverStanding(ctx context.Context, standing *model.DriverStanding) error
 	GetDriverStanding(ctx context.Context, id uuid.UUID) (*model.DriverStanding, error)
 	GetAllDriverStandings(ctx context.Context) ([]*model.DriverStanding, error)
+	GetSeasonDriverStandings(ctx context.Context, year int) ([]*model.DriverStanding, error)
+	GetDriverStandingsBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.DriverStanding, error)
 	UpdateDriverStanding(ctx context.Context, standing *model.DriverStanding) error
 	DeleteDriverStanding(ctx context.Context, id uuid.UUID) error
+	RestoreDriverStanding(ctx context.Context, id uuid.UUID) error
+	PurgeDriverStanding(ctx context.Context, id uuid.UUID) error
+	BulkUpsertDriverStandings(ctx context.Context, standings []*model.DriverStanding, mode model.BulkMode) (model.BulkResult, error)
 
 	CreateConstructorStanding(ctx context.Context, standing *model.ConstructorStanding) error
 	GetConstructorStanding(ctx context.Context, id uuid.UUID) (*model.ConstructorStanding, error)
 	GetAllConstructorStandings(ctx context.Context) ([]*model.ConstructorStanding, error)
+	GetSeasonConstructorStandings(ctx context.Context, year int) ([]*model.ConstructorStanding, error)
+	GetConstructorStandingsBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.ConstructorStanding, error)
 	UpdateConstructorStanding(ctx context.Context, standing *model.ConstructorStanding) error
 	DeleteConstructorStanding(ctx context.Context, id uuid.UUID) error
+	RestoreConstructorStanding(ctx context.Context, id uuid.UUID) error
+	PurgeConstructorStanding(ctx context.Context, id uuid.UUID) error
+	BulkUpsertConstructorStandings(ctx context.Context, standings []*model.ConstructorStanding, mode model.BulkMode) (model.BulkResult, error)
 }
 
 type standingService struct {
 	repo repository.StandingRepository
+	uow  repository.UnitOfWork
 }
 
-func NewStandingService(repo repository.StandingRepository) StandingService {
-	return &standingService{repo: repo}
+func NewStandingService(repo repository.StandingRepository, uow repository.UnitOfWork) StandingService {
+	return &standingService{
+		repo: repo,
+		uow:  uow,
+	}
 }
 
 func (s *standingService) CreateDriverStanding(ctx context.Context, standing *model.DriverStanding) error {
-	return s.repo.CreateDriverStanding(ctx, standing)
+	ctx, span := tracing.Start(ctx, "StandingService.CreateDriverStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.CreateDriverStanding(ctx, standing)
+	})
 }
 
 func (s *standingService) GetDriverStanding(ctx context.Context, id uuid.UUID) (*model.DriverStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.GetDriverStanding")
+	defer span.End()
+
 	return s.repo.GetDriverStanding(ctx, id)
 }
 
 func (s *standingService) GetAllDriverStandings(ctx context.Context) ([]*model.DriverStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.GetAllDriverStandings")
+	defer span.End()
+
 	return s.repo.GetAllDriverStandings(ctx)
 }
 
+func (s *standingService) GetSeasonDriverStandings(ctx context.Context, year int) ([]*model.DriverStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.GetSeasonDriverStandings")
+	defer span.End()
+
+	return s.repo.GetSeasonDriverStandings(ctx, year)
+}
+
+func (s *standingService) GetDriverStandingsBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.DriverStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.GetDriverStandingsBySeasonIDs")
+	defer span.End()
+
+	return s.repo.GetDriverStandingsBySeasonIDs(ctx, seasonIDs)
+}
+
 func (s *standingService) UpdateDriverStanding(ctx context.Context, standing *model.DriverStanding) error {
-	return s.repo.UpdateDriverStanding(ctx, standing)
+	ctx, span := tracing.Start(ctx, "StandingService.UpdateDriverStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.UpdateDriverStanding(ctx, standing)
+	})
 }
 
 func (s *standingService) DeleteDriverStanding(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteDriverStanding(ctx, id)
+	ctx, span := tracing.Start(ctx, "StandingService.DeleteDriverStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.DeleteDriverStanding(ctx, id)
+	})
+}
+
+func (s *standingService) RestoreDriverStanding(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingService.RestoreDriverStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.RestoreDriverStanding(ctx, id)
+	})
+}
+
+func (s *standingService) PurgeDriverStanding(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingService.PurgeDriverStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.PurgeDriverStanding(ctx, id)
+	})
 }
 
 func (s *standingService) CreateConstructorStanding(ctx context.Context, standing *model.ConstructorStanding) error {
-	return s.repo.CreateConstructorStanding(ctx, standing)
+	ctx, span := tracing.Start(ctx, "StandingService.CreateConstructorStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.CreateConstructorStanding(ctx, standing)
+	})
 }
 
 func (s *standingService) GetConstructorStanding(ctx context.Context, id uuid.UUID) (*model.ConstructorStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.GetConstructorStanding")
+	defer span.End()
+
 	return s.repo.GetConstructorStanding(ctx, id)
 }
 
 func (s *standingService) GetAllConstructorStandings(ctx context.Context) ([]*model.ConstructorStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.GetAllConstructorStandings")
+	defer span.End()
+
 	return s.repo.GetAllConstructorStandings(ctx)
 }
 
+func (s *standingService) GetSeasonConstructorStandings(ctx context.Context, year int) ([]*model.ConstructorStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.GetSeasonConstructorStandings")
+	defer span.End()
+
+	return s.repo.GetSeasonConstructorStandings(ctx, year)
+}
+
+func (s *standingService) GetConstructorStandingsBySeasonIDs(ctx context.Context, seasonIDs []uuid.UUID) ([]*model.ConstructorStanding, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.GetConstructorStandingsBySeasonIDs")
+	defer span.End()
+
+	return s.repo.GetConstructorStandingsBySeasonIDs(ctx, seasonIDs)
+}
+
 func (s *standingService) UpdateConstructorStanding(ctx context.Context, standing *model.ConstructorStanding) error {
-	return s.repo.UpdateConstructorStanding(ctx, standing)
+	ctx, span := tracing.Start(ctx, "StandingService.UpdateConstructorStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.UpdateConstructorStanding(ctx, standing)
+	})
 }
 
 func (s *standingService) DeleteConstructorStanding(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteConstructorStanding(ctx, id)
+	ctx, span := tracing.Start(ctx, "StandingService.DeleteConstructorStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.DeleteConstructorStanding(ctx, id)
+	})
+}
+
+func (s *standingService) RestoreConstructorStanding(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingService.RestoreConstructorStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.RestoreConstructorStanding(ctx, id)
+	})
+}
+
+func (s *standingService) PurgeConstructorStanding(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "StandingService.PurgeConstructorStanding")
+	defer span.End()
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		return s.repo.PurgeConstructorStanding(ctx, id)
+	})
+}
+
+func (s *standingService) BulkUpsertDriverStandings(ctx context.Context, standings []*model.DriverStanding, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.BulkUpsertDriverStandings")
+	defer span.End()
+
+	for _, standing := range standings {
+		standing.ID = uuid.New()
+	}
+	result, err := inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.BulkResult, error) {
+		return s.repo.BulkUpsertDriverStandings(ctx, standings, mode)
+	})
+	metrics.ObserveImport("driver-standings", result, err)
+	return result, err
+}
+
+func (s *standingService) BulkUpsertConstructorStandings(ctx context.Context, standings []*model.ConstructorStanding, mode model.BulkMode) (model.BulkResult, error) {
+	ctx, span := tracing.Start(ctx, "StandingService.BulkUpsertConstructorStandings")
+	defer span.End()
+
+	for _, standing := range standings {
+		standing.ID = uuid.New()
+	}
+	result, err := inUnitOfWork(ctx, s.uow, func(ctx context.Context) (model.BulkResult, error) {
+		return s.repo.BulkUpsertConstructorStandings(ctx, standings, mode)
+	})
+	metrics.ObserveImport("constructor-standings", result, err)
+	return result, err
 }
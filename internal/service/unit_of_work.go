@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ChinmayNoob/f1/internal/repository"
+)
+
+// inUnitOfWork runs fn inside uow and returns its result.
+func inUnitOfWork[T any](ctx context.Context, uow repository.UnitOfWork, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+	"github.com/ChinmayNoob/f1/internal/repository"
+	"github.com/ChinmayNoob/f1/internal/tracing"
+)
+
+type UsageService interface {
+	GetUsageReport(ctx context.Context, from, to time.Time, client, route string) (model.UsageReport, error)
+}
+
+type usageService struct {
+	repo repository.UsageRepository
+}
+
+func NewUsageService(repo repository.UsageRepository) UsageService {
+	return &usageService{repo: repo}
+}
+
+// GetUsageReport summarizes usage per client and route. Hours are whole, so
+// from is rounded down and to up to the hour.
+func (s *usageService) GetUsageReport(ctx context.Context, from, to time.Time, client, route string) (model.UsageReport, error) {
+	ctx, span := tracing.Start(ctx, "UsageService.GetUsageReport")
+	defer span.End()
+
+	from = from.UTC().Truncate(time.Hour)
+	if t := to.UTC().Truncate(time.Hour); t.Before(to) {
+		to = t.Add(time.Hour)
+	}
+
+	counters, err := s.repo.GetUsage(ctx, from, to, client, route)
+	if err != nil {
+		return model.UsageReport{}, err
+	}
+
+	type entryKey struct{ client, route string }
+	type totals struct {
+		entry    model.UsageReportEntry
+		duration float64
+		buckets  []int64
+	}
+	byKey := make(map[entryKey]*totals)
+	var order []entryKey
+
+	for _, c := range counters {
+		key := entryKey{c.Client, c.Route}
+		t := byKey[key]
+		if t == nil {
+			t = &totals{
+				entry: model.UsageReportEntry{
+					Client:   c.Client,
+					Route:    c.Route,
+					Statuses: make(map[string]int64),
+				},
+				buckets: make([]int64, len(model.LatencyBucketBoundsMs)+1),
+			}
+			byKey[key] = t
+			order = append(order, key)
+		}
+
+		t.entry.Requests += c.Requests
+		t.entry.Statuses[strconv.Itoa(c.Status)] += c.Requests
+		switch {
+		case c.Status >= 500:
+			t.entry.ServerErrors += c.Requests
+		case c.Status >= 400:
+			t.entry.ClientErrors += c.Requests
+		}
+		t.duration += c.TotalDurationMs
+		for i, n := range c.LatencyBuckets {
+			if i < len(t.buckets) {
+				t.buckets[i] += n
+			}
+		}
+	}
+
+	report := model.UsageReport{From: from, To: to, Entries: []model.UsageReportEntry{}}
+	for _, key := range order {
+		t := byKey[key]
+		e := t.entry
+		if e.Requests > 0 {
+			e.ErrorRate = float64(e.ClientErrors+e.ServerErrors) / float64(e.Requests)
+			e.AvgLatencyMs = t.duration / float64(e.Requests)
+		}
+		e.P50LatencyMs = latencyPercentile(t.buckets, e.Requests, 0.50)
+		e.P95LatencyMs = latencyPercentile(t.buckets, e.Requests, 0.95)
+		e.P99LatencyMs = latencyPercentile(t.buckets, e.Requests, 0.99)
+		report.Entries = append(report.Entries, e)
+	}
+	return report, nil
+}
+
+// latencyPercentile estimates a percentile from the latency histogram by
+// interpolating linearly inside the bucket it falls in. Values in the
+// overflow bucket are reported as the last bound.
+func latencyPercentile(buckets []int64, total int64, p float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	bounds := model.LatencyBucketBoundsMs
+	rank := p * float64(total)
+
+	var seen int64
+	for i, n := range buckets {
+		if n == 0 || float64(seen+n) < rank {
+			seen += n
+			continue
+		}
+		if i >= len(bounds) {
+			return bounds[len(bounds)-1]
+		}
+		lower := 0.0
+		if i > 0 {
+			lower = bounds[i-1]
+		}
+		return lower + (bounds[i]-lower)*(rank-float64(seen))/float64(n)
+	}
+	return bounds[len(bounds)-1]
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/model"
+)
+
+func TestLatencyPercentile(t *testing.T) {
+	// histogram returns a histogram with counts at the given bucket indexes;
+	// the last index is the overflow bucket.
+	histogram := func(counts map[int]int64) ([]int64, int64) {
+		buckets := make([]int64, len(model.LatencyBucketBoundsMs)+1)
+		var total int64
+		for i, n := range counts {
+			buckets[i] = n
+			total += n
+		}
+		return buckets, total
+	}
+	overflow := len(model.LatencyBucketBoundsMs)
+
+	tests := []struct {
+		name   string
+		counts map[int]int64
+		p      float64
+		want   float64
+	}{
+		{name: "empty histogram", p: 0.5, want: 0},
+		{name: "first bucket", counts: map[int]int64{0: 4}, p: 0.5, want: 2.5},
+		{name: "single bucket median", counts: map[int]int64{2: 10}, p: 0.5, want: 17.5},
+		{name: "single bucket p99", counts: map[int]int64{2: 10}, p: 0.99, want: 24.85},
+		{name: "single bucket max", counts: map[int]int64{2: 10}, p: 1, want: 25},
+		{name: "second of two buckets", counts: map[int]int64{0: 5, 1: 5}, p: 0.9, want: 9},
+		{name: "p99 in the overflow bucket", counts: map[int]int64{0: 98, overflow: 2}, p: 0.99, want: 10000},
+		{name: "median below the overflow bucket", counts: map[int]int64{0: 98, overflow: 2}, p: 0.5, want: 5 * 50.0 / 98},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buckets, total := histogram(tt.counts)
+			got := latencyPercentile(buckets, total, tt.p)
+			if diff := got - tt.want; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("latencyPercentile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Package tracing sets up OpenTelemetry tracing and creates the spans of the
+// HTTP, service and repository layers.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+
+	serviceName = "f1-api"
+)
+
+var tracer = otel.Tracer("github.com/ChinmayNoob/f1")
+
+// Setup installs the global tracer provider for exporter, which is "otlp",
+// "stdout" or "none". The OTLP exporter is configured by the standard
+// OTEL_EXPORTER_OTLP_* variables and sampling by OTEL_TRACES_SAMPLER. The
+// returned function flushes and stops the exporter.
+func Setup(ctx context.Context, exporter string) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var spanExporter sdktrace.SpanExporter
+	switch exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New()
+	case ExporterOTLP:
+		spanExporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span named name as a child of the span in ctx.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, opts...)
+}
+
+// Seq2 returns a sequence that runs in a span named name: the span starts
+// when the sequence is iterated, rather than when it is built, wraps the
+// sequence seq returns for the span's context, and ends when the iteration
+// does, recording the error that ended it, if any.
+func Seq2[T any](ctx context.Context, name string, seq func(ctx context.Context) iter.Seq2[T, error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, span := Start(ctx, name)
+		defer span.End()
+
+		for v, err := range seq(ctx) {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+}
@@ -7,7 +7,9 @@ const (
 	DEFAULT_PAGE_SIZE = 10
 )
 
-func ParsePagination(pageStr, limitStr string) (int, int) {
+// ParsePagination reads the page and limit query parameters, capping the
+// limit at maxLimit.
+func ParsePagination(pageStr, limitStr string, maxLimit int) (int, int) {
 	page, err := strconv.Atoi(pageStr)
 	if err != nil || page < 1 {
 		page = DEFAULT_PAGE
@@ -17,10 +19,15 @@ func ParsePagination(pageStr, limitStr string) (int, int) {
 	if err != nil || limit < 1 {
 		limit = DEFAULT_PAGE_SIZE
 	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
 
 	return page, limit
 }
 
+// Paginate appends the LIMIT and OFFSET of a page to query. The limit is
+// expected to be capped by ParsePagination already.
 func Paginate(query string, page, limit int) (string, error) {
 	if page < 1 {
 		page = DEFAULT_PAGE
@@ -2,38 +2,54 @@ package db
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"os"
+	"time"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/joho/godotenv"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-var Conn *pgx.Conn
+var Pool *pgxpool.Pool
 
-func InitDB(ctx context.Context) {
-	if err := godotenv.Load(); err != nil {
-		log.Println("No .env file found, using system environment variables")
-	}
+// Tracer, when set before InitDB, is called around every query run on Pool.
+var Tracer pgx.QueryTracer
 
-	user := os.Getenv("POSTGRES_USER")
-	password := os.Getenv("POSTGRES_PASSWORD")
-	host := os.Getenv("POSTGRES_HOST")
-	port := os.Getenv("POSTGRES_PORT")
-	dbname := os.Getenv("POSTGRES_DB")
+// Options configures the connection pool.
+type Options struct {
+	URL               string
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+	ConnectTimeout    time.Duration
+}
 
-	if user == "" || password == "" || host == "" || port == "" || dbname == "" {
-		log.Fatal("One or more required database connection parameters are missing")
+// InitDB opens Pool and checks that the database answers.
+func InitDB(ctx context.Context, opts Options) error {
+	config, err := pgxpool.ParseConfig(opts.URL)
+	if err != nil {
+		return err
 	}
+	config.MaxConns = opts.MaxConns
+	config.MinConns = opts.MinConns
+	config.MaxConnLifetime = opts.MaxConnLifetime
+	config.MaxConnIdleTime = opts.MaxConnIdleTime
+	config.HealthCheckPeriod = opts.HealthCheckPeriod
+	if opts.ConnectTimeout > 0 {
+		config.ConnConfig.ConnectTimeout = opts.ConnectTimeout
+	}
+	config.ConnConfig.Tracer = Tracer
 
-	databaseURL := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s", user, password, host, port, dbname)
-
-	var err error
-	Conn, err = pgx.Connect(ctx, databaseURL)
+	Pool, err = pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
-		log.Fatalf("Failed to connect to the database: %v", err)
+		return err
+	}
+	if err := Pool.Ping(ctx); err != nil {
+		Pool.Close()
+		return err
 	}
 
-	log.Println("Connected to Local Postgres")
+	log.Printf("Connected to %s", config.ConnConfig.Host)
+	return nil
 }
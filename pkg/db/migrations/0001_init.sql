@@ -0,0 +1,80 @@
+CREATE TABLE IF NOT EXISTS constructors (
+    id          UUID PRIMARY KEY,
+    ref         TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    nationality TEXT NOT NULL,
+    url         TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS drivers (
+    id             UUID PRIMARY KEY,
+    constructor_id UUID NOT NULL REFERENCES constructors (id),
+    ref            TEXT NOT NULL,
+    code           TEXT,
+    number         INT,
+    first_name     TEXT NOT NULL,
+    last_name      TEXT NOT NULL,
+    date_of_birth  DATE NOT NULL,
+    nationality    TEXT NOT NULL,
+    status         TEXT NOT NULL,
+    url            TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS circuits (
+    id        UUID PRIMARY KEY,
+    ref       TEXT NOT NULL,
+    name      TEXT NOT NULL,
+    location  TEXT NOT NULL,
+    country   TEXT NOT NULL,
+    "current" BOOLEAN NOT NULL DEFAULT false,
+    url       TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id   UUID PRIMARY KEY,
+    year INT NOT NULL,
+    url  TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS races (
+    id         UUID PRIMARY KEY,
+    season_id  UUID NOT NULL REFERENCES seasons (id),
+    circuit_id UUID NOT NULL REFERENCES circuits (id),
+    round      INT NOT NULL,
+    name       TEXT NOT NULL,
+    date       DATE NOT NULL,
+    url        TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS results (
+    id             UUID PRIMARY KEY,
+    race_id        UUID NOT NULL REFERENCES races (id),
+    driver_id      UUID NOT NULL REFERENCES drivers (id),
+    constructor_id UUID NOT NULL REFERENCES constructors (id),
+    number         INT NOT NULL,
+    grid           INT NOT NULL,
+    position       INT,
+    position_text  TEXT NOT NULL,
+    points         DOUBLE PRECISION NOT NULL DEFAULT 0,
+    laps           INT NOT NULL,
+    time           TEXT NOT NULL,
+    status         TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS driver_standings (
+    id        UUID PRIMARY KEY,
+    season_id UUID NOT NULL REFERENCES seasons (id),
+    driver_id UUID NOT NULL REFERENCES drivers (id),
+    position  INT NOT NULL,
+    points    DOUBLE PRECISION NOT NULL DEFAULT 0,
+    wins      INT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS constructor_standings (
+    id             UUID PRIMARY KEY,
+    season_id      UUID NOT NULL REFERENCES seasons (id),
+    constructor_id UUID NOT NULL REFERENCES constructors (id),
+    position       INT NOT NULL,
+    points         DOUBLE PRECISION NOT NULL DEFAULT 0,
+    wins           INT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,8 @@
+CREATE UNIQUE INDEX IF NOT EXISTS constructors_ref_key ON constructors (ref);
+CREATE UNIQUE INDEX IF NOT EXISTS drivers_ref_key ON drivers (ref);
+CREATE UNIQUE INDEX IF NOT EXISTS circuits_ref_key ON circuits (ref);
+CREATE UNIQUE INDEX IF NOT EXISTS seasons_year_key ON seasons (year);
+CREATE UNIQUE INDEX IF NOT EXISTS races_season_round_key ON races (season_id, round);
+CREATE UNIQUE INDEX IF NOT EXISTS results_race_driver_key ON results (race_id, driver_id);
+CREATE UNIQUE INDEX IF NOT EXISTS driver_standings_season_driver_key ON driver_standings (season_id, driver_id);
+CREATE UNIQUE INDEX IF NOT EXISTS constructor_standings_season_constructor_key ON constructor_standings (season_id, constructor_id);
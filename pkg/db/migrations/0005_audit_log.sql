@@ -0,0 +1,95 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id          BIGSERIAL PRIMARY KEY,
+    entity_type TEXT NOT NULL,
+    entity_id   UUID NOT NULL,
+    action      TEXT NOT NULL,
+    actor       TEXT NOT NULL,
+    request_id  TEXT NOT NULL DEFAULT '',
+    before      JSONB,
+    after       JSONB,
+    changes     JSONB,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity_type, entity_id, id);
+
+-- The actor and request ID are set per transaction by the unit of work with
+-- set_config('f1.actor', ...) and set_config('f1.request_id', ...).
+-- f1.audit_action overrides the derived action, e.g. for reverts.
+CREATE OR REPLACE FUNCTION audit_row_change() RETURNS trigger AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    row_action TEXT;
+    row_changes JSONB;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        new_row := to_jsonb(NEW);
+        row_action := 'create';
+    ELSIF TG_OP = 'UPDATE' THEN
+        IF NEW IS NOT DISTINCT FROM OLD THEN
+            RETURN NULL;
+        END IF;
+        old_row := to_jsonb(OLD);
+        new_row := to_jsonb(NEW);
+        row_action := CASE
+            WHEN OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN 'delete'
+            WHEN OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN 'restore'
+            ELSE 'update'
+        END;
+        SELECT jsonb_object_agg(n.key, jsonb_build_object('from', o.value, 'to', n.value))
+        INTO row_changes
+        FROM jsonb_each(new_row) n
+        INNER JOIN jsonb_each(old_row) o ON o.key = n.key
+        WHERE n.value IS DISTINCT FROM o.value;
+    ELSE
+        old_row := to_jsonb(OLD);
+        row_action := 'purge';
+    END IF;
+
+    INSERT INTO audit_log (entity_type, entity_id, action, actor, request_id, before, after, changes)
+    VALUES (
+        TG_TABLE_NAME,
+        (COALESCE(new_row, old_row) ->> 'id')::uuid,
+        COALESCE(NULLIF(current_setting('f1.audit_action', true), ''), row_action),
+        COALESCE(NULLIF(current_setting('f1.actor', true), ''), 'system'),
+        COALESCE(current_setting('f1.request_id', true), ''),
+        old_row,
+        new_row,
+        row_changes
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS constructors_audit ON constructors;
+CREATE TRIGGER constructors_audit AFTER INSERT OR UPDATE OR DELETE ON constructors
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change();
+
+DROP TRIGGER IF EXISTS drivers_audit ON drivers;
+CREATE TRIGGER drivers_audit AFTER INSERT OR UPDATE OR DELETE ON drivers
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change();
+
+DROP TRIGGER IF EXISTS circuits_audit ON circuits;
+CREATE TRIGGER circuits_audit AFTER INSERT OR UPDATE OR DELETE ON circuits
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change();
+
+DROP TRIGGER IF EXISTS seasons_audit ON seasons;
+CREATE TRIGGER seasons_audit AFTER INSERT OR UPDATE OR DELETE ON seasons
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change();
+
+DROP TRIGGER IF EXISTS races_audit ON races;
+CREATE TRIGGER races_audit AFTER INSERT OR UPDATE OR DELETE ON races
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change();
+
+DROP TRIGGER IF EXISTS results_audit ON results;
+CREATE TRIGGER results_audit AFTER INSERT OR UPDATE OR DELETE ON results
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change();
+
+DROP TRIGGER IF EXISTS driver_standings_audit ON driver_standings;
+CREATE TRIGGER driver_standings_audit AFTER INSERT OR UPDATE OR DELETE ON driver_standings
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change();
+
+DROP TRIGGER IF EXISTS constructor_standings_audit ON constructor_standings;
+CREATE TRIGGER constructor_standings_audit AFTER INSERT OR UPDATE OR DELETE ON constructor_standings
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change();
@@ -0,0 +1,8 @@
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS tier TEXT NOT NULL DEFAULT 'standard';
+
+CREATE TABLE IF NOT EXISTS daily_quota_usage (
+    client   TEXT NOT NULL,
+    day      DATE NOT NULL,
+    requests BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (client, day)
+);
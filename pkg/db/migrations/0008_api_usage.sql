@@ -0,0 +1,12 @@
+-- latency_buckets counts requests per latency bucket; the bucket bounds are
+-- model.LatencyBucketBoundsMs plus a final overflow bucket.
+CREATE TABLE IF NOT EXISTS api_usage_hourly (
+    hour              TIMESTAMPTZ NOT NULL,
+    client            TEXT NOT NULL,
+    route             TEXT NOT NULL,
+    status            INT NOT NULL,
+    requests          BIGINT NOT NULL,
+    total_duration_ms DOUBLE PRECISION NOT NULL,
+    latency_buckets   BIGINT[] NOT NULL,
+    PRIMARY KEY (hour, client, route, status)
+);
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// DBTX is implemented by both the pool and a transaction, so repository code
+// runs unchanged inside or outside a unit of work.
+type DBTX interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type txKey struct{}
+
+// Executor returns the transaction carried by ctx, or the pool when ctx is
+// not part of a transaction.
+func Executor(ctx context.Context) DBTX {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return Pool
+}
+
+// WithTx runs fn with a context bound to a transaction that is committed when
+// fn returns nil and rolled back otherwise. When ctx already carries a
+// transaction, fn runs in a savepoint of it instead.
+func WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := Executor(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,135 @@
+package f1client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Batch runs several API calls in one request. Operations that fail are
+// reported in their results rather than as an error.
+func (c *Client) Batch(ctx context.Context, batch BatchRequest) (*BatchResponse, error) {
+	return jsonCall[BatchResponse](ctx, c, api(http.MethodPost, "/batch", nil), batch)
+}
+
+// GraphQL runs a GraphQL query and decodes its data into data, which may be
+// nil. Errors the query reports are returned as GraphQLErrors, after the
+// data that did resolve is decoded.
+func (c *Client) GraphQL(ctx context.Context, query GraphQLRequest, data any) error {
+	req, err := api(http.MethodPost, "/graphql", nil).withJSON(query)
+	if err != nil {
+		return err
+	}
+	req.safe = true
+
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors GraphQLErrors   `json:"errors"`
+	}
+	if err := c.getJSON(ctx, req, &resp); err != nil {
+		return err
+	}
+	if data != nil && len(resp.Data) > 0 && string(resp.Data) != "null" {
+		if err := json.Unmarshal(resp.Data, data); err != nil {
+			return fmt.Errorf("f1client: decode graphql data: %w", err)
+		}
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+	return nil
+}
+
+// UsageQuery narrows a usage report. Zero values leave the API's defaults.
+type UsageQuery struct {
+	// From and To bound the window, by default the 24 hours up to now.
+	From time.Time
+	To   time.Time
+	// Client and Route keep only one caller or route, e.g.
+	// "GET /v1/drivers/{id}".
+	Client string
+	Route  string
+}
+
+// Usage reports API usage per caller and route. It needs the admin scope.
+func (c *Client) Usage(ctx context.Context, query UsageQuery) (*UsageReport, error) {
+	q := url.Values{}
+	if !query.From.IsZero() {
+		q.Set("from", query.From.Format(time.RFC3339))
+	}
+	if !query.To.IsZero() {
+		q.Set("to", query.To.Format(time.RFC3339))
+	}
+	setString(q, "client", query.Client)
+	setString(q, "route", query.Route)
+	return jsonCall[UsageReport](ctx, c, api(http.MethodGet, "/admin/usage", q), nil)
+}
+
+// QueryStats lists the statistics of the SQL statements the server ran. It
+// needs the admin scope.
+func (c *Client) QueryStats(ctx context.Context) ([]QueryStatement, error) {
+	return getList[QueryStatement](ctx, c, "/admin/queries")
+}
+
+// ExplainQuery explains statement id with the arguments of its last call.
+// With analyze the statement is run, in a transaction that is rolled back,
+// to report actual timings. It needs the admin scope.
+func (c *Client) ExplainQuery(ctx context.Context, id string, analyze bool) (*QueryPlan, error) {
+	q := url.Values{}
+	if analyze {
+		q.Set("analyze", strconv.FormatBool(analyze))
+	}
+	return jsonCall[QueryPlan](ctx, c, api(http.MethodGet, "/admin/queries/"+url.PathEscape(id)+"/explain", q), nil)
+}
+
+// Metrics returns the server's Prometheus metrics in the text exposition
+// format. It needs the admin scope.
+func (c *Client) Metrics(ctx context.Context) (string, error) {
+	req := newRequest(http.MethodGet, "/metrics", nil)
+	req.accept = "text/plain"
+	b, err := c.read(ctx, req)
+	return string(b), err
+}
+
+// Live reports whether the server is up.
+func (c *Client) Live(ctx context.Context) error {
+	return c.exec(ctx, newRequest(http.MethodGet, "/healthz", nil))
+}
+
+// Ready returns the readiness of the server and its dependencies. A server
+// that is not ready is reported in the result, with Status "not_ready",
+// rather than as an error.
+func (c *Client) Ready(ctx context.Context) (*Readiness, error) {
+	req := newRequest(http.MethodGet, "/readyz", nil)
+	req.resultStatus = http.StatusServiceUnavailable
+	return jsonCall[Readiness](ctx, c, req, nil)
+}
+
+// OpenAPI returns the OpenAPI 3.1 document of the API.
+func (c *Client) OpenAPI(ctx context.Context) (json.RawMessage, error) {
+	b, err := c.read(ctx, newRequest(http.MethodGet, "/openapi.json", nil))
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(b), nil
+}
+
+// read sends req and returns the whole response body.
+func (c *Client) read(ctx context.Context, req *request) ([]byte, error) {
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("f1client: read %s response: %w", req.path, err)
+	}
+	return b, nil
+}
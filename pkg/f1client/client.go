@@ -0,0 +1,357 @@
+// Package f1client is a Go client of the F1 API.
+//
+//	c, err := f1client.NewClient("https://f1.example.com", f1client.WithAPIKey(key))
+//	driver, err := c.Drivers.Get(ctx, "hamilton")
+//	for circuit, err := range c.Circuits.All(ctx, f1client.CircuitFilter{}, f1client.ListOptions{}) { ... }
+//
+// Every method takes a context, which bounds the request and its retries.
+// Failed requests return an *Error, which matches the Err* sentinels with
+// errors.Is.
+package f1client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIPrefix is the path the API is served under.
+const APIPrefix = "/v1"
+
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	auth       func(ctx context.Context, req *http.Request) error
+	retry      RetryPolicy
+	userAgent  string
+
+	Constructors         *ConstructorsResource
+	Drivers              *DriversResource
+	Circuits             *CircuitsResource
+	Seasons              *SeasonsResource
+	Races                *RacesResource
+	Results              *ResultsResource
+	DriverStandings      *DriverStandingsResource
+	ConstructorStandings *ConstructorStandingsResource
+}
+
+// RetryPolicy sets how failed requests are retried. Requests are retried on
+// network errors, 429, 502, 503 and 504, waiting for the Retry-After the
+// server sends or else backing off exponentially with jitter.
+type RetryPolicy struct {
+	// MaxAttempts is the most times a request is sent, 1 to not retry.
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry. It doubles with every
+	// retry, up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the policy of clients made without WithRetry.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  200 * time.Millisecond,
+	MaxBackoff:  5 * time.Second,
+}
+
+type Option func(*Client)
+
+// WithHTTPClient sends requests with hc instead of http.DefaultClient.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithAPIKey authenticates requests with an API key.
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.auth = func(_ context.Context, req *http.Request) error {
+			req.Header.Set("X-API-Key", key)
+			return nil
+		}
+	}
+}
+
+// WithBearerToken authenticates requests with a JWT or an API key sent as a
+// bearer token.
+func WithBearerToken(token string) Option {
+	return WithTokenSource(func(context.Context) (string, error) { return token, nil })
+}
+
+// WithTokenSource authenticates requests with a bearer token that token
+// returns, for tokens that expire. It is called for every attempt.
+func WithTokenSource(token func(ctx context.Context) (string, error)) Option {
+	return func(c *Client) {
+		c.auth = func(ctx context.Context, req *http.Request) error {
+			t, err := token(ctx)
+			if err != nil {
+				return fmt.Errorf("f1client: get token: %w", err)
+			}
+			req.Header.Set("Authorization", "Bearer "+t)
+			return nil
+		}
+	}
+}
+
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) { c.retry = policy }
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) { c.userAgent = userAgent }
+}
+
+// NewClient returns a client of the API served at baseURL, e.g.
+// http://localhost:8080.
+func NewClient(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("f1client: invalid base URL %q", baseURL)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+
+	c := &Client{
+		baseURL:    u,
+		httpClient: http.DefaultClient,
+		auth:       func(context.Context, *http.Request) error { return nil },
+		retry:      DefaultRetryPolicy,
+		userAgent:  "f1client",
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.retry.MaxAttempts = max(c.retry.MaxAttempts, 1)
+
+	c.Constructors = &ConstructorsResource{Collection[Constructor]{Resource[Constructor]{c: c, path: "/constructors"}}}
+	c.Drivers = &DriversResource{Collection[Driver]{Resource[Driver]{c: c, path: "/drivers"}}}
+	c.Circuits = &CircuitsResource{Collection[Circuit]{Resource[Circuit]{c: c, path: "/circuits"}}}
+	c.Seasons = &SeasonsResource{Resource[Season]{c: c, path: "/seasons"}}
+	c.Races = &RacesResource{Resource[Race]{c: c, path: "/races"}}
+	c.Results = &ResultsResource{Resource[Result]{c: c, path: "/results"}}
+	c.DriverStandings = &DriverStandingsResource{Resource[DriverStanding]{c: c, path: "/driver-standings"}}
+	c.ConstructorStandings = &ConstructorStandingsResource{Resource[ConstructorStanding]{c: c, path: "/constructor-standings"}}
+	return c, nil
+}
+
+// request is one API call. Its body is encoded once, so that it can be sent
+// again on retries.
+type request struct {
+	method string
+	// path is relative to the base URL, with escaped segments. It includes
+	// APIPrefix for API routes.
+	path        string
+	query       url.Values
+	body        []byte
+	contentType string
+	accept      string
+	// resultStatus is an error status whose body is a result all the same,
+	// such as the 422 of a rolled back bulk upsert.
+	resultStatus int
+	// safe marks a POST that changes nothing, such as a GraphQL query, which
+	// can be retried as it is.
+	safe bool
+}
+
+func newRequest(method, path string, query url.Values) *request {
+	return &request{method: method, path: path, query: query, accept: "application/json"}
+}
+
+// api returns a request to an API route, path being relative to APIPrefix.
+func api(method, path string, query url.Values) *request {
+	return newRequest(method, APIPrefix+path, query)
+}
+
+func (r *request) withJSON(body any) (*request, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("f1client: encode request: %w", err)
+	}
+	r.body, r.contentType = b, "application/json"
+	return r, nil
+}
+
+// do sends req, retrying it as the policy allows, and returns the response
+// of the first attempt that is not retried. Responses of 400 and above are
+// returned as an *Error. The caller closes the body.
+func (c *Client) do(ctx context.Context, req *request) (*http.Response, error) {
+	// POST requests are not idempotent by themselves, so they carry a key
+	// that lets the server recognize a retry and replay its first answer.
+	var idempotencyKey string
+	if req.method == http.MethodPost && !req.safe {
+		idempotencyKey = uuid.NewString()
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, req, idempotencyKey)
+		if err == nil && (resp.StatusCode < 400 || resp.StatusCode == req.resultStatus) {
+			return resp, nil
+		}
+		if err == nil {
+			err = newError(resp)
+		}
+		if attempt >= c.retry.MaxAttempts || !retryable(ctx, err) {
+			return nil, err
+		}
+
+		timer := time.NewTimer(c.backoff(attempt, err))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, req *request, idempotencyKey string) (*http.Response, error) {
+	// Paths are built with escaped segments, so that a ref cannot add one.
+	u := *c.baseURL
+	u.RawPath = c.baseURL.EscapedPath() + req.path
+	u.Path, _ = url.PathUnescape(u.RawPath)
+	u.RawQuery = req.query.Encode()
+
+	var body io.Reader
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("f1client: %w", err)
+	}
+	if req.contentType != "" {
+		httpReq.Header.Set("Content-Type", req.contentType)
+	}
+	if req.accept != "" {
+		httpReq.Header.Set("Accept", req.accept)
+	}
+	if idempotencyKey != "" {
+		httpReq.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	httpReq.Header.Set("User-Agent", c.userAgent)
+	if err := c.auth(ctx, httpReq); err != nil {
+		return nil, err
+	}
+	return c.httpClient.Do(httpReq)
+}
+
+// retryable reports whether a request that failed with err may succeed if
+// it is sent again.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		// The request did not get an answer.
+		return true
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before retrying after attempt failed with
+// err.
+func (c *Client) backoff(attempt int, err error) time.Duration {
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+	wait := c.retry.MinBackoff << (attempt - 1)
+	if wait <= 0 || (c.retry.MaxBackoff > 0 && wait > c.retry.MaxBackoff) {
+		wait = c.retry.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// Full jitter spreads out the retries of clients that failed together.
+	return rand.N(wait) + 1
+}
+
+// getJSON sends req and decodes the JSON response into out.
+func (c *Client) getJSON(ctx context.Context, req *request, out any) error {
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decode(resp, out)
+}
+
+// exec sends req, for routes that answer without a body.
+func (c *Client) exec(ctx context.Context, req *request) error {
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func decode(resp *http.Response, out any) error {
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("f1client: decode %s response: %w", resp.Request.URL.Path, err)
+	}
+	return nil
+}
+
+// jsonCall sends body as JSON and decodes the response into a new R.
+func jsonCall[R any](ctx context.Context, c *Client, req *request, body any) (*R, error) {
+	if body != nil {
+		var err error
+		if req, err = req.withJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	var out R
+	if err := c.getJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListOptions select the page of a list. The API applies its defaults to
+// zero values.
+type ListOptions struct {
+	// Page is the page number, from 1.
+	Page int
+	// Limit is the page size, up to the server's maximum page size.
+	Limit int
+	// IncludeDeleted also lists soft-deleted records. It needs the admin
+	// scope.
+	IncludeDeleted bool
+}
+
+// apply returns the query of a list with filters.
+func (o ListOptions) apply(filters url.Values) url.Values {
+	q := url.Values{}
+	for name, values := range filters {
+		q[name] = values
+	}
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.IncludeDeleted {
+		q.Set("include_deleted", "true")
+	}
+	return q
+}
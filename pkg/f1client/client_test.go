@@ -0,0 +1,382 @@
+package f1client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var fastRetry = RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+// newTestClient returns a client of a test server serving h.
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	c, err := NewClient(srv.URL, append([]Option{WithRetry(fastRetry)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestGetSendsAPIKeyAndEscapesRefs(t *testing.T) {
+	id := uuid.New()
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-API-Key"); got != "secret" {
+			t.Errorf("X-API-Key = %q, want secret", got)
+		}
+		if r.URL.EscapedPath() != "/v1/drivers/max%2Fverstappen" {
+			t.Errorf("path = %q", r.URL.EscapedPath())
+		}
+		writeJSON(w, http.StatusOK, Driver{ID: id, LastName: "Verstappen"})
+	}, WithAPIKey("secret"))
+
+	driver, err := c.Drivers.Get(context.Background(), "max/verstappen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if driver.ID != id || driver.LastName != "Verstappen" {
+		t.Errorf("driver = %+v", driver)
+	}
+}
+
+func TestTokenSourceIsCalledForEveryAttempt(t *testing.T) {
+	var calls, tokens atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if got, want := r.Header.Get("Authorization"), "Bearer token-"+strconv.Itoa(int(n)); got != want {
+			t.Errorf("Authorization = %q, want %q", got, want)
+		}
+		if n == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeJSON(w, http.StatusOK, []Driver{})
+	}, WithTokenSource(func(context.Context) (string, error) {
+		return "token-" + strconv.Itoa(int(tokens.Add(1))), nil
+	}))
+
+	if _, err := c.Drivers.List(context.Background(), DriverFilter{}, ListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrorsMatchTheirStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusBadRequest, ErrBadRequest},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusUnprocessableEntity, ErrUnprocessable},
+		{http.StatusInternalServerError, ErrServer},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.status), func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-ID", "req-1")
+				http.Error(w, "Driver not found", tt.status)
+			})
+
+			_, err := c.Drivers.Get(context.Background(), uuid.NewString())
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			var apiErr *Error
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("err = %T, want *Error", err)
+			}
+			if apiErr.StatusCode != tt.status || apiErr.Message != "Driver not found" || apiErr.RequestID != "req-1" {
+				t.Errorf("err = %+v", apiErr)
+			}
+		})
+	}
+}
+
+func TestProblemDetailsError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"title":"Internal Server Error","status":500,"detail":"The server hit an unexpected error","request_id":"req-2"}`)
+	}, WithRetry(RetryPolicy{MaxAttempts: 1}))
+
+	err := c.Circuits.Delete(context.Background(), "monza")
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Message != "The server hit an unexpected error" || apiErr.RequestID != "req-2" {
+		t.Errorf("err = %#v", err)
+	}
+}
+
+func TestRetriesReuseTheIdempotencyKey(t *testing.T) {
+	var calls atomic.Int32
+	keys := make(chan string, 3)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		keys <- r.Header.Get("Idempotency-Key")
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "Rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		var constructor Constructor
+		json.NewDecoder(r.Body).Decode(&constructor)
+		writeJSON(w, http.StatusOK, constructor)
+	})
+
+	created, err := c.Constructors.Create(context.Background(), &Constructor{Name: "McLaren"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Name != "McLaren" {
+		t.Errorf("created = %+v", created)
+	}
+	close(keys)
+	first := <-keys
+	if first == "" {
+		t.Fatal("no Idempotency-Key sent")
+	}
+	for key := range keys {
+		if key != first {
+			t.Errorf("Idempotency-Key = %q on retry, want %q", key, first)
+		}
+	}
+}
+
+func TestRetriesStop(t *testing.T) {
+	t.Run("after MaxAttempts", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		})
+		if err := c.Live(context.Background()); !errors.Is(err, ErrServer) {
+			t.Errorf("err = %v, want ErrServer", err)
+		}
+		if got := calls.Load(); got != 3 {
+			t.Errorf("attempts = %d, want 3", got)
+		}
+	})
+
+	t.Run("on client errors", func(t *testing.T) {
+		var calls atomic.Int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+		})
+		if _, err := c.Drivers.Update(context.Background(), uuid.NewString(), &Driver{}); !errors.Is(err, ErrBadRequest) {
+			t.Errorf("err = %v, want ErrBadRequest", err)
+		}
+		if got := calls.Load(); got != 1 {
+			t.Errorf("attempts = %d, want 1", got)
+		}
+	})
+
+	t.Run("when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			cancel()
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}, WithRetry(RetryPolicy{MaxAttempts: 5, MinBackoff: time.Hour}))
+		if err := c.Live(ctx); !errors.Is(err, ErrServer) && !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v", err)
+		}
+	})
+}
+
+func TestAllPagesThroughTheList(t *testing.T) {
+	const total, maxPageSize = 25, 10
+	var pages atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		pages.Add(1)
+		q := r.URL.Query()
+		if q.Get("nationality") != "British" {
+			t.Errorf("nationality = %q", q.Get("nationality"))
+		}
+		page, _ := strconv.Atoi(q.Get("page"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		limit = min(limit, maxPageSize)
+		drivers := []Driver{}
+		for i := (page - 1) * limit; i < min(page*limit, total); i++ {
+			drivers = append(drivers, Driver{Ref: strconv.Itoa(i)})
+		}
+		writeJSON(w, http.StatusOK, drivers)
+	})
+
+	var refs []string
+	for driver, err := range c.Drivers.All(context.Background(), DriverFilter{Nationality: "British"}, ListOptions{Limit: 20}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		refs = append(refs, driver.Ref)
+	}
+	if len(refs) != total || refs[0] != "0" || refs[total-1] != strconv.Itoa(total-1) {
+		t.Errorf("refs = %v", refs)
+	}
+	if got := pages.Load(); got != 3 {
+		t.Errorf("pages fetched = %d, want 3", got)
+	}
+}
+
+func TestAllStopsAtAnError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			http.Error(w, "Missing scope: admin", http.StatusForbidden)
+			return
+		}
+		writeJSON(w, http.StatusOK, []Constructor{{Ref: "ferrari"}, {Ref: "mclaren"}})
+	})
+
+	var refs []string
+	var err error
+	for constructor, e := range c.Constructors.All(context.Background(), ConstructorFilter{}, ListOptions{Limit: 2, IncludeDeleted: true}) {
+		if e != nil {
+			err = e
+			break
+		}
+		refs = append(refs, constructor.Ref)
+	}
+	if len(refs) != 2 || !errors.Is(err, ErrForbidden) {
+		t.Errorf("refs = %v, err = %v", refs, err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	stream := func(fail bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Accept"); got != "application/x-ndjson" {
+				t.Errorf("Accept = %q", got)
+			}
+			w.Header().Set("Trailer", "X-Stream-Error")
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			fmt.Fprintln(w, `{"ref":"monza"}`)
+			fmt.Fprintln(w, `{"ref":"spa"}`)
+			if fail {
+				fmt.Fprint(w, `{"ref":"suz`)
+				w.Header().Set("X-Stream-Error", "Internal Server Error")
+			}
+		}
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		c := newTestClient(t, stream(false))
+		var refs []string
+		for circuit, err := range c.Circuits.Stream(context.Background(), CircuitFilter{}) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			refs = append(refs, circuit.Ref)
+		}
+		if len(refs) != 2 {
+			t.Errorf("refs = %v", refs)
+		}
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		c := newTestClient(t, stream(true))
+		var refs []string
+		var err error
+		for circuit, e := range c.Circuits.Stream(context.Background(), CircuitFilter{}) {
+			if e != nil {
+				err = e
+				break
+			}
+			refs = append(refs, circuit.Ref)
+		}
+		if len(refs) != 2 || !errors.Is(err, ErrServer) {
+			t.Errorf("refs = %v, err = %v", refs, err)
+		}
+	})
+}
+
+func TestBulkUpsertReportsARollback(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("mode") != string(BulkModeAtomic) {
+			t.Errorf("mode = %q", r.URL.Query().Get("mode"))
+		}
+		writeJSON(w, http.StatusUnprocessableEntity, BulkResult{
+			Mode:   BulkModeAtomic,
+			Failed: 1,
+			Items:  []BulkItemResult{{Index: 0, Status: BulkItemFailed, Error: "duplicate"}},
+		})
+	})
+
+	result, err := c.Seasons.BulkUpsert(context.Background(), []Season{{Year: 2021}}, BulkModeAtomic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Failed != 1 || result.Items[0].Status != BulkItemFailed {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestGraphQL(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get("Idempotency-Key"); key != "" {
+			t.Errorf("query sent with Idempotency-Key %q", key)
+		}
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Variables["year"] != float64(2021) {
+			t.Errorf("variables = %v", req.Variables)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"data":   map[string]any{"season": map[string]any{"year": 2021}, "race": nil},
+			"errors": []GraphQLError{{Message: "invalid race ID", Path: []any{"race"}}},
+		})
+	})
+
+	var data struct {
+		Season struct{ Year int }
+	}
+	err := c.GraphQL(context.Background(), GraphQLRequest{
+		Query:     `query($year: Int!) { season(year: $year) { year } race(id: "x") { name } }`,
+		Variables: map[string]any{"year": 2021},
+	}, &data)
+	var gqlErrs GraphQLErrors
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) != 1 || gqlErrs[0].Message != "invalid race ID" {
+		t.Errorf("err = %v", err)
+	}
+	if data.Season.Year != 2021 {
+		t.Errorf("data = %+v", data)
+	}
+}
+
+func TestReadyReportsNotReady(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusServiceUnavailable, Readiness{Status: "not_ready"})
+	})
+
+	readiness, err := c.Ready(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if readiness.Status != "not_ready" {
+		t.Errorf("readiness = %+v", readiness)
+	}
+}
+
+func TestNewClientRejectsInvalidURLs(t *testing.T) {
+	for _, baseURL := range []string{"", "localhost:8080", "://"} {
+		if _, err := NewClient(baseURL); err == nil {
+			t.Errorf("NewClient(%q) succeeded", baseURL)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package f1client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The sentinels an *Error matches, by status code.
+var (
+	ErrBadRequest    = errors.New("bad request")
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrForbidden     = errors.New("forbidden")
+	ErrNotFound      = errors.New("not found")
+	ErrNotAcceptable = errors.New("not acceptable")
+	// ErrConflict is returned for records still referenced by others and
+	// for requests whose Idempotency-Key is still being processed.
+	ErrConflict = errors.New("conflict")
+	// ErrUnprocessable is returned for an Idempotency-Key reused with a
+	// different request.
+	ErrUnprocessable = errors.New("unprocessable")
+	ErrRateLimited   = errors.New("rate limited")
+	ErrServer        = errors.New("server error")
+)
+
+var statusErrors = map[int]error{
+	http.StatusBadRequest:          ErrBadRequest,
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusNotAcceptable:       ErrNotAcceptable,
+	http.StatusConflict:            ErrConflict,
+	http.StatusUnprocessableEntity: ErrUnprocessable,
+	http.StatusTooManyRequests:     ErrRateLimited,
+}
+
+// maxErrorBody bounds how much of an error response is read.
+const maxErrorBody = 64 << 10
+
+// Error is an error response of the API.
+type Error struct {
+	StatusCode int
+	// Message is the message the API answered with, e.g. "Driver not
+	// found".
+	Message string
+	// RequestID identifies the request in the server's logs.
+	RequestID string
+	// RetryAfter is how long the server asked the client to wait, for 429
+	// and 503 responses.
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("f1client: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("f1client: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Is matches the sentinel of e's status code.
+func (e *Error) Is(target error) bool {
+	if target == ErrServer {
+		return e.StatusCode >= 500
+	}
+	return statusErrors[e.StatusCode] == target
+}
+
+// newError reads the error response resp and closes its body. Errors are
+// plain text, except those of panics, which are problem details.
+func newError(resp *http.Response) *Error {
+	defer resp.Body.Close()
+
+	e := &Error{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-ID"),
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		e.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == "application/problem+json" {
+		var problem struct {
+			Detail    string `json:"detail"`
+			RequestID string `json:"request_id"`
+		}
+		if json.Unmarshal(body, &problem) == nil {
+			e.Message = problem.Detail
+			if e.RequestID == "" {
+				e.RequestID = problem.RequestID
+			}
+			return e
+		}
+	}
+	e.Message = strings.TrimSpace(string(body))
+	return e
+}
+
+// GraphQLErrors are the errors a GraphQL response reports. The data the
+// query did resolve is decoded all the same.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Message
+	}
+	return "f1client: graphql: " + strings.Join(messages, "; ")
+}
@@ -0,0 +1,53 @@
+package f1client
+
+import "github.com/ChinmayNoob/f1/internal/model"
+
+// The records of the API are the server's own model types, so that the
+// client and the server cannot disagree on them. They are aliased here
+// because packages outside this module cannot import internal/model.
+type (
+	Constructor         = model.Constructor
+	Driver              = model.Driver
+	Circuit             = model.Circuit
+	Season              = model.Season
+	Race                = model.Race
+	RaceWithResults     = model.RaceWithResults
+	Result              = model.Result
+	DriverStanding      = model.DriverStanding
+	ConstructorStanding = model.ConstructorStanding
+
+	AuditEntry  = model.AuditEntry
+	AuditAction = model.AuditAction
+
+	BulkMode       = model.BulkMode
+	BulkResult     = model.BulkResult
+	BulkItemResult = model.BulkItemResult
+	BulkItemStatus = model.BulkItemStatus
+
+	BatchRequest   = model.BatchRequest
+	BatchOperation = model.BatchOperation
+	BatchResponse  = model.BatchResponse
+	BatchResult    = model.BatchResult
+
+	GraphQLRequest  = model.GraphQLRequest
+	GraphQLError    = model.GraphQLError
+	GraphQLLocation = model.GraphQLLocation
+
+	UsageReport      = model.UsageReport
+	UsageReportEntry = model.UsageReportEntry
+	QueryStatement   = model.QueryStatement
+	QueryPlan        = model.QueryPlan
+	Readiness        = model.Readiness
+	DependencyStatus = model.DependencyStatus
+)
+
+const (
+	BulkModeAtomic     = model.BulkModeAtomic
+	BulkModeBestEffort = model.BulkModeBestEffort
+
+	BulkItemCreated    = model.BulkItemCreated
+	BulkItemUpdated    = model.BulkItemUpdated
+	BulkItemFailed     = model.BulkItemFailed
+	BulkItemRolledBack = model.BulkItemRolledBack
+	BulkItemSkipped    = model.BulkItemSkipped
+)
@@ -0,0 +1,191 @@
+package f1client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Resource serves the routes every collection of the API has. id is the
+// record's UUID or, where the API accepts one, its ref or year.
+type Resource[T any] struct {
+	c    *Client
+	path string
+}
+
+// Collection adds reading, creating and updating a record to Resource. The
+// seasons, races, results and standings collections do not have it: the
+// API registers those routes but does not implement them yet.
+type Collection[T any] struct {
+	Resource[T]
+}
+
+func (r Resource[T]) item(id string, segments ...string) string {
+	p := r.path + "/" + url.PathEscape(id)
+	for _, s := range segments {
+		p += "/" + url.PathEscape(s)
+	}
+	return p
+}
+
+func (r Collection[T]) Get(ctx context.Context, id string) (*T, error) {
+	return jsonCall[T](ctx, r.c, api(http.MethodGet, r.item(id), nil), nil)
+}
+
+func (r Collection[T]) Create(ctx context.Context, record *T) (*T, error) {
+	return jsonCall[T](ctx, r.c, api(http.MethodPost, r.path, nil), record)
+}
+
+func (r Collection[T]) Update(ctx context.Context, id string, record *T) (*T, error) {
+	return jsonCall[T](ctx, r.c, api(http.MethodPut, r.item(id), nil), record)
+}
+
+// Delete soft-deletes a record.
+func (r Resource[T]) Delete(ctx context.Context, id string) error {
+	return r.c.exec(ctx, api(http.MethodDelete, r.item(id), nil))
+}
+
+// Restore undoes the soft delete of a record. It needs the admin scope.
+func (r Resource[T]) Restore(ctx context.Context, id string) error {
+	return r.c.exec(ctx, api(http.MethodPost, r.item(id, "restore"), nil))
+}
+
+// Purge permanently removes a soft-deleted record. It needs the admin scope.
+func (r Resource[T]) Purge(ctx context.Context, id string) error {
+	return r.c.exec(ctx, api(http.MethodPost, r.item(id, "purge"), nil))
+}
+
+// BulkUpsert creates or updates records by their natural key. Items that
+// failed, and an atomic upsert that was rolled back, are reported in the
+// result rather than as an error.
+func (r Resource[T]) BulkUpsert(ctx context.Context, records []T, mode BulkMode) (*BulkResult, error) {
+	q := url.Values{}
+	if mode != "" {
+		q.Set("mode", string(mode))
+	}
+	req, err := api(http.MethodPost, r.path+"/bulk", q).withJSON(records)
+	if err != nil {
+		return nil, err
+	}
+	req.resultStatus = http.StatusUnprocessableEntity
+
+	var result BulkResult
+	if err := r.c.getJSON(ctx, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// History lists the recorded changes of a record.
+func (r Resource[T]) History(ctx context.Context, id string, opts ListOptions) ([]AuditEntry, error) {
+	var entries []AuditEntry
+	if err := r.c.getJSON(ctx, api(http.MethodGet, r.item(id, "history"), opts.apply(nil)), &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Revert sets a record back to how it was after the change version of its
+// history. It needs the admin scope.
+func (r Resource[T]) Revert(ctx context.Context, id string, version int64) (*T, error) {
+	return jsonCall[T](ctx, r.c, api(http.MethodPost, r.item(id, "history", strconv.FormatInt(version, 10), "revert"), nil), nil)
+}
+
+// list returns one page of the records matching filters.
+func (r Resource[T]) list(ctx context.Context, filters url.Values, opts ListOptions) ([]T, error) {
+	var records []T
+	if err := r.c.getJSON(ctx, api(http.MethodGet, r.path, opts.apply(filters)), &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// all yields the records matching filters, page after page from opts.Page.
+// Iteration ends at the first page shorter than the ones before it, so that
+// it also ends when the server caps opts.Limit to a smaller page size.
+func (r Resource[T]) all(ctx context.Context, filters url.Values, opts ListOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		opts.Page = max(opts.Page, 1)
+		pageSize := 0
+		for {
+			records, err := r.list(ctx, filters, opts)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, record := range records {
+				if !yield(record, nil) {
+					return
+				}
+			}
+			pageSize = max(pageSize, len(records))
+			if len(records) == 0 || len(records) < pageSize {
+				return
+			}
+			opts.Page++
+		}
+	}
+}
+
+// stream yields every record matching filters, unpaginated, as the server
+// reads them from the database. The server reports a failure after the
+// first record in a trailer, which is yielded as an error.
+func (r Resource[T]) stream(ctx context.Context, filters url.Values) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		req := api(http.MethodGet, r.path, filters)
+		req.accept = "application/x-ndjson"
+		resp, err := r.c.do(ctx, req)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer resp.Body.Close()
+
+		dec := json.NewDecoder(resp.Body)
+		for {
+			var record T
+			err := dec.Decode(&record)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				// A failed stream is cut short, usually mid-record.
+				if failed := streamError(resp); failed != nil {
+					err = failed
+				} else {
+					err = fmt.Errorf("f1client: decode %s stream: %w", r.path, err)
+				}
+				yield(zero, err)
+				return
+			}
+			if !yield(record, nil) {
+				return
+			}
+		}
+		if failed := streamError(resp); failed != nil {
+			yield(zero, failed)
+		}
+	}
+}
+
+// streamError returns the failure the trailer of a stream read to its end
+// reports, if any.
+func streamError(resp *http.Response) *Error {
+	failure := resp.Trailer.Get("X-Stream-Error")
+	if failure == "" {
+		return nil
+	}
+	return &Error{
+		StatusCode: http.StatusInternalServerError,
+		Message:    "stream failed: " + failure,
+		RequestID:  resp.Header.Get("X-Request-ID"),
+	}
+}
@@ -0,0 +1,104 @@
+package f1client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ChinmayNoob/f1/internal/handler"
+	"github.com/ChinmayNoob/f1/internal/openapi"
+	"github.com/ChinmayNoob/f1/internal/router"
+	"github.com/google/uuid"
+)
+
+// TestResourcesCallImplementedRoutes calls every method of every resource
+// and checks the route it sends to against the server's OpenAPI document,
+// so that the client keeps to the routes the API implements.
+func TestResourcesCallImplementedRoutes(t *testing.T) {
+	doc := openapi.Build(router.SetupRoutes(http.NewServeMux(), nil, nil, nil, nil, nil, nil, nil, nil, nil, &handler.QueryStatsHandler{}, http.NotFoundHandler(), nil, &handler.GraphQLHandler{}))
+	documented := http.NewServeMux()
+	for path, item := range doc.Paths {
+		for method := range item {
+			documented.Handle(strings.ToUpper(method)+" "+path, http.NotFoundHandler())
+		}
+	}
+
+	var mu sync.Mutex
+	var requests []*http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r)
+		mu.Unlock()
+		writeJSON(w, http.StatusOK, nil)
+	}))
+	t.Cleanup(srv.Close)
+	c, err := NewClient(srv.URL, WithRetry(RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := reflect.ValueOf(c).Elem()
+	for i := range client.NumField() {
+		resource := client.Field(i)
+		if !strings.HasSuffix(resource.Type().String(), "Resource") {
+			continue
+		}
+		for j := range resource.NumMethod() {
+			name := client.Type().Field(i).Name + "." + resource.Type().Method(j).Name
+			mu.Lock()
+			requests = nil
+			mu.Unlock()
+			call(resource.Method(j))
+
+			mu.Lock()
+			sent := requests
+			mu.Unlock()
+			if len(sent) != 1 {
+				t.Errorf("%s sent %d requests, want 1", name, len(sent))
+				continue
+			}
+			r := sent[0]
+			_, pattern := documented.Handler(r)
+			method, path, _ := strings.Cut(pattern, " ")
+			op := doc.Paths[path][strings.ToLower(method)]
+			switch {
+			case op == nil:
+				t.Errorf("%s calls %s %s, which the API does not serve", name, r.Method, r.URL.Path)
+			case op.Unimplemented:
+				t.Errorf("%s calls %s, which the API does not implement yet", name, pattern)
+			}
+		}
+	}
+}
+
+// call calls method with an argument of each parameter's type and reads
+// the iterator it returns, if any, up to its first value.
+func call(method reflect.Value) {
+	args := make([]reflect.Value, method.Type().NumIn())
+	for i := range args {
+		switch t := method.Type().In(i); {
+		case t == reflect.TypeFor[context.Context]():
+			args[i] = reflect.ValueOf(context.Background())
+		case t == reflect.TypeFor[uuid.UUID]():
+			args[i] = reflect.ValueOf(uuid.New())
+		case t.Kind() == reflect.String:
+			args[i] = reflect.ValueOf("hamilton").Convert(t)
+		case t.Kind() == reflect.Int || t.Kind() == reflect.Int64:
+			args[i] = reflect.ValueOf(1).Convert(t)
+		default:
+			args[i] = reflect.Zero(t)
+		}
+	}
+
+	results := method.Call(args)
+	if seq := results[0]; seq.Kind() == reflect.Func {
+		yield := reflect.MakeFunc(seq.Type().In(0), func([]reflect.Value) []reflect.Value {
+			return []reflect.Value{reflect.ValueOf(false)}
+		})
+		seq.Call([]reflect.Value{yield})
+	}
+}
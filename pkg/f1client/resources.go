@@ -0,0 +1,220 @@
+package f1client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// The API pages a list by the first of its filters that is set, in the
+// order of the filter's fields; streams apply every filter that is set.
+
+type ConstructorFilter struct {
+	Name        string
+	Nationality string
+}
+
+func (f ConstructorFilter) values() url.Values {
+	q := url.Values{}
+	setString(q, "name", f.Name)
+	setString(q, "nationality", f.Nationality)
+	return q
+}
+
+type DriverFilter struct {
+	FirstName string
+	LastName  string
+	// Team is the name of the driver's constructor.
+	Team        string
+	Nationality string
+	Status      string
+}
+
+func (f DriverFilter) values() url.Values {
+	q := url.Values{}
+	setString(q, "firstName", f.FirstName)
+	setString(q, "lastName", f.LastName)
+	setString(q, "team", f.Team)
+	setString(q, "nationality", f.Nationality)
+	setString(q, "status", f.Status)
+	return q
+}
+
+type CircuitFilter struct {
+	Name     string
+	Location string
+	Country  string
+	// Current lists the circuits on the current calendar, or those off it.
+	// The API does not page this filter.
+	Current *bool
+}
+
+func (f CircuitFilter) values() url.Values {
+	q := url.Values{}
+	setString(q, "name", f.Name)
+	setString(q, "location", f.Location)
+	setString(q, "country", f.Country)
+	if f.Current != nil {
+		q.Set("current", strconv.FormatBool(*f.Current))
+	}
+	return q
+}
+
+func setString(q url.Values, name, value string) {
+	if value != "" {
+		q.Set(name, value)
+	}
+}
+
+// ConstructorsResource serves /constructors. Constructors can be addressed
+// by ref as well as by ID.
+type ConstructorsResource struct {
+	Collection[Constructor]
+}
+
+func (r *ConstructorsResource) List(ctx context.Context, filter ConstructorFilter, opts ListOptions) ([]Constructor, error) {
+	return r.list(ctx, filter.values(), opts)
+}
+
+// All yields every constructor matching filter, fetching a page at a time.
+func (r *ConstructorsResource) All(ctx context.Context, filter ConstructorFilter, opts ListOptions) iter.Seq2[Constructor, error] {
+	return r.all(ctx, filter.values(), opts)
+}
+
+// Stream yields every constructor matching filter from a single streamed
+// response.
+func (r *ConstructorsResource) Stream(ctx context.Context, filter ConstructorFilter) iter.Seq2[Constructor, error] {
+	return r.stream(ctx, filter.values())
+}
+
+// DriversResource serves /drivers. Drivers can be addressed by ref as well
+// as by ID.
+type DriversResource struct {
+	Collection[Driver]
+}
+
+func (r *DriversResource) List(ctx context.Context, filter DriverFilter, opts ListOptions) ([]Driver, error) {
+	return r.list(ctx, filter.values(), opts)
+}
+
+// All yields every driver matching filter, fetching a page at a time.
+func (r *DriversResource) All(ctx context.Context, filter DriverFilter, opts ListOptions) iter.Seq2[Driver, error] {
+	return r.all(ctx, filter.values(), opts)
+}
+
+// Stream yields every driver matching filter from a single streamed
+// response.
+func (r *DriversResource) Stream(ctx context.Context, filter DriverFilter) iter.Seq2[Driver, error] {
+	return r.stream(ctx, filter.values())
+}
+
+// Merge moves the results of driver id to the driver into, recomputing the
+// affected standings, and deletes driver id. It returns the merged driver.
+func (r *DriversResource) Merge(ctx context.Context, id string, into uuid.UUID) (*Driver, error) {
+	body := struct {
+		Into uuid.UUID `json:"into"`
+	}{into}
+	return jsonCall[Driver](ctx, r.c, api(http.MethodPost, r.item(id, "merge"), nil), body)
+}
+
+// CircuitsResource serves /circuits. Circuits can be addressed by ref as
+// well as by ID.
+type CircuitsResource struct {
+	Collection[Circuit]
+}
+
+func (r *CircuitsResource) List(ctx context.Context, filter CircuitFilter, opts ListOptions) ([]Circuit, error) {
+	return r.list(ctx, filter.values(), opts)
+}
+
+// All yields every circuit matching filter, fetching a page at a time.
+func (r *CircuitsResource) All(ctx context.Context, filter CircuitFilter, opts ListOptions) iter.Seq2[Circuit, error] {
+	return r.all(ctx, filter.values(), opts)
+}
+
+// Stream yields every circuit matching filter from a single streamed
+// response.
+func (r *CircuitsResource) Stream(ctx context.Context, filter CircuitFilter) iter.Seq2[Circuit, error] {
+	return r.stream(ctx, filter.values())
+}
+
+// SeasonsResource serves /seasons. Seasons can be addressed by year as well
+// as by ID, and their races by round. The API does not list, get or update
+// seasons yet, so they are read through their races and standings.
+type SeasonsResource struct {
+	Resource[Season]
+}
+
+// Races lists the races of the season of year.
+func (r *SeasonsResource) Races(ctx context.Context, year int) ([]Race, error) {
+	return getList[Race](ctx, r.c, r.season(year, "races"))
+}
+
+// Race returns the race of round in the season of year.
+func (r *SeasonsResource) Race(ctx context.Context, year, round int) (*Race, error) {
+	return jsonCall[Race](ctx, r.c, api(http.MethodGet, r.season(year, "races", strconv.Itoa(round)), nil), nil)
+}
+
+// RaceResults lists the results of the race of round in the season of year.
+func (r *SeasonsResource) RaceResults(ctx context.Context, year, round int) ([]Result, error) {
+	return getList[Result](ctx, r.c, r.season(year, "races", strconv.Itoa(round), "results"))
+}
+
+func (r *SeasonsResource) DriverStandings(ctx context.Context, year int) ([]DriverStanding, error) {
+	return getList[DriverStanding](ctx, r.c, r.season(year, "driver-standings"))
+}
+
+func (r *SeasonsResource) ConstructorStandings(ctx context.Context, year int) ([]ConstructorStanding, error) {
+	return getList[ConstructorStanding](ctx, r.c, r.season(year, "constructor-standings"))
+}
+
+func (r *SeasonsResource) season(year int, segments ...string) string {
+	return r.item(strconv.Itoa(year), segments...)
+}
+
+// RacesResource serves /races. The API does not list, get or update races
+// yet; they are read through their season.
+type RacesResource struct {
+	Resource[Race]
+}
+
+// Create creates a race together with its results.
+func (r *RacesResource) Create(ctx context.Context, race *RaceWithResults) (*RaceWithResults, error) {
+	return jsonCall[RaceWithResults](ctx, r.c, api(http.MethodPost, r.path, nil), race)
+}
+
+// Results lists the results of race id.
+func (r *RacesResource) Results(ctx context.Context, id string) ([]Result, error) {
+	return getList[Result](ctx, r.c, r.item(id, "results"))
+}
+
+// ResultsResource serves /results. The API does not list, get, create or
+// update results yet; they are read through their race and created with it.
+type ResultsResource struct {
+	Resource[Result]
+}
+
+// DriverStandingsResource serves /driver-standings. The API does not list,
+// get, create or update standings yet; they are read through their season.
+type DriverStandingsResource struct {
+	Resource[DriverStanding]
+}
+
+// ConstructorStandingsResource serves /constructor-standings, as
+// DriverStandingsResource serves /driver-standings.
+type ConstructorStandingsResource struct {
+	Resource[ConstructorStanding]
+}
+
+// getList returns the unpaged list served at path.
+func getList[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	var records []T
+	if err := c.getJSON(ctx, api(http.MethodGet, path, nil), &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,2560 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: f1/v1/f1.proto
+
+package f1v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetRequest addresses a record by ID or, where the REST route accepts one,
+// by ref.
+type GetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Also find the record if it is soft-deleted. Requires the admin scope.
+	IncludeDeleted bool `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// RecordRequest addresses a record to delete, restore or purge, by ID or,
+// where the REST route accepts one, by ref.
+type RecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRequest) Reset() {
+	*x = RecordRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRequest) ProtoMessage() {}
+
+func (x *RecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRequest.ProtoReflect.Descriptor instead.
+func (*RecordRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type HistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRequest) ProtoMessage() {}
+
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *HistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type HistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryResponse) ProtoMessage() {}
+
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HistoryResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RevertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the history entry to revert to.
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertRequest) Reset() {
+	*x = RevertRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertRequest) ProtoMessage() {}
+
+func (x *RevertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertRequest.ProtoReflect.Descriptor instead.
+func (*RevertRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RevertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RevertResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The record as stored after the revert.
+	Record        *structpb.Struct `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertResponse) Reset() {
+	*x = RevertResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertResponse) ProtoMessage() {}
+
+func (x *RevertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertResponse.ProtoReflect.Descriptor instead.
+func (*RevertResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevertResponse) GetRecord() *structpb.Struct {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type SeasonRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Year           int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SeasonRequest) Reset() {
+	*x = SeasonRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonRequest) ProtoMessage() {}
+
+func (x *SeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonRequest.ProtoReflect.Descriptor instead.
+func (*SeasonRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SeasonRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SeasonRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type SeasonRaceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Year           int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Round          int32                  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SeasonRaceRequest) Reset() {
+	*x = SeasonRaceRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonRaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonRaceRequest) ProtoMessage() {}
+
+func (x *SeasonRaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonRaceRequest.ProtoReflect.Descriptor instead.
+func (*SeasonRaceRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SeasonRaceRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SeasonRaceRequest) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *SeasonRaceRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListConstructorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// As with the REST query parameters, a page is filtered by one field.
+	//
+	// Types that are valid to be assigned to Filter:
+	//
+	//	*ListConstructorsRequest_Name
+	//	*ListConstructorsRequest_Nationality
+	Filter         isListConstructorsRequest_Filter `protobuf_oneof:"filter"`
+	Page           int32                            `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                            `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeDeleted bool                             `protobuf:"varint,12,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListConstructorsRequest) Reset() {
+	*x = ListConstructorsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConstructorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConstructorsRequest) ProtoMessage() {}
+
+func (x *ListConstructorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConstructorsRequest.ProtoReflect.Descriptor instead.
+func (*ListConstructorsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListConstructorsRequest) GetFilter() isListConstructorsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListConstructorsRequest) GetName() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListConstructorsRequest_Name); ok {
+			return x.Name
+		}
+	}
+	return ""
+}
+
+func (x *ListConstructorsRequest) GetNationality() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListConstructorsRequest_Nationality); ok {
+			return x.Nationality
+		}
+	}
+	return ""
+}
+
+func (x *ListConstructorsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListConstructorsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListConstructorsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type isListConstructorsRequest_Filter interface {
+	isListConstructorsRequest_Filter()
+}
+
+type ListConstructorsRequest_Name struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+
+type ListConstructorsRequest_Nationality struct {
+	Nationality string `protobuf:"bytes,2,opt,name=nationality,proto3,oneof"`
+}
+
+func (*ListConstructorsRequest_Name) isListConstructorsRequest_Filter() {}
+
+func (*ListConstructorsRequest_Nationality) isListConstructorsRequest_Filter() {}
+
+type ListConstructorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Constructors  []*Constructor         `protobuf:"bytes,1,rep,name=constructors,proto3" json:"constructors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConstructorsResponse) Reset() {
+	*x = ListConstructorsResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConstructorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConstructorsResponse) ProtoMessage() {}
+
+func (x *ListConstructorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConstructorsResponse.ProtoReflect.Descriptor instead.
+func (*ListConstructorsResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListConstructorsResponse) GetConstructors() []*Constructor {
+	if x != nil {
+		return x.Constructors
+	}
+	return nil
+}
+
+type StreamConstructorsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Nationality    string                 `protobuf:"bytes,2,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Ref            string                 `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamConstructorsRequest) Reset() {
+	*x = StreamConstructorsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamConstructorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamConstructorsRequest) ProtoMessage() {}
+
+func (x *StreamConstructorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamConstructorsRequest.ProtoReflect.Descriptor instead.
+func (*StreamConstructorsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamConstructorsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamConstructorsRequest) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *StreamConstructorsRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *StreamConstructorsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateConstructorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Constructor   *Constructor           `protobuf:"bytes,2,opt,name=constructor,proto3" json:"constructor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConstructorRequest) Reset() {
+	*x = UpdateConstructorRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConstructorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConstructorRequest) ProtoMessage() {}
+
+func (x *UpdateConstructorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConstructorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConstructorRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateConstructorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateConstructorRequest) GetConstructor() *Constructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return nil
+}
+
+type BulkUpsertConstructorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Constructors  []*Constructor         `protobuf:"bytes,1,rep,name=constructors,proto3" json:"constructors,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertConstructorsRequest) Reset() {
+	*x = BulkUpsertConstructorsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertConstructorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertConstructorsRequest) ProtoMessage() {}
+
+func (x *BulkUpsertConstructorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertConstructorsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertConstructorsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BulkUpsertConstructorsRequest) GetConstructors() []*Constructor {
+	if x != nil {
+		return x.Constructors
+	}
+	return nil
+}
+
+func (x *BulkUpsertConstructorsRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type ListDriversRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// As with the REST query parameters, a page is filtered by one field.
+	//
+	// Types that are valid to be assigned to Filter:
+	//
+	//	*ListDriversRequest_FirstName
+	//	*ListDriversRequest_LastName
+	//	*ListDriversRequest_Team
+	//	*ListDriversRequest_Nationality
+	//	*ListDriversRequest_Status
+	Filter         isListDriversRequest_Filter `protobuf_oneof:"filter"`
+	Page           int32                       `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                       `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeDeleted bool                        `protobuf:"varint,12,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDriversRequest) Reset() {
+	*x = ListDriversRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriversRequest) ProtoMessage() {}
+
+func (x *ListDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriversRequest.ProtoReflect.Descriptor instead.
+func (*ListDriversRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListDriversRequest) GetFilter() isListDriversRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDriversRequest) GetFirstName() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListDriversRequest_FirstName); ok {
+			return x.FirstName
+		}
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetLastName() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListDriversRequest_LastName); ok {
+			return x.LastName
+		}
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetTeam() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListDriversRequest_Team); ok {
+			return x.Team
+		}
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetNationality() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListDriversRequest_Nationality); ok {
+			return x.Nationality
+		}
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetStatus() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListDriversRequest_Status); ok {
+			return x.Status
+		}
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDriversRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDriversRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type isListDriversRequest_Filter interface {
+	isListDriversRequest_Filter()
+}
+
+type ListDriversRequest_FirstName struct {
+	FirstName string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3,oneof"`
+}
+
+type ListDriversRequest_LastName struct {
+	LastName string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3,oneof"`
+}
+
+type ListDriversRequest_Team struct {
+	// The constructor name.
+	Team string `protobuf:"bytes,3,opt,name=team,proto3,oneof"`
+}
+
+type ListDriversRequest_Nationality struct {
+	Nationality string `protobuf:"bytes,4,opt,name=nationality,proto3,oneof"`
+}
+
+type ListDriversRequest_Status struct {
+	Status string `protobuf:"bytes,5,opt,name=status,proto3,oneof"`
+}
+
+func (*ListDriversRequest_FirstName) isListDriversRequest_Filter() {}
+
+func (*ListDriversRequest_LastName) isListDriversRequest_Filter() {}
+
+func (*ListDriversRequest_Team) isListDriversRequest_Filter() {}
+
+func (*ListDriversRequest_Nationality) isListDriversRequest_Filter() {}
+
+func (*ListDriversRequest_Status) isListDriversRequest_Filter() {}
+
+type ListDriversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drivers       []*Driver              `protobuf:"bytes,1,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriversResponse) Reset() {
+	*x = ListDriversResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriversResponse) ProtoMessage() {}
+
+func (x *ListDriversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriversResponse.ProtoReflect.Descriptor instead.
+func (*ListDriversResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListDriversResponse) GetDrivers() []*Driver {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+type StreamDriversRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FirstName      string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Team           string                 `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`
+	Nationality    string                 `protobuf:"bytes,4,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Ref            string                 `protobuf:"bytes,6,opt,name=ref,proto3" json:"ref,omitempty"`
+	Code           string                 `protobuf:"bytes,7,opt,name=code,proto3" json:"code,omitempty"`
+	Number         *int32                 `protobuf:"varint,8,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	Url            string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,10,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamDriversRequest) Reset() {
+	*x = StreamDriversRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDriversRequest) ProtoMessage() {}
+
+func (x *StreamDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDriversRequest.ProtoReflect.Descriptor instead.
+func (*StreamDriversRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StreamDriversRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *StreamDriversRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *StreamDriversRequest) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *StreamDriversRequest) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *StreamDriversRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StreamDriversRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *StreamDriversRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StreamDriversRequest) GetNumber() int32 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+func (x *StreamDriversRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StreamDriversRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Driver        *Driver                `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDriverRequest) Reset() {
+	*x = UpdateDriverRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDriverRequest) ProtoMessage() {}
+
+func (x *UpdateDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDriverRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDriverRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateDriverRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type MergeDriversRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The driver merged away, by ID or ref.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The driver that remains, by ID.
+	Into          string `protobuf:"bytes,2,opt,name=into,proto3" json:"into,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDriversRequest) Reset() {
+	*x = MergeDriversRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDriversRequest) ProtoMessage() {}
+
+func (x *MergeDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDriversRequest.ProtoReflect.Descriptor instead.
+func (*MergeDriversRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MergeDriversRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MergeDriversRequest) GetInto() string {
+	if x != nil {
+		return x.Into
+	}
+	return ""
+}
+
+type BulkUpsertDriversRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drivers       []*Driver              `protobuf:"bytes,1,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertDriversRequest) Reset() {
+	*x = BulkUpsertDriversRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertDriversRequest) ProtoMessage() {}
+
+func (x *BulkUpsertDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertDriversRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertDriversRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BulkUpsertDriversRequest) GetDrivers() []*Driver {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+func (x *BulkUpsertDriversRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type ListCircuitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// As with the REST query parameters, a page is filtered by one field.
+	// current is not paginated.
+	//
+	// Types that are valid to be assigned to Filter:
+	//
+	//	*ListCircuitsRequest_Name
+	//	*ListCircuitsRequest_Location
+	//	*ListCircuitsRequest_Country
+	//	*ListCircuitsRequest_Current
+	Filter         isListCircuitsRequest_Filter `protobuf_oneof:"filter"`
+	Page           int32                        `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                        `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeDeleted bool                         `protobuf:"varint,12,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCircuitsRequest) Reset() {
+	*x = ListCircuitsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCircuitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCircuitsRequest) ProtoMessage() {}
+
+func (x *ListCircuitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCircuitsRequest.ProtoReflect.Descriptor instead.
+func (*ListCircuitsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCircuitsRequest) GetFilter() isListCircuitsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCircuitsRequest) GetName() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListCircuitsRequest_Name); ok {
+			return x.Name
+		}
+	}
+	return ""
+}
+
+func (x *ListCircuitsRequest) GetLocation() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListCircuitsRequest_Location); ok {
+			return x.Location
+		}
+	}
+	return ""
+}
+
+func (x *ListCircuitsRequest) GetCountry() string {
+	if x != nil {
+		if x, ok := x.Filter.(*ListCircuitsRequest_Country); ok {
+			return x.Country
+		}
+	}
+	return ""
+}
+
+func (x *ListCircuitsRequest) GetCurrent() bool {
+	if x != nil {
+		if x, ok := x.Filter.(*ListCircuitsRequest_Current); ok {
+			return x.Current
+		}
+	}
+	return false
+}
+
+func (x *ListCircuitsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCircuitsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCircuitsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type isListCircuitsRequest_Filter interface {
+	isListCircuitsRequest_Filter()
+}
+
+type ListCircuitsRequest_Name struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+
+type ListCircuitsRequest_Location struct {
+	Location string `protobuf:"bytes,2,opt,name=location,proto3,oneof"`
+}
+
+type ListCircuitsRequest_Country struct {
+	Country string `protobuf:"bytes,3,opt,name=country,proto3,oneof"`
+}
+
+type ListCircuitsRequest_Current struct {
+	Current bool `protobuf:"varint,4,opt,name=current,proto3,oneof"`
+}
+
+func (*ListCircuitsRequest_Name) isListCircuitsRequest_Filter() {}
+
+func (*ListCircuitsRequest_Location) isListCircuitsRequest_Filter() {}
+
+func (*ListCircuitsRequest_Country) isListCircuitsRequest_Filter() {}
+
+func (*ListCircuitsRequest_Current) isListCircuitsRequest_Filter() {}
+
+type ListCircuitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Circuits      []*Circuit             `protobuf:"bytes,1,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCircuitsResponse) Reset() {
+	*x = ListCircuitsResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCircuitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCircuitsResponse) ProtoMessage() {}
+
+func (x *ListCircuitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCircuitsResponse.ProtoReflect.Descriptor instead.
+func (*ListCircuitsResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListCircuitsResponse) GetCircuits() []*Circuit {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+type StreamCircuitsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Location       string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Country        string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Current        *bool                  `protobuf:"varint,4,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	Ref            string                 `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty"`
+	Url            string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,7,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamCircuitsRequest) Reset() {
+	*x = StreamCircuitsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCircuitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCircuitsRequest) ProtoMessage() {}
+
+func (x *StreamCircuitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCircuitsRequest.ProtoReflect.Descriptor instead.
+func (*StreamCircuitsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamCircuitsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamCircuitsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StreamCircuitsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *StreamCircuitsRequest) GetCurrent() bool {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return false
+}
+
+func (x *StreamCircuitsRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *StreamCircuitsRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StreamCircuitsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateCircuitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Circuit       *Circuit               `protobuf:"bytes,2,opt,name=circuit,proto3" json:"circuit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCircuitRequest) Reset() {
+	*x = UpdateCircuitRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCircuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCircuitRequest) ProtoMessage() {}
+
+func (x *UpdateCircuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCircuitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCircuitRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCircuitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCircuitRequest) GetCircuit() *Circuit {
+	if x != nil {
+		return x.Circuit
+	}
+	return nil
+}
+
+type BulkUpsertCircuitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Circuits      []*Circuit             `protobuf:"bytes,1,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertCircuitsRequest) Reset() {
+	*x = BulkUpsertCircuitsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertCircuitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertCircuitsRequest) ProtoMessage() {}
+
+func (x *BulkUpsertCircuitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertCircuitsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertCircuitsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BulkUpsertCircuitsRequest) GetCircuits() []*Circuit {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+func (x *BulkUpsertCircuitsRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type BulkUpsertSeasonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seasons       []*Season              `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertSeasonsRequest) Reset() {
+	*x = BulkUpsertSeasonsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertSeasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertSeasonsRequest) ProtoMessage() {}
+
+func (x *BulkUpsertSeasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertSeasonsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BulkUpsertSeasonsRequest) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *BulkUpsertSeasonsRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type ListRacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Races         []*Race                `protobuf:"bytes,1,rep,name=races,proto3" json:"races,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRacesResponse) Reset() {
+	*x = ListRacesResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRacesResponse) ProtoMessage() {}
+
+func (x *ListRacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRacesResponse.ProtoReflect.Descriptor instead.
+func (*ListRacesResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRacesResponse) GetRaces() []*Race {
+	if x != nil {
+		return x.Races
+	}
+	return nil
+}
+
+type BulkUpsertRacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Races         []*Race                `protobuf:"bytes,1,rep,name=races,proto3" json:"races,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertRacesRequest) Reset() {
+	*x = BulkUpsertRacesRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertRacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertRacesRequest) ProtoMessage() {}
+
+func (x *BulkUpsertRacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertRacesRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertRacesRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BulkUpsertRacesRequest) GetRaces() []*Race {
+	if x != nil {
+		return x.Races
+	}
+	return nil
+}
+
+func (x *BulkUpsertRacesRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type ListResultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*Result              `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResultsResponse) Reset() {
+	*x = ListResultsResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResultsResponse) ProtoMessage() {}
+
+func (x *ListResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListResultsResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListResultsResponse) GetResults() []*Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BulkUpsertResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*Result              `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertResultsRequest) Reset() {
+	*x = BulkUpsertResultsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertResultsRequest) ProtoMessage() {}
+
+func (x *BulkUpsertResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertResultsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertResultsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkUpsertResultsRequest) GetResults() []*Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkUpsertResultsRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type ListDriverStandingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standings     []*DriverStanding      `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriverStandingsResponse) Reset() {
+	*x = ListDriverStandingsResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverStandingsResponse) ProtoMessage() {}
+
+func (x *ListDriverStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriverStandingsResponse.ProtoReflect.Descriptor instead.
+func (*ListDriverStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListDriverStandingsResponse) GetStandings() []*DriverStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type BulkUpsertDriverStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standings     []*DriverStanding      `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertDriverStandingsRequest) Reset() {
+	*x = BulkUpsertDriverStandingsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertDriverStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertDriverStandingsRequest) ProtoMessage() {}
+
+func (x *BulkUpsertDriverStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertDriverStandingsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertDriverStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BulkUpsertDriverStandingsRequest) GetStandings() []*DriverStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *BulkUpsertDriverStandingsRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type ListConstructorStandingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standings     []*ConstructorStanding `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConstructorStandingsResponse) Reset() {
+	*x = ListConstructorStandingsResponse{}
+	mi := &file_f1_v1_f1_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConstructorStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConstructorStandingsResponse) ProtoMessage() {}
+
+func (x *ListConstructorStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConstructorStandingsResponse.ProtoReflect.Descriptor instead.
+func (*ListConstructorStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListConstructorStandingsResponse) GetStandings() []*ConstructorStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type BulkUpsertConstructorStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standings     []*ConstructorStanding `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpsertConstructorStandingsRequest) Reset() {
+	*x = BulkUpsertConstructorStandingsRequest{}
+	mi := &file_f1_v1_f1_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpsertConstructorStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpsertConstructorStandingsRequest) ProtoMessage() {}
+
+func (x *BulkUpsertConstructorStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_f1_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpsertConstructorStandingsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpsertConstructorStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_f1_v1_f1_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BulkUpsertConstructorStandingsRequest) GetStandings() []*ConstructorStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *BulkUpsertConstructorStandingsRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+var File_f1_v1_f1_proto protoreflect.FileDescriptor
+
+const file_f1_v1_f1_proto_rawDesc = "" +
+	"\n" +
+	"\x0ef1/v1/f1.proto\x12\x05f1.v1\x1a\x12f1/v1/models.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"E\n" +
+	"\n" +
+	"GetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\x1f\n" +
+	"\rRecordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x0eHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\">\n" +
+	"\x0fHistoryResponse\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.f1.v1.AuditEntryR\aentries\"9\n" +
+	"\rRevertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"A\n" +
+	"\x0eRevertResponse\x12/\n" +
+	"\x06record\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06record\"L\n" +
+	"\rSeasonRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"f\n" +
+	"\x11SeasonRaceRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\x05R\x05round\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"\xb0\x01\n" +
+	"\x17ListConstructorsRequest\x12\x14\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x12\"\n" +
+	"\vnationality\x18\x02 \x01(\tH\x00R\vnationality\x12\x12\n" +
+	"\x04page\x18\n" +
+	" \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\v \x01(\x05R\x05limit\x12'\n" +
+	"\x0finclude_deleted\x18\f \x01(\bR\x0eincludeDeletedB\b\n" +
+	"\x06filter\"R\n" +
+	"\x18ListConstructorsResponse\x126\n" +
+	"\fconstructors\x18\x01 \x03(\v2\x12.f1.v1.ConstructorR\fconstructors\"\x8c\x01\n" +
+	"\x19StreamConstructorsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vnationality\x18\x02 \x01(\tR\vnationality\x12\x10\n" +
+	"\x03ref\x18\x03 \x01(\tR\x03ref\x12'\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\"`\n" +
+	"\x18UpdateConstructorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\vconstructor\x18\x02 \x01(\v2\x12.f1.v1.ConstructorR\vconstructor\"|\n" +
+	"\x1dBulkUpsertConstructorsRequest\x126\n" +
+	"\fconstructors\x18\x01 \x03(\v2\x12.f1.v1.ConstructorR\fconstructors\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode\"\x85\x02\n" +
+	"\x12ListDriversRequest\x12\x1f\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tH\x00R\tfirstName\x12\x1d\n" +
+	"\tlast_name\x18\x02 \x01(\tH\x00R\blastName\x12\x14\n" +
+	"\x04team\x18\x03 \x01(\tH\x00R\x04team\x12\"\n" +
+	"\vnationality\x18\x04 \x01(\tH\x00R\vnationality\x12\x18\n" +
+	"\x06status\x18\x05 \x01(\tH\x00R\x06status\x12\x12\n" +
+	"\x04page\x18\n" +
+	" \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\v \x01(\x05R\x05limit\x12'\n" +
+	"\x0finclude_deleted\x18\f \x01(\bR\x0eincludeDeletedB\b\n" +
+	"\x06filter\">\n" +
+	"\x13ListDriversResponse\x12'\n" +
+	"\adrivers\x18\x01 \x03(\v2\r.f1.v1.DriverR\adrivers\"\xa9\x02\n" +
+	"\x14StreamDriversRequest\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x02 \x01(\tR\blastName\x12\x12\n" +
+	"\x04team\x18\x03 \x01(\tR\x04team\x12 \n" +
+	"\vnationality\x18\x04 \x01(\tR\vnationality\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x10\n" +
+	"\x03ref\x18\x06 \x01(\tR\x03ref\x12\x12\n" +
+	"\x04code\x18\a \x01(\tR\x04code\x12\x1b\n" +
+	"\x06number\x18\b \x01(\x05H\x00R\x06number\x88\x01\x01\x12\x10\n" +
+	"\x03url\x18\t \x01(\tR\x03url\x12'\n" +
+	"\x0finclude_deleted\x18\n" +
+	" \x01(\bR\x0eincludeDeletedB\t\n" +
+	"\a_number\"L\n" +
+	"\x13UpdateDriverRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x06driver\x18\x02 \x01(\v2\r.f1.v1.DriverR\x06driver\"9\n" +
+	"\x13MergeDriversRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04into\x18\x02 \x01(\tR\x04into\"h\n" +
+	"\x18BulkUpsertDriversRequest\x12'\n" +
+	"\adrivers\x18\x01 \x03(\v2\r.f1.v1.DriverR\adrivers\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode\"\xde\x01\n" +
+	"\x13ListCircuitsRequest\x12\x14\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x12\x1c\n" +
+	"\blocation\x18\x02 \x01(\tH\x00R\blocation\x12\x1a\n" +
+	"\acountry\x18\x03 \x01(\tH\x00R\acountry\x12\x1a\n" +
+	"\acurrent\x18\x04 \x01(\bH\x00R\acurrent\x12\x12\n" +
+	"\x04page\x18\n" +
+	" \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\v \x01(\x05R\x05limit\x12'\n" +
+	"\x0finclude_deleted\x18\f \x01(\bR\x0eincludeDeletedB\b\n" +
+	"\x06filter\"B\n" +
+	"\x14ListCircuitsResponse\x12*\n" +
+	"\bcircuits\x18\x01 \x03(\v2\x0e.f1.v1.CircuitR\bcircuits\"\xd9\x01\n" +
+	"\x15StreamCircuitsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1d\n" +
+	"\acurrent\x18\x04 \x01(\bH\x00R\acurrent\x88\x01\x01\x12\x10\n" +
+	"\x03ref\x18\x05 \x01(\tR\x03ref\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12'\n" +
+	"\x0finclude_deleted\x18\a \x01(\bR\x0eincludeDeletedB\n" +
+	"\n" +
+	"\b_current\"P\n" +
+	"\x14UpdateCircuitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\acircuit\x18\x02 \x01(\v2\x0e.f1.v1.CircuitR\acircuit\"l\n" +
+	"\x19BulkUpsertCircuitsRequest\x12*\n" +
+	"\bcircuits\x18\x01 \x03(\v2\x0e.f1.v1.CircuitR\bcircuits\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode\"h\n" +
+	"\x18BulkUpsertSeasonsRequest\x12'\n" +
+	"\aseasons\x18\x01 \x03(\v2\r.f1.v1.SeasonR\aseasons\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode\"6\n" +
+	"\x11ListRacesResponse\x12!\n" +
+	"\x05races\x18\x01 \x03(\v2\v.f1.v1.RaceR\x05races\"`\n" +
+	"\x16BulkUpsertRacesRequest\x12!\n" +
+	"\x05races\x18\x01 \x03(\v2\v.f1.v1.RaceR\x05races\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode\">\n" +
+	"\x13ListResultsResponse\x12'\n" +
+	"\aresults\x18\x01 \x03(\v2\r.f1.v1.ResultR\aresults\"h\n" +
+	"\x18BulkUpsertResultsRequest\x12'\n" +
+	"\aresults\x18\x01 \x03(\v2\r.f1.v1.ResultR\aresults\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode\"R\n" +
+	"\x1bListDriverStandingsResponse\x123\n" +
+	"\tstandings\x18\x01 \x03(\v2\x15.f1.v1.DriverStandingR\tstandings\"|\n" +
+	" BulkUpsertDriverStandingsRequest\x123\n" +
+	"\tstandings\x18\x01 \x03(\v2\x15.f1.v1.DriverStandingR\tstandings\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode\"\\\n" +
+	" ListConstructorStandingsResponse\x128\n" +
+	"\tstandings\x18\x01 \x03(\v2\x1a.f1.v1.ConstructorStandingR\tstandings\"\x86\x01\n" +
+	"%BulkUpsertConstructorStandingsRequest\x128\n" +
+	"\tstandings\x18\x01 \x03(\v2\x1a.f1.v1.ConstructorStandingR\tstandings\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode2\x9d\x06\n" +
+	"\x12ConstructorService\x12;\n" +
+	"\x11CreateConstructor\x12\x12.f1.v1.Constructor\x1a\x12.f1.v1.Constructor\x127\n" +
+	"\x0eGetConstructor\x12\x11.f1.v1.GetRequest\x1a\x12.f1.v1.Constructor\x12S\n" +
+	"\x10ListConstructors\x12\x1e.f1.v1.ListConstructorsRequest\x1a\x1f.f1.v1.ListConstructorsResponse\x12L\n" +
+	"\x12StreamConstructors\x12 .f1.v1.StreamConstructorsRequest\x1a\x12.f1.v1.Constructor0\x01\x12H\n" +
+	"\x11UpdateConstructor\x12\x1f.f1.v1.UpdateConstructorRequest\x1a\x12.f1.v1.Constructor\x12A\n" +
+	"\x11DeleteConstructor\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x12RestoreConstructor\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\x10PurgeConstructor\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x16BulkUpsertConstructors\x12$.f1.v1.BulkUpsertConstructorsRequest\x1a\x11.f1.v1.BulkResult\x12F\n" +
+	"\x15GetConstructorHistory\x12\x15.f1.v1.HistoryRequest\x1a\x16.f1.v1.HistoryResponse\x12@\n" +
+	"\x11RevertConstructor\x12\x14.f1.v1.RevertRequest\x1a\x15.f1.v1.RevertResponse2\xea\x05\n" +
+	"\rDriverService\x12,\n" +
+	"\fCreateDriver\x12\r.f1.v1.Driver\x1a\r.f1.v1.Driver\x12-\n" +
+	"\tGetDriver\x12\x11.f1.v1.GetRequest\x1a\r.f1.v1.Driver\x12D\n" +
+	"\vListDrivers\x12\x19.f1.v1.ListDriversRequest\x1a\x1a.f1.v1.ListDriversResponse\x12=\n" +
+	"\rStreamDrivers\x12\x1b.f1.v1.StreamDriversRequest\x1a\r.f1.v1.Driver0\x01\x129\n" +
+	"\fUpdateDriver\x12\x1a.f1.v1.UpdateDriverRequest\x1a\r.f1.v1.Driver\x12<\n" +
+	"\fDeleteDriver\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\rRestoreDriver\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\vPurgeDriver\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\fMergeDrivers\x12\x1a.f1.v1.MergeDriversRequest\x1a\r.f1.v1.Driver\x12G\n" +
+	"\x11BulkUpsertDrivers\x12\x1f.f1.v1.BulkUpsertDriversRequest\x1a\x11.f1.v1.BulkResult\x12A\n" +
+	"\x10GetDriverHistory\x12\x15.f1.v1.HistoryRequest\x1a\x16.f1.v1.HistoryResponse\x12;\n" +
+	"\fRevertDriver\x12\x14.f1.v1.RevertRequest\x1a\x15.f1.v1.RevertResponse2\xc5\x05\n" +
+	"\x0eCircuitService\x12/\n" +
+	"\rCreateCircuit\x12\x0e.f1.v1.Circuit\x1a\x0e.f1.v1.Circuit\x12/\n" +
+	"\n" +
+	"GetCircuit\x12\x11.f1.v1.GetRequest\x1a\x0e.f1.v1.Circuit\x12G\n" +
+	"\fListCircuits\x12\x1a.f1.v1.ListCircuitsRequest\x1a\x1b.f1.v1.ListCircuitsResponse\x12@\n" +
+	"\x0eStreamCircuits\x12\x1c.f1.v1.StreamCircuitsRequest\x1a\x0e.f1.v1.Circuit0\x01\x12<\n" +
+	"\rUpdateCircuit\x12\x1b.f1.v1.UpdateCircuitRequest\x1a\x0e.f1.v1.Circuit\x12=\n" +
+	"\rDeleteCircuit\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\x0eRestoreCircuit\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\fPurgeCircuit\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\x12BulkUpsertCircuits\x12 .f1.v1.BulkUpsertCircuitsRequest\x1a\x11.f1.v1.BulkResult\x12B\n" +
+	"\x11GetCircuitHistory\x12\x15.f1.v1.HistoryRequest\x1a\x16.f1.v1.HistoryResponse\x12<\n" +
+	"\rRevertCircuit\x12\x14.f1.v1.RevertRequest\x1a\x15.f1.v1.RevertResponse2\xdc\x04\n" +
+	"\rSeasonService\x12A\n" +
+	"\x0fListSeasonRaces\x12\x14.f1.v1.SeasonRequest\x1a\x18.f1.v1.ListRacesResponse\x126\n" +
+	"\rGetSeasonRace\x12\x18.f1.v1.SeasonRaceRequest\x1a\v.f1.v1.Race\x12M\n" +
+	"\x15ListSeasonRaceResults\x12\x18.f1.v1.SeasonRaceRequest\x1a\x1a.f1.v1.ListResultsResponse\x12<\n" +
+	"\fDeleteSeason\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\rRestoreSeason\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\vPurgeSeason\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x11BulkUpsertSeasons\x12\x1f.f1.v1.BulkUpsertSeasonsRequest\x1a\x11.f1.v1.BulkResult\x12A\n" +
+	"\x10GetSeasonHistory\x12\x15.f1.v1.HistoryRequest\x1a\x16.f1.v1.HistoryResponse\x12;\n" +
+	"\fRevertSeason\x12\x14.f1.v1.RevertRequest\x1a\x15.f1.v1.RevertResponse2\x82\x04\n" +
+	"\vRaceService\x12<\n" +
+	"\n" +
+	"CreateRace\x12\x16.f1.v1.RaceWithResults\x1a\x16.f1.v1.RaceWithResults\x12@\n" +
+	"\x0fListRaceResults\x12\x11.f1.v1.GetRequest\x1a\x1a.f1.v1.ListResultsResponse\x12:\n" +
+	"\n" +
+	"DeleteRace\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\vRestoreRace\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\tPurgeRace\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x0fBulkUpsertRaces\x12\x1d.f1.v1.BulkUpsertRacesRequest\x1a\x11.f1.v1.BulkResult\x12?\n" +
+	"\x0eGetRaceHistory\x12\x15.f1.v1.HistoryRequest\x1a\x16.f1.v1.HistoryResponse\x129\n" +
+	"\n" +
+	"RevertRace\x12\x14.f1.v1.RevertRequest\x1a\x15.f1.v1.RevertResponse2\x92\x03\n" +
+	"\rResultService\x12<\n" +
+	"\fDeleteResult\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\rRestoreResult\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\vPurgeResult\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x11BulkUpsertResults\x12\x1f.f1.v1.BulkUpsertResultsRequest\x1a\x11.f1.v1.BulkResult\x12A\n" +
+	"\x10GetResultHistory\x12\x15.f1.v1.HistoryRequest\x1a\x16.f1.v1.HistoryResponse\x12;\n" +
+	"\fRevertResult\x12\x14.f1.v1.RevertRequest\x1a\x15.f1.v1.RevertResponse2\xe2\b\n" +
+	"\x0fStandingService\x12U\n" +
+	"\x19ListSeasonDriverStandings\x12\x14.f1.v1.SeasonRequest\x1a\".f1.v1.ListDriverStandingsResponse\x12_\n" +
+	"\x1eListSeasonConstructorStandings\x12\x14.f1.v1.SeasonRequest\x1a'.f1.v1.ListConstructorStandingsResponse\x12D\n" +
+	"\x14DeleteDriverStanding\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x15RestoreDriverStanding\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x13PurgeDriverStanding\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x19BulkUpsertDriverStandings\x12'.f1.v1.BulkUpsertDriverStandingsRequest\x1a\x11.f1.v1.BulkResult\x12I\n" +
+	"\x18GetDriverStandingHistory\x12\x15.f1.v1.HistoryRequest\x1a\x16.f1.v1.HistoryResponse\x12C\n" +
+	"\x14RevertDriverStanding\x12\x14.f1.v1.RevertRequest\x1a\x15.f1.v1.RevertResponse\x12I\n" +
+	"\x19DeleteConstructorStanding\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\x1aRestoreConstructorStanding\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x18PurgeConstructorStanding\x12\x14.f1.v1.RecordRequest\x1a\x16.google.protobuf.Empty\x12a\n" +
+	"\x1eBulkUpsertConstructorStandings\x12,.f1.v1.BulkUpsertConstructorStandingsRequest\x1a\x11.f1.v1.BulkResult\x12N\n" +
+	"\x1dGetConstructorStandingHistory\x12\x15.f1.v1.HistoryRequest\x1a\x16.f1.v1.HistoryResponse\x12H\n" +
+	"\x19RevertConstructorStanding\x12\x14.f1.v1.RevertRequest\x1a\x15.f1.v1.RevertResponseB-Z+github.com/ChinmayNoob/f1/pkg/pb/f1/v1;f1v1b\x06proto3"
+
+var (
+	file_f1_v1_f1_proto_rawDescOnce sync.Once
+	file_f1_v1_f1_proto_rawDescData []byte
+)
+
+func file_f1_v1_f1_proto_rawDescGZIP() []byte {
+	file_f1_v1_f1_proto_rawDescOnce.Do(func() {
+		file_f1_v1_f1_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_f1_v1_f1_proto_rawDesc), len(file_f1_v1_f1_proto_rawDesc)))
+	})
+	return file_f1_v1_f1_proto_rawDescData
+}
+
+var file_f1_v1_f1_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_f1_v1_f1_proto_goTypes = []any{
+	(*GetRequest)(nil),                            // 0: f1.v1.GetRequest
+	(*RecordRequest)(nil),                         // 1: f1.v1.RecordRequest
+	(*HistoryRequest)(nil),                        // 2: f1.v1.HistoryRequest
+	(*HistoryResponse)(nil),                       // 3: f1.v1.HistoryResponse
+	(*RevertRequest)(nil),                         // 4: f1.v1.RevertRequest
+	(*RevertResponse)(nil),                        // 5: f1.v1.RevertResponse
+	(*SeasonRequest)(nil),                         // 6: f1.v1.SeasonRequest
+	(*SeasonRaceRequest)(nil),                     // 7: f1.v1.SeasonRaceRequest
+	(*ListConstructorsRequest)(nil),               // 8: f1.v1.ListConstructorsRequest
+	(*ListConstructorsResponse)(nil),              // 9: f1.v1.ListConstructorsResponse
+	(*StreamConstructorsRequest)(nil),             // 10: f1.v1.StreamConstructorsRequest
+	(*UpdateConstructorRequest)(nil),              // 11: f1.v1.UpdateConstructorRequest
+	(*BulkUpsertConstructorsRequest)(nil),         // 12: f1.v1.BulkUpsertConstructorsRequest
+	(*ListDriversRequest)(nil),                    // 13: f1.v1.ListDriversRequest
+	(*ListDriversResponse)(nil),                   // 14: f1.v1.ListDriversResponse
+	(*StreamDriversRequest)(nil),                  // 15: f1.v1.StreamDriversRequest
+	(*UpdateDriverRequest)(nil),                   // 16: f1.v1.UpdateDriverRequest
+	(*MergeDriversRequest)(nil),                   // 17: f1.v1.MergeDriversRequest
+	(*BulkUpsertDriversRequest)(nil),              // 18: f1.v1.BulkUpsertDriversRequest
+	(*ListCircuitsRequest)(nil),                   // 19: f1.v1.ListCircuitsRequest
+	(*ListCircuitsResponse)(nil),                  // 20: f1.v1.ListCircuitsResponse
+	(*StreamCircuitsRequest)(nil),                 // 21: f1.v1.StreamCircuitsRequest
+	(*UpdateCircuitRequest)(nil),                  // 22: f1.v1.UpdateCircuitRequest
+	(*BulkUpsertCircuitsRequest)(nil),             // 23: f1.v1.BulkUpsertCircuitsRequest
+	(*BulkUpsertSeasonsRequest)(nil),              // 24: f1.v1.BulkUpsertSeasonsRequest
+	(*ListRacesResponse)(nil),                     // 25: f1.v1.ListRacesResponse
+	(*BulkUpsertRacesRequest)(nil),                // 26: f1.v1.BulkUpsertRacesRequest
+	(*ListResultsResponse)(nil),                   // 27: f1.v1.ListResultsResponse
+	(*BulkUpsertResultsRequest)(nil),              // 28: f1.v1.BulkUpsertResultsRequest
+	(*ListDriverStandingsResponse)(nil),           // 29: f1.v1.ListDriverStandingsResponse
+	(*BulkUpsertDriverStandingsRequest)(nil),      // 30: f1.v1.BulkUpsertDriverStandingsRequest
+	(*ListConstructorStandingsResponse)(nil),      // 31: f1.v1.ListConstructorStandingsResponse
+	(*BulkUpsertConstructorStandingsRequest)(nil), // 32: f1.v1.BulkUpsertConstructorStandingsRequest
+	(*AuditEntry)(nil),                            // 33: f1.v1.AuditEntry
+	(*structpb.Struct)(nil),                       // 34: google.protobuf.Struct
+	(*Constructor)(nil),                           // 35: f1.v1.Constructor
+	(BulkMode)(0),                                 // 36: f1.v1.BulkMode
+	(*Driver)(nil),                                // 37: f1.v1.Driver
+	(*Circuit)(nil),                               // 38: f1.v1.Circuit
+	(*Season)(nil),                                // 39: f1.v1.Season
+	(*Race)(nil),                                  // 40: f1.v1.Race
+	(*Result)(nil),                                // 41: f1.v1.Result
+	(*DriverStanding)(nil),                        // 42: f1.v1.DriverStanding
+	(*ConstructorStanding)(nil),                   // 43: f1.v1.ConstructorStanding
+	(*RaceWithResults)(nil),                       // 44: f1.v1.RaceWithResults
+	(*emptypb.Empty)(nil),                         // 45: google.protobuf.Empty
+	(*BulkResult)(nil),                            // 46: f1.v1.BulkResult
+}
+var file_f1_v1_f1_proto_depIdxs = []int32{
+	33, // 0: f1.v1.HistoryResponse.entries:type_name -> f1.v1.AuditEntry
+	34, // 1: f1.v1.RevertResponse.record:type_name -> google.protobuf.Struct
+	35, // 2: f1.v1.ListConstructorsResponse.constructors:type_name -> f1.v1.Constructor
+	35, // 3: f1.v1.UpdateConstructorRequest.constructor:type_name -> f1.v1.Constructor
+	35, // 4: f1.v1.BulkUpsertConstructorsRequest.constructors:type_name -> f1.v1.Constructor
+	36, // 5: f1.v1.BulkUpsertConstructorsRequest.mode:type_name -> f1.v1.BulkMode
+	37, // 6: f1.v1.ListDriversResponse.drivers:type_name -> f1.v1.Driver
+	37, // 7: f1.v1.UpdateDriverRequest.driver:type_name -> f1.v1.Driver
+	37, // 8: f1.v1.BulkUpsertDriversRequest.drivers:type_name -> f1.v1.Driver
+	36, // 9: f1.v1.BulkUpsertDriversRequest.mode:type_name -> f1.v1.BulkMode
+	38, // 10: f1.v1.ListCircuitsResponse.circuits:type_name -> f1.v1.Circuit
+	38, // 11: f1.v1.UpdateCircuitRequest.circuit:type_name -> f1.v1.Circuit
+	38, // 12: f1.v1.BulkUpsertCircuitsRequest.circuits:type_name -> f1.v1.Circuit
+	36, // 13: f1.v1.BulkUpsertCircuitsRequest.mode:type_name -> f1.v1.BulkMode
+	39, // 14: f1.v1.BulkUpsertSeasonsRequest.seasons:type_name -> f1.v1.Season
+	36, // 15: f1.v1.BulkUpsertSeasonsRequest.mode:type_name -> f1.v1.BulkMode
+	40, // 16: f1.v1.ListRacesResponse.races:type_name -> f1.v1.Race
+	40, // 17: f1.v1.BulkUpsertRacesRequest.races:type_name -> f1.v1.Race
+	36, // 18: f1.v1.BulkUpsertRacesRequest.mode:type_name -> f1.v1.BulkMode
+	41, // 19: f1.v1.ListResultsResponse.results:type_name -> f1.v1.Result
+	41, // 20: f1.v1.BulkUpsertResultsRequest.results:type_name -> f1.v1.Result
+	36, // 21: f1.v1.BulkUpsertResultsRequest.mode:type_name -> f1.v1.BulkMode
+	42, // 22: f1.v1.ListDriverStandingsResponse.standings:type_name -> f1.v1.DriverStanding
+	42, // 23: f1.v1.BulkUpsertDriverStandingsRequest.standings:type_name -> f1.v1.DriverStanding
+	36, // 24: f1.v1.BulkUpsertDriverStandingsRequest.mode:type_name -> f1.v1.BulkMode
+	43, // 25: f1.v1.ListConstructorStandingsResponse.standings:type_name -> f1.v1.ConstructorStanding
+	43, // 26: f1.v1.BulkUpsertConstructorStandingsRequest.standings:type_name -> f1.v1.ConstructorStanding
+	36, // 27: f1.v1.BulkUpsertConstructorStandingsRequest.mode:type_name -> f1.v1.BulkMode
+	35, // 28: f1.v1.ConstructorService.CreateConstructor:input_type -> f1.v1.Constructor
+	0,  // 29: f1.v1.ConstructorService.GetConstructor:input_type -> f1.v1.GetRequest
+	8,  // 30: f1.v1.ConstructorService.ListConstructors:input_type -> f1.v1.ListConstructorsRequest
+	10, // 31: f1.v1.ConstructorService.StreamConstructors:input_type -> f1.v1.StreamConstructorsRequest
+	11, // 32: f1.v1.ConstructorService.UpdateConstructor:input_type -> f1.v1.UpdateConstructorRequest
+	1,  // 33: f1.v1.ConstructorService.DeleteConstructor:input_type -> f1.v1.RecordRequest
+	1,  // 34: f1.v1.ConstructorService.RestoreConstructor:input_type -> f1.v1.RecordRequest
+	1,  // 35: f1.v1.ConstructorService.PurgeConstructor:input_type -> f1.v1.RecordRequest
+	12, // 36: f1.v1.ConstructorService.BulkUpsertConstructors:input_type -> f1.v1.BulkUpsertConstructorsRequest
+	2,  // 37: f1.v1.ConstructorService.GetConstructorHistory:input_type -> f1.v1.HistoryRequest
+	4,  // 38: f1.v1.ConstructorService.RevertConstructor:input_type -> f1.v1.RevertRequest
+	37, // 39: f1.v1.DriverService.CreateDriver:input_type -> f1.v1.Driver
+	0,  // 40: f1.v1.DriverService.GetDriver:input_type -> f1.v1.GetRequest
+	13, // 41: f1.v1.DriverService.ListDrivers:input_type -> f1.v1.ListDriversRequest
+	15, // 42: f1.v1.DriverService.StreamDrivers:input_type -> f1.v1.StreamDriversRequest
+	16, // 43: f1.v1.DriverService.UpdateDriver:input_type -> f1.v1.UpdateDriverRequest
+	1,  // 44: f1.v1.DriverService.DeleteDriver:input_type -> f1.v1.RecordRequest
+	1,  // 45: f1.v1.DriverService.RestoreDriver:input_type -> f1.v1.RecordRequest
+	1,  // 46: f1.v1.DriverService.PurgeDriver:input_type -> f1.v1.RecordRequest
+	17, // 47: f1.v1.DriverService.MergeDrivers:input_type -> f1.v1.MergeDriversRequest
+	18, // 48: f1.v1.DriverService.BulkUpsertDrivers:input_type -> f1.v1.BulkUpsertDriversRequest
+	2,  // 49: f1.v1.DriverService.GetDriverHistory:input_type -> f1.v1.HistoryRequest
+	4,  // 50: f1.v1.DriverService.RevertDriver:input_type -> f1.v1.RevertRequest
+	38, // 51: f1.v1.CircuitService.CreateCircuit:input_type -> f1.v1.Circuit
+	0,  // 52: f1.v1.CircuitService.GetCircuit:input_type -> f1.v1.GetRequest
+	19, // 53: f1.v1.CircuitService.ListCircuits:input_type -> f1.v1.ListCircuitsRequest
+	21, // 54: f1.v1.CircuitService.StreamCircuits:input_type -> f1.v1.StreamCircuitsRequest
+	22, // 55: f1.v1.CircuitService.UpdateCircuit:input_type -> f1.v1.UpdateCircuitRequest
+	1,  // 56: f1.v1.CircuitService.DeleteCircuit:input_type -> f1.v1.RecordRequest
+	1,  // 57: f1.v1.CircuitService.RestoreCircuit:input_type -> f1.v1.RecordRequest
+	1,  // 58: f1.v1.CircuitService.PurgeCircuit:input_type -> f1.v1.RecordRequest
+	23, // 59: f1.v1.CircuitService.BulkUpsertCircuits:input_type -> f1.v1.BulkUpsertCircuitsRequest
+	2,  // 60: f1.v1.CircuitService.GetCircuitHistory:input_type -> f1.v1.HistoryRequest
+	4,  // 61: f1.v1.CircuitService.RevertCircuit:input_type -> f1.v1.RevertRequest
+	6,  // 62: f1.v1.SeasonService.ListSeasonRaces:input_type -> f1.v1.SeasonRequest
+	7,  // 63: f1.v1.SeasonService.GetSeasonRace:input_type -> f1.v1.SeasonRaceRequest
+	7,  // 64: f1.v1.SeasonService.ListSeasonRaceResults:input_type -> f1.v1.SeasonRaceRequest
+	1,  // 65: f1.v1.SeasonService.DeleteSeason:input_type -> f1.v1.RecordRequest
+	1,  // 66: f1.v1.SeasonService.RestoreSeason:input_type -> f1.v1.RecordRequest
+	1,  // 67: f1.v1.SeasonService.PurgeSeason:input_type -> f1.v1.RecordRequest
+	24, // 68: f1.v1.SeasonService.BulkUpsertSeasons:input_type -> f1.v1.BulkUpsertSeasonsRequest
+	2,  // 69: f1.v1.SeasonService.GetSeasonHistory:input_type -> f1.v1.HistoryRequest
+	4,  // 70: f1.v1.SeasonService.RevertSeason:input_type -> f1.v1.RevertRequest
+	44, // 71: f1.v1.RaceService.CreateRace:input_type -> f1.v1.RaceWithResults
+	0,  // 72: f1.v1.RaceService.ListRaceResults:input_type -> f1.v1.GetRequest
+	1,  // 73: f1.v1.RaceService.DeleteRace:input_type -> f1.v1.RecordRequest
+	1,  // 74: f1.v1.RaceService.RestoreRace:input_type -> f1.v1.RecordRequest
+	1,  // 75: f1.v1.RaceService.PurgeRace:input_type -> f1.v1.RecordRequest
+	26, // 76: f1.v1.RaceService.BulkUpsertRaces:input_type -> f1.v1.BulkUpsertRacesRequest
+	2,  // 77: f1.v1.RaceService.GetRaceHistory:input_type -> f1.v1.HistoryRequest
+	4,  // 78: f1.v1.RaceService.RevertRace:input_type -> f1.v1.RevertRequest
+	1,  // 79: f1.v1.ResultService.DeleteResult:input_type -> f1.v1.RecordRequest
+	1,  // 80: f1.v1.ResultService.RestoreResult:input_type -> f1.v1.RecordRequest
+	1,  // 81: f1.v1.ResultService.PurgeResult:input_type -> f1.v1.RecordRequest
+	28, // 82: f1.v1.ResultService.BulkUpsertResults:input_type -> f1.v1.BulkUpsertResultsRequest
+	2,  // 83: f1.v1.ResultService.GetResultHistory:input_type -> f1.v1.HistoryRequest
+	4,  // 84: f1.v1.ResultService.RevertResult:input_type -> f1.v1.RevertRequest
+	6,  // 85: f1.v1.StandingService.ListSeasonDriverStandings:input_type -> f1.v1.SeasonRequest
+	6,  // 86: f1.v1.StandingService.ListSeasonConstructorStandings:input_type -> f1.v1.SeasonRequest
+	1,  // 87: f1.v1.StandingService.DeleteDriverStanding:input_type -> f1.v1.RecordRequest
+	1,  // 88: f1.v1.StandingService.RestoreDriverStanding:input_type -> f1.v1.RecordRequest
+	1,  // 89: f1.v1.StandingService.PurgeDriverStanding:input_type -> f1.v1.RecordRequest
+	30, // 90: f1.v1.StandingService.BulkUpsertDriverStandings:input_type -> f1.v1.BulkUpsertDriverStandingsRequest
+	2,  // 91: f1.v1.StandingService.GetDriverStandingHistory:input_type -> f1.v1.HistoryRequest
+	4,  // 92: f1.v1.StandingService.RevertDriverStanding:input_type -> f1.v1.RevertRequest
+	1,  // 93: f1.v1.StandingService.DeleteConstructorStanding:input_type -> f1.v1.RecordRequest
+	1,  // 94: f1.v1.StandingService.RestoreConstructorStanding:input_type -> f1.v1.RecordRequest
+	1,  // 95: f1.v1.StandingService.PurgeConstructorStanding:input_type -> f1.v1.RecordRequest
+	32, // 96: f1.v1.StandingService.BulkUpsertConstructorStandings:input_type -> f1.v1.BulkUpsertConstructorStandingsRequest
+	2,  // 97: f1.v1.StandingService.GetConstructorStandingHistory:input_type -> f1.v1.HistoryRequest
+	4,  // 98: f1.v1.StandingService.RevertConstructorStanding:input_type -> f1.v1.RevertRequest
+	35, // 99: f1.v1.ConstructorService.CreateConstructor:output_type -> f1.v1.Constructor
+	35, // 100: f1.v1.ConstructorService.GetConstructor:output_type -> f1.v1.Constructor
+	9,  // 101: f1.v1.ConstructorService.ListConstructors:output_type -> f1.v1.ListConstructorsResponse
+	35, // 102: f1.v1.ConstructorService.StreamConstructors:output_type -> f1.v1.Constructor
+	35, // 103: f1.v1.ConstructorService.UpdateConstructor:output_type -> f1.v1.Constructor
+	45, // 104: f1.v1.ConstructorService.DeleteConstructor:output_type -> google.protobuf.Empty
+	45, // 105: f1.v1.ConstructorService.RestoreConstructor:output_type -> google.protobuf.Empty
+	45, // 106: f1.v1.ConstructorService.PurgeConstructor:output_type -> google.protobuf.Empty
+	46, // 107: f1.v1.ConstructorService.BulkUpsertConstructors:output_type -> f1.v1.BulkResult
+	3,  // 108: f1.v1.ConstructorService.GetConstructorHistory:output_type -> f1.v1.HistoryResponse
+	5,  // 109: f1.v1.ConstructorService.RevertConstructor:output_type -> f1.v1.RevertResponse
+	37, // 110: f1.v1.DriverService.CreateDriver:output_type -> f1.v1.Driver
+	37, // 111: f1.v1.DriverService.GetDriver:output_type -> f1.v1.Driver
+	14, // 112: f1.v1.DriverService.ListDrivers:output_type -> f1.v1.ListDriversResponse
+	37, // 113: f1.v1.DriverService.StreamDrivers:output_type -> f1.v1.Driver
+	37, // 114: f1.v1.DriverService.UpdateDriver:output_type -> f1.v1.Driver
+	45, // 115: f1.v1.DriverService.DeleteDriver:output_type -> google.protobuf.Empty
+	45, // 116: f1.v1.DriverService.RestoreDriver:output_type -> google.protobuf.Empty
+	45, // 117: f1.v1.DriverService.PurgeDriver:output_type -> google.protobuf.Empty
+	37, // 118: f1.v1.DriverService.MergeDrivers:output_type -> f1.v1.Driver
+	46, // 119: f1.v1.DriverService.BulkUpsertDrivers:output_type -> f1.v1.BulkResult
+	3,  // 120: f1.v1.DriverService.GetDriverHistory:output_type -> f1.v1.HistoryResponse
+	5,  // 121: f1.v1.DriverService.RevertDriver:output_type -> f1.v1.RevertResponse
+	38, // 122: f1.v1.CircuitService.CreateCircuit:output_type -> f1.v1.Circuit
+	38, // 123: f1.v1.CircuitService.GetCircuit:output_type -> f1.v1.Circuit
+	20, // 124: f1.v1.CircuitService.ListCircuits:output_type -> f1.v1.ListCircuitsResponse
+	38, // 125: f1.v1.CircuitService.StreamCircuits:output_type -> f1.v1.Circuit
+	38, // 126: f1.v1.CircuitService.UpdateCircuit:output_type -> f1.v1.Circuit
+	45, // 127: f1.v1.CircuitService.DeleteCircuit:output_type -> google.protobuf.Empty
+	45, // 128: f1.v1.CircuitService.RestoreCircuit:output_type -> google.protobuf.Empty
+	45, // 129: f1.v1.CircuitService.PurgeCircuit:output_type -> google.protobuf.Empty
+	46, // 130: f1.v1.CircuitService.BulkUpsertCircuits:output_type -> f1.v1.BulkResult
+	3,  // 131: f1.v1.CircuitService.GetCircuitHistory:output_type -> f1.v1.HistoryResponse
+	5,  // 132: f1.v1.CircuitService.RevertCircuit:output_type -> f1.v1.RevertResponse
+	25, // 133: f1.v1.SeasonService.ListSeasonRaces:output_type -> f1.v1.ListRacesResponse
+	40, // 134: f1.v1.SeasonService.GetSeasonRace:output_type -> f1.v1.Race
+	27, // 135: f1.v1.SeasonService.ListSeasonRaceResults:output_type -> f1.v1.ListResultsResponse
+	45, // 136: f1.v1.SeasonService.DeleteSeason:output_type -> google.protobuf.Empty
+	45, // 137: f1.v1.SeasonService.RestoreSeason:output_type -> google.protobuf.Empty
+	45, // 138: f1.v1.SeasonService.PurgeSeason:output_type -> google.protobuf.Empty
+	46, // 139: f1.v1.SeasonService.BulkUpsertSeasons:output_type -> f1.v1.BulkResult
+	3,  // 140: f1.v1.SeasonService.GetSeasonHistory:output_type -> f1.v1.HistoryResponse
+	5,  // 141: f1.v1.SeasonService.RevertSeason:output_type -> f1.v1.RevertResponse
+	44, // 142: f1.v1.RaceService.CreateRace:output_type -> f1.v1.RaceWithResults
+	27, // 143: f1.v1.RaceService.ListRaceResults:output_type -> f1.v1.ListResultsResponse
+	45, // 144: f1.v1.RaceService.DeleteRace:output_type -> google.protobuf.Empty
+	45, // 145: f1.v1.RaceService.RestoreRace:output_type -> google.protobuf.Empty
+	45, // 146: f1.v1.RaceService.PurgeRace:output_type -> google.protobuf.Empty
+	46, // 147: f1.v1.RaceService.BulkUpsertRaces:output_type -> f1.v1.BulkResult
+	3,  // 148: f1.v1.RaceService.GetRaceHistory:output_type -> f1.v1.HistoryResponse
+	5,  // 149: f1.v1.RaceService.RevertRace:output_type -> f1.v1.RevertResponse
+	45, // 150: f1.v1.ResultService.DeleteResult:output_type -> google.protobuf.Empty
+	45, // 151: f1.v1.ResultService.RestoreResult:output_type -> google.protobuf.Empty
+	45, // 152: f1.v1.ResultService.PurgeResult:output_type -> google.protobuf.Empty
+	46, // 153: f1.v1.ResultService.BulkUpsertResults:output_type -> f1.v1.BulkResult
+	3,  // 154: f1.v1.ResultService.GetResultHistory:output_type -> f1.v1.HistoryResponse
+	5,  // 155: f1.v1.ResultService.RevertResult:output_type -> f1.v1.RevertResponse
+	29, // 156: f1.v1.StandingService.ListSeasonDriverStandings:output_type -> f1.v1.ListDriverStandingsResponse
+	31, // 157: f1.v1.StandingService.ListSeasonConstructorStandings:output_type -> f1.v1.ListConstructorStandingsResponse
+	45, // 158: f1.v1.StandingService.DeleteDriverStanding:output_type -> google.protobuf.Empty
+	45, // 159: f1.v1.StandingService.RestoreDriverStanding:output_type -> google.protobuf.Empty
+	45, // 160: f1.v1.StandingService.PurgeDriverStanding:output_type -> google.protobuf.Empty
+	46, // 161: f1.v1.StandingService.BulkUpsertDriverStandings:output_type -> f1.v1.BulkResult
+	3,  // 162: f1.v1.StandingService.GetDriverStandingHistory:output_type -> f1.v1.HistoryResponse
+	5,  // 163: f1.v1.StandingService.RevertDriverStanding:output_type -> f1.v1.RevertResponse
+	45, // 164: f1.v1.StandingService.DeleteConstructorStanding:output_type -> google.protobuf.Empty
+	45, // 165: f1.v1.StandingService.RestoreConstructorStanding:output_type -> google.protobuf.Empty
+	45, // 166: f1.v1.StandingService.PurgeConstructorStanding:output_type -> google.protobuf.Empty
+	46, // 167: f1.v1.StandingService.BulkUpsertConstructorStandings:output_type -> f1.v1.BulkResult
+	3,  // 168: f1.v1.StandingService.GetConstructorStandingHistory:output_type -> f1.v1.HistoryResponse
+	5,  // 169: f1.v1.StandingService.RevertConstructorStanding:output_type -> f1.v1.RevertResponse
+	99, // [99:170] is the sub-list for method output_type
+	28, // [28:99] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_f1_v1_f1_proto_init() }
+func file_f1_v1_f1_proto_init() {
+	if File_f1_v1_f1_proto != nil {
+		return
+	}
+	file_f1_v1_models_proto_init()
+	file_f1_v1_f1_proto_msgTypes[8].OneofWrappers = []any{
+		(*ListConstructorsRequest_Name)(nil),
+		(*ListConstructorsRequest_Nationality)(nil),
+	}
+	file_f1_v1_f1_proto_msgTypes[13].OneofWrappers = []any{
+		(*ListDriversRequest_FirstName)(nil),
+		(*ListDriversRequest_LastName)(nil),
+		(*ListDriversRequest_Team)(nil),
+		(*ListDriversRequest_Nationality)(nil),
+		(*ListDriversRequest_Status)(nil),
+	}
+	file_f1_v1_f1_proto_msgTypes[15].OneofWrappers = []any{}
+	file_f1_v1_f1_proto_msgTypes[19].OneofWrappers = []any{
+		(*ListCircuitsRequest_Name)(nil),
+		(*ListCircuitsRequest_Location)(nil),
+		(*ListCircuitsRequest_Country)(nil),
+		(*ListCircuitsRequest_Current)(nil),
+	}
+	file_f1_v1_f1_proto_msgTypes[21].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_f1_v1_f1_proto_rawDesc), len(file_f1_v1_f1_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   7,
+		},
+		GoTypes:           file_f1_v1_f1_proto_goTypes,
+		DependencyIndexes: file_f1_v1_f1_proto_depIdxs,
+		MessageInfos:      file_f1_v1_f1_proto_msgTypes,
+	}.Build()
+	File_f1_v1_f1_proto = out.File
+	file_f1_v1_f1_proto_goTypes = nil
+	file_f1_v1_f1_proto_depIdxs = nil
+}
@@ -0,0 +1,3198 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: f1/v1/f1.proto
+
+package f1v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConstructorService_CreateConstructor_FullMethodName      = "/f1.v1.ConstructorService/CreateConstructor"
+	ConstructorService_GetConstructor_FullMethodName         = "/f1.v1.ConstructorService/GetConstructor"
+	ConstructorService_ListConstructors_FullMethodName       = "/f1.v1.ConstructorService/ListConstructors"
+	ConstructorService_StreamConstructors_FullMethodName     = "/f1.v1.ConstructorService/StreamConstructors"
+	ConstructorService_UpdateConstructor_FullMethodName      = "/f1.v1.ConstructorService/UpdateConstructor"
+	ConstructorService_DeleteConstructor_FullMethodName      = "/f1.v1.ConstructorService/DeleteConstructor"
+	ConstructorService_RestoreConstructor_FullMethodName     = "/f1.v1.ConstructorService/RestoreConstructor"
+	ConstructorService_PurgeConstructor_FullMethodName       = "/f1.v1.ConstructorService/PurgeConstructor"
+	ConstructorService_BulkUpsertConstructors_FullMethodName = "/f1.v1.ConstructorService/BulkUpsertConstructors"
+	ConstructorService_GetConstructorHistory_FullMethodName  = "/f1.v1.ConstructorService/GetConstructorHistory"
+	ConstructorService_RevertConstructor_FullMethodName      = "/f1.v1.ConstructorService/RevertConstructor"
+)
+
+// ConstructorServiceClient is the client API for ConstructorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConstructorServiceClient interface {
+	CreateConstructor(ctx context.Context, in *Constructor, opts ...grpc.CallOption) (*Constructor, error)
+	GetConstructor(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Constructor, error)
+	ListConstructors(ctx context.Context, in *ListConstructorsRequest, opts ...grpc.CallOption) (*ListConstructorsResponse, error)
+	// StreamConstructors sends every constructor matching all the set
+	// filters, unpaginated, as it is read.
+	StreamConstructors(ctx context.Context, in *StreamConstructorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Constructor], error)
+	UpdateConstructor(ctx context.Context, in *UpdateConstructorRequest, opts ...grpc.CallOption) (*Constructor, error)
+	DeleteConstructor(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreConstructor(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeConstructor(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkUpsertConstructors(ctx context.Context, in *BulkUpsertConstructorsRequest, opts ...grpc.CallOption) (*BulkResult, error)
+	GetConstructorHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	RevertConstructor(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+}
+
+type constructorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConstructorServiceClient(cc grpc.ClientConnInterface) ConstructorServiceClient {
+	return &constructorServiceClient{cc}
+}
+
+func (c *constructorServiceClient) CreateConstructor(ctx context.Context, in *Constructor, opts ...grpc.CallOption) (*Constructor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Constructor)
+	err := c.cc.Invoke(ctx, ConstructorService_CreateConstructor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) GetConstructor(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Constructor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Constructor)
+	err := c.cc.Invoke(ctx, ConstructorService_GetConstructor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) ListConstructors(ctx context.Context, in *ListConstructorsRequest, opts ...grpc.CallOption) (*ListConstructorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConstructorsResponse)
+	err := c.cc.Invoke(ctx, ConstructorService_ListConstructors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) StreamConstructors(ctx context.Context, in *StreamConstructorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Constructor], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConstructorService_ServiceDesc.Streams[0], ConstructorService_StreamConstructors_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamConstructorsRequest, Constructor]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConstructorService_StreamConstructorsClient = grpc.ServerStreamingClient[Constructor]
+
+func (c *constructorServiceClient) UpdateConstructor(ctx context.Context, in *UpdateConstructorRequest, opts ...grpc.CallOption) (*Constructor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Constructor)
+	err := c.cc.Invoke(ctx, ConstructorService_UpdateConstructor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) DeleteConstructor(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConstructorService_DeleteConstructor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) RestoreConstructor(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConstructorService_RestoreConstructor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) PurgeConstructor(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConstructorService_PurgeConstructor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) BulkUpsertConstructors(ctx context.Context, in *BulkUpsertConstructorsRequest, opts ...grpc.CallOption) (*BulkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResult)
+	err := c.cc.Invoke(ctx, ConstructorService_BulkUpsertConstructors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) GetConstructorHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, ConstructorService_GetConstructorHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructorServiceClient) RevertConstructor(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, ConstructorService_RevertConstructor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConstructorServiceServer is the server API for ConstructorService service.
+// All implementations must embed UnimplementedConstructorServiceServer
+// for forward compatibility.
+type ConstructorServiceServer interface {
+	CreateConstructor(context.Context, *Constructor) (*Constructor, error)
+	GetConstructor(context.Context, *GetRequest) (*Constructor, error)
+	ListConstructors(context.Context, *ListConstructorsRequest) (*ListConstructorsResponse, error)
+	// StreamConstructors sends every constructor matching all the set
+	// filters, unpaginated, as it is read.
+	StreamConstructors(*StreamConstructorsRequest, grpc.ServerStreamingServer[Constructor]) error
+	UpdateConstructor(context.Context, *UpdateConstructorRequest) (*Constructor, error)
+	DeleteConstructor(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	RestoreConstructor(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	PurgeConstructor(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	BulkUpsertConstructors(context.Context, *BulkUpsertConstructorsRequest) (*BulkResult, error)
+	GetConstructorHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	RevertConstructor(context.Context, *RevertRequest) (*RevertResponse, error)
+	mustEmbedUnimplementedConstructorServiceServer()
+}
+
+// UnimplementedConstructorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConstructorServiceServer struct{}
+
+func (UnimplementedConstructorServiceServer) CreateConstructor(context.Context, *Constructor) (*Constructor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConstructor not implemented")
+}
+func (UnimplementedConstructorServiceServer) GetConstructor(context.Context, *GetRequest) (*Constructor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConstructor not implemented")
+}
+func (UnimplementedConstructorServiceServer) ListConstructors(context.Context, *ListConstructorsRequest) (*ListConstructorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConstructors not implemented")
+}
+func (UnimplementedConstructorServiceServer) StreamConstructors(*StreamConstructorsRequest, grpc.ServerStreamingServer[Constructor]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamConstructors not implemented")
+}
+func (UnimplementedConstructorServiceServer) UpdateConstructor(context.Context, *UpdateConstructorRequest) (*Constructor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConstructor not implemented")
+}
+func (UnimplementedConstructorServiceServer) DeleteConstructor(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConstructor not implemented")
+}
+func (UnimplementedConstructorServiceServer) RestoreConstructor(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConstructor not implemented")
+}
+func (UnimplementedConstructorServiceServer) PurgeConstructor(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeConstructor not implemented")
+}
+func (UnimplementedConstructorServiceServer) BulkUpsertConstructors(context.Context, *BulkUpsertConstructorsRequest) (*BulkResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertConstructors not implemented")
+}
+func (UnimplementedConstructorServiceServer) GetConstructorHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConstructorHistory not implemented")
+}
+func (UnimplementedConstructorServiceServer) RevertConstructor(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertConstructor not implemented")
+}
+func (UnimplementedConstructorServiceServer) mustEmbedUnimplementedConstructorServiceServer() {}
+func (UnimplementedConstructorServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeConstructorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConstructorServiceServer will
+// result in compilation errors.
+type UnsafeConstructorServiceServer interface {
+	mustEmbedUnimplementedConstructorServiceServer()
+}
+
+func RegisterConstructorServiceServer(s grpc.ServiceRegistrar, srv ConstructorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConstructorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConstructorService_ServiceDesc, srv)
+}
+
+func _ConstructorService_CreateConstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Constructor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).CreateConstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_CreateConstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).CreateConstructor(ctx, req.(*Constructor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_GetConstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).GetConstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_GetConstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).GetConstructor(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_ListConstructors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConstructorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).ListConstructors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_ListConstructors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).ListConstructors(ctx, req.(*ListConstructorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_StreamConstructors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamConstructorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConstructorServiceServer).StreamConstructors(m, &grpc.GenericServerStream[StreamConstructorsRequest, Constructor]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConstructorService_StreamConstructorsServer = grpc.ServerStreamingServer[Constructor]
+
+func _ConstructorService_UpdateConstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConstructorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).UpdateConstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_UpdateConstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).UpdateConstructor(ctx, req.(*UpdateConstructorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_DeleteConstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).DeleteConstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_DeleteConstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).DeleteConstructor(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_RestoreConstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).RestoreConstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_RestoreConstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).RestoreConstructor(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_PurgeConstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).PurgeConstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_PurgeConstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).PurgeConstructor(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_BulkUpsertConstructors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertConstructorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).BulkUpsertConstructors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_BulkUpsertConstructors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).BulkUpsertConstructors(ctx, req.(*BulkUpsertConstructorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_GetConstructorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).GetConstructorHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_GetConstructorHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).GetConstructorHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructorService_RevertConstructor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructorServiceServer).RevertConstructor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructorService_RevertConstructor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructorServiceServer).RevertConstructor(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConstructorService_ServiceDesc is the grpc.ServiceDesc for ConstructorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConstructorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "f1.v1.ConstructorService",
+	HandlerType: (*ConstructorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateConstructor",
+			Handler:    _ConstructorService_CreateConstructor_Handler,
+		},
+		{
+			MethodName: "GetConstructor",
+			Handler:    _ConstructorService_GetConstructor_Handler,
+		},
+		{
+			MethodName: "ListConstructors",
+			Handler:    _ConstructorService_ListConstructors_Handler,
+		},
+		{
+			MethodName: "UpdateConstructor",
+			Handler:    _ConstructorService_UpdateConstructor_Handler,
+		},
+		{
+			MethodName: "DeleteConstructor",
+			Handler:    _ConstructorService_DeleteConstructor_Handler,
+		},
+		{
+			MethodName: "RestoreConstructor",
+			Handler:    _ConstructorService_RestoreConstructor_Handler,
+		},
+		{
+			MethodName: "PurgeConstructor",
+			Handler:    _ConstructorService_PurgeConstructor_Handler,
+		},
+		{
+			MethodName: "BulkUpsertConstructors",
+			Handler:    _ConstructorService_BulkUpsertConstructors_Handler,
+		},
+		{
+			MethodName: "GetConstructorHistory",
+			Handler:    _ConstructorService_GetConstructorHistory_Handler,
+		},
+		{
+			MethodName: "RevertConstructor",
+			Handler:    _ConstructorService_RevertConstructor_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamConstructors",
+			Handler:       _ConstructorService_StreamConstructors_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "f1/v1/f1.proto",
+}
+
+const (
+	DriverService_CreateDriver_FullMethodName      = "/f1.v1.DriverService/CreateDriver"
+	DriverService_GetDriver_FullMethodName         = "/f1.v1.DriverService/GetDriver"
+	DriverService_ListDrivers_FullMethodName       = "/f1.v1.DriverService/ListDrivers"
+	DriverService_StreamDrivers_FullMethodName     = "/f1.v1.DriverService/StreamDrivers"
+	DriverService_UpdateDriver_FullMethodName      = "/f1.v1.DriverService/UpdateDriver"
+	DriverService_DeleteDriver_FullMethodName      = "/f1.v1.DriverService/DeleteDriver"
+	DriverService_RestoreDriver_FullMethodName     = "/f1.v1.DriverService/RestoreDriver"
+	DriverService_PurgeDriver_FullMethodName       = "/f1.v1.DriverService/PurgeDriver"
+	DriverService_MergeDrivers_FullMethodName      = "/f1.v1.DriverService/MergeDrivers"
+	DriverService_BulkUpsertDrivers_FullMethodName = "/f1.v1.DriverService/BulkUpsertDrivers"
+	DriverService_GetDriverHistory_FullMethodName  = "/f1.v1.DriverService/GetDriverHistory"
+	DriverService_RevertDriver_FullMethodName      = "/f1.v1.DriverService/RevertDriver"
+)
+
+// DriverServiceClient is the client API for DriverService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DriverServiceClient interface {
+	CreateDriver(ctx context.Context, in *Driver, opts ...grpc.CallOption) (*Driver, error)
+	GetDriver(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Driver, error)
+	ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error)
+	// StreamDrivers sends every driver matching all the set filters,
+	// unpaginated, as it is read.
+	StreamDrivers(ctx context.Context, in *StreamDriversRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Driver], error)
+	UpdateDriver(ctx context.Context, in *UpdateDriverRequest, opts ...grpc.CallOption) (*Driver, error)
+	DeleteDriver(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreDriver(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeDriver(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// MergeDrivers moves the results and standings of a driver to another one
+	// and deletes it.
+	MergeDrivers(ctx context.Context, in *MergeDriversRequest, opts ...grpc.CallOption) (*Driver, error)
+	BulkUpsertDrivers(ctx context.Context, in *BulkUpsertDriversRequest, opts ...grpc.CallOption) (*BulkResult, error)
+	GetDriverHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	RevertDriver(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+}
+
+type driverServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDriverServiceClient(cc grpc.ClientConnInterface) DriverServiceClient {
+	return &driverServiceClient{cc}
+}
+
+func (c *driverServiceClient) CreateDriver(ctx context.Context, in *Driver, opts ...grpc.CallOption) (*Driver, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, DriverService_CreateDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriver(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Driver, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, DriverService_GetDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDriversResponse)
+	err := c.cc.Invoke(ctx, DriverService_ListDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) StreamDrivers(ctx context.Context, in *StreamDriversRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Driver], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DriverService_ServiceDesc.Streams[0], DriverService_StreamDrivers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamDriversRequest, Driver]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverService_StreamDriversClient = grpc.ServerStreamingClient[Driver]
+
+func (c *driverServiceClient) UpdateDriver(ctx context.Context, in *UpdateDriverRequest, opts ...grpc.CallOption) (*Driver, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, DriverService_UpdateDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) DeleteDriver(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DriverService_DeleteDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) RestoreDriver(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DriverService_RestoreDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) PurgeDriver(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DriverService_PurgeDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) MergeDrivers(ctx context.Context, in *MergeDriversRequest, opts ...grpc.CallOption) (*Driver, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, DriverService_MergeDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) BulkUpsertDrivers(ctx context.Context, in *BulkUpsertDriversRequest, opts ...grpc.CallOption) (*BulkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResult)
+	err := c.cc.Invoke(ctx, DriverService_BulkUpsertDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriverHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetDriverHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) RevertDriver(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, DriverService_RevertDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DriverServiceServer is the server API for DriverService service.
+// All implementations must embed UnimplementedDriverServiceServer
+// for forward compatibility.
+type DriverServiceServer interface {
+	CreateDriver(context.Context, *Driver) (*Driver, error)
+	GetDriver(context.Context, *GetRequest) (*Driver, error)
+	ListDrivers(context.Context, *ListDriversRequest) (*ListDriversResponse, error)
+	// StreamDrivers sends every driver matching all the set filters,
+	// unpaginated, as it is read.
+	StreamDrivers(*StreamDriversRequest, grpc.ServerStreamingServer[Driver]) error
+	UpdateDriver(context.Context, *UpdateDriverRequest) (*Driver, error)
+	DeleteDriver(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	RestoreDriver(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	PurgeDriver(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	// MergeDrivers moves the results and standings of a driver to another one
+	// and deletes it.
+	MergeDrivers(context.Context, *MergeDriversRequest) (*Driver, error)
+	BulkUpsertDrivers(context.Context, *BulkUpsertDriversRequest) (*BulkResult, error)
+	GetDriverHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	RevertDriver(context.Context, *RevertRequest) (*RevertResponse, error)
+	mustEmbedUnimplementedDriverServiceServer()
+}
+
+// UnimplementedDriverServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDriverServiceServer struct{}
+
+func (UnimplementedDriverServiceServer) CreateDriver(context.Context, *Driver) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriver(context.Context, *GetRequest) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ListDrivers(context.Context, *ListDriversRequest) (*ListDriversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) StreamDrivers(*StreamDriversRequest, grpc.ServerStreamingServer[Driver]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) UpdateDriver(context.Context, *UpdateDriverRequest) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) DeleteDriver(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) RestoreDriver(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) PurgeDriver(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) MergeDrivers(context.Context, *MergeDriversRequest) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) BulkUpsertDrivers(context.Context, *BulkUpsertDriversRequest) (*BulkResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriverHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverHistory not implemented")
+}
+func (UnimplementedDriverServiceServer) RevertDriver(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) mustEmbedUnimplementedDriverServiceServer() {}
+func (UnimplementedDriverServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeDriverServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DriverServiceServer will
+// result in compilation errors.
+type UnsafeDriverServiceServer interface {
+	mustEmbedUnimplementedDriverServiceServer()
+}
+
+func RegisterDriverServiceServer(s grpc.ServiceRegistrar, srv DriverServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDriverServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DriverService_ServiceDesc, srv)
+}
+
+func _DriverService_CreateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Driver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).CreateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_CreateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).CreateDriver(ctx, req.(*Driver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriver(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ListDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListDrivers(ctx, req.(*ListDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_StreamDrivers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDriversRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DriverServiceServer).StreamDrivers(m, &grpc.GenericServerStream[StreamDriversRequest, Driver]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverService_StreamDriversServer = grpc.ServerStreamingServer[Driver]
+
+func _DriverService_UpdateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UpdateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_UpdateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UpdateDriver(ctx, req.(*UpdateDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_DeleteDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).DeleteDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_DeleteDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).DeleteDriver(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_RestoreDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).RestoreDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_RestoreDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).RestoreDriver(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_PurgeDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).PurgeDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_PurgeDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).PurgeDriver(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_MergeDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).MergeDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_MergeDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).MergeDrivers(ctx, req.(*MergeDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_BulkUpsertDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).BulkUpsertDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_BulkUpsertDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).BulkUpsertDrivers(ctx, req.(*BulkUpsertDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriverHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriverHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriverHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriverHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_RevertDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).RevertDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_RevertDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).RevertDriver(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DriverService_ServiceDesc is the grpc.ServiceDesc for DriverService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DriverService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "f1.v1.DriverService",
+	HandlerType: (*DriverServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDriver",
+			Handler:    _DriverService_CreateDriver_Handler,
+		},
+		{
+			MethodName: "GetDriver",
+			Handler:    _DriverService_GetDriver_Handler,
+		},
+		{
+			MethodName: "ListDrivers",
+			Handler:    _DriverService_ListDrivers_Handler,
+		},
+		{
+			MethodName: "UpdateDriver",
+			Handler:    _DriverService_UpdateDriver_Handler,
+		},
+		{
+			MethodName: "DeleteDriver",
+			Handler:    _DriverService_DeleteDriver_Handler,
+		},
+		{
+			MethodName: "RestoreDriver",
+			Handler:    _DriverService_RestoreDriver_Handler,
+		},
+		{
+			MethodName: "PurgeDriver",
+			Handler:    _DriverService_PurgeDriver_Handler,
+		},
+		{
+			MethodName: "MergeDrivers",
+			Handler:    _DriverService_MergeDrivers_Handler,
+		},
+		{
+			MethodName: "BulkUpsertDrivers",
+			Handler:    _DriverService_BulkUpsertDrivers_Handler,
+		},
+		{
+			MethodName: "GetDriverHistory",
+			Handler:    _DriverService_GetDriverHistory_Handler,
+		},
+		{
+			MethodName: "RevertDriver",
+			Handler:    _DriverService_RevertDriver_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDrivers",
+			Handler:       _DriverService_StreamDrivers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "f1/v1/f1.proto",
+}
+
+const (
+	CircuitService_CreateCircuit_FullMethodName      = "/f1.v1.CircuitService/CreateCircuit"
+	CircuitService_GetCircuit_FullMethodName         = "/f1.v1.CircuitService/GetCircuit"
+	CircuitService_ListCircuits_FullMethodName       = "/f1.v1.CircuitService/ListCircuits"
+	CircuitService_StreamCircuits_FullMethodName     = "/f1.v1.CircuitService/StreamCircuits"
+	CircuitService_UpdateCircuit_FullMethodName      = "/f1.v1.CircuitService/UpdateCircuit"
+	CircuitService_DeleteCircuit_FullMethodName      = "/f1.v1.CircuitService/DeleteCircuit"
+	CircuitService_RestoreCircuit_FullMethodName     = "/f1.v1.CircuitService/RestoreCircuit"
+	CircuitService_PurgeCircuit_FullMethodName       = "/f1.v1.CircuitService/PurgeCircuit"
+	CircuitService_BulkUpsertCircuits_FullMethodName = "/f1.v1.CircuitService/BulkUpsertCircuits"
+	CircuitService_GetCircuitHistory_FullMethodName  = "/f1.v1.CircuitService/GetCircuitHistory"
+	CircuitService_RevertCircuit_FullMethodName      = "/f1.v1.CircuitService/RevertCircuit"
+)
+
+// CircuitServiceClient is the client API for CircuitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CircuitServiceClient interface {
+	CreateCircuit(ctx context.Context, in *Circuit, opts ...grpc.CallOption) (*Circuit, error)
+	GetCircuit(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Circuit, error)
+	ListCircuits(ctx context.Context, in *ListCircuitsRequest, opts ...grpc.CallOption) (*ListCircuitsResponse, error)
+	// StreamCircuits sends every circuit matching all the set filters,
+	// unpaginated, as it is read.
+	StreamCircuits(ctx context.Context, in *StreamCircuitsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Circuit], error)
+	UpdateCircuit(ctx context.Context, in *UpdateCircuitRequest, opts ...grpc.CallOption) (*Circuit, error)
+	DeleteCircuit(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreCircuit(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeCircuit(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkUpsertCircuits(ctx context.Context, in *BulkUpsertCircuitsRequest, opts ...grpc.CallOption) (*BulkResult, error)
+	GetCircuitHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	RevertCircuit(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+}
+
+type circuitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCircuitServiceClient(cc grpc.ClientConnInterface) CircuitServiceClient {
+	return &circuitServiceClient{cc}
+}
+
+func (c *circuitServiceClient) CreateCircuit(ctx context.Context, in *Circuit, opts ...grpc.CallOption) (*Circuit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Circuit)
+	err := c.cc.Invoke(ctx, CircuitService_CreateCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) GetCircuit(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Circuit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Circuit)
+	err := c.cc.Invoke(ctx, CircuitService_GetCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) ListCircuits(ctx context.Context, in *ListCircuitsRequest, opts ...grpc.CallOption) (*ListCircuitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCircuitsResponse)
+	err := c.cc.Invoke(ctx, CircuitService_ListCircuits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) StreamCircuits(ctx context.Context, in *StreamCircuitsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Circuit], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CircuitService_ServiceDesc.Streams[0], CircuitService_StreamCircuits_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamCircuitsRequest, Circuit]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CircuitService_StreamCircuitsClient = grpc.ServerStreamingClient[Circuit]
+
+func (c *circuitServiceClient) UpdateCircuit(ctx context.Context, in *UpdateCircuitRequest, opts ...grpc.CallOption) (*Circuit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Circuit)
+	err := c.cc.Invoke(ctx, CircuitService_UpdateCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) DeleteCircuit(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CircuitService_DeleteCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) RestoreCircuit(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CircuitService_RestoreCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) PurgeCircuit(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CircuitService_PurgeCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) BulkUpsertCircuits(ctx context.Context, in *BulkUpsertCircuitsRequest, opts ...grpc.CallOption) (*BulkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResult)
+	err := c.cc.Invoke(ctx, CircuitService_BulkUpsertCircuits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) GetCircuitHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, CircuitService_GetCircuitHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *circuitServiceClient) RevertCircuit(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, CircuitService_RevertCircuit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CircuitServiceServer is the server API for CircuitService service.
+// All implementations must embed UnimplementedCircuitServiceServer
+// for forward compatibility.
+type CircuitServiceServer interface {
+	CreateCircuit(context.Context, *Circuit) (*Circuit, error)
+	GetCircuit(context.Context, *GetRequest) (*Circuit, error)
+	ListCircuits(context.Context, *ListCircuitsRequest) (*ListCircuitsResponse, error)
+	// StreamCircuits sends every circuit matching all the set filters,
+	// unpaginated, as it is read.
+	StreamCircuits(*StreamCircuitsRequest, grpc.ServerStreamingServer[Circuit]) error
+	UpdateCircuit(context.Context, *UpdateCircuitRequest) (*Circuit, error)
+	DeleteCircuit(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	RestoreCircuit(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	PurgeCircuit(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	BulkUpsertCircuits(context.Context, *BulkUpsertCircuitsRequest) (*BulkResult, error)
+	GetCircuitHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	RevertCircuit(context.Context, *RevertRequest) (*RevertResponse, error)
+	mustEmbedUnimplementedCircuitServiceServer()
+}
+
+// UnimplementedCircuitServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCircuitServiceServer struct{}
+
+func (UnimplementedCircuitServiceServer) CreateCircuit(context.Context, *Circuit) (*Circuit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCircuit not implemented")
+}
+func (UnimplementedCircuitServiceServer) GetCircuit(context.Context, *GetRequest) (*Circuit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCircuit not implemented")
+}
+func (UnimplementedCircuitServiceServer) ListCircuits(context.Context, *ListCircuitsRequest) (*ListCircuitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCircuits not implemented")
+}
+func (UnimplementedCircuitServiceServer) StreamCircuits(*StreamCircuitsRequest, grpc.ServerStreamingServer[Circuit]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCircuits not implemented")
+}
+func (UnimplementedCircuitServiceServer) UpdateCircuit(context.Context, *UpdateCircuitRequest) (*Circuit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuit not implemented")
+}
+func (UnimplementedCircuitServiceServer) DeleteCircuit(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCircuit not implemented")
+}
+func (UnimplementedCircuitServiceServer) RestoreCircuit(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCircuit not implemented")
+}
+func (UnimplementedCircuitServiceServer) PurgeCircuit(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCircuit not implemented")
+}
+func (UnimplementedCircuitServiceServer) BulkUpsertCircuits(context.Context, *BulkUpsertCircuitsRequest) (*BulkResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertCircuits not implemented")
+}
+func (UnimplementedCircuitServiceServer) GetCircuitHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCircuitHistory not implemented")
+}
+func (UnimplementedCircuitServiceServer) RevertCircuit(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertCircuit not implemented")
+}
+func (UnimplementedCircuitServiceServer) mustEmbedUnimplementedCircuitServiceServer() {}
+func (UnimplementedCircuitServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCircuitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CircuitServiceServer will
+// result in compilation errors.
+type UnsafeCircuitServiceServer interface {
+	mustEmbedUnimplementedCircuitServiceServer()
+}
+
+func RegisterCircuitServiceServer(s grpc.ServiceRegistrar, srv CircuitServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCircuitServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CircuitService_ServiceDesc, srv)
+}
+
+func _CircuitService_CreateCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Circuit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).CreateCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_CreateCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).CreateCircuit(ctx, req.(*Circuit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_GetCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).GetCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_GetCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).GetCircuit(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_ListCircuits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCircuitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).ListCircuits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_ListCircuits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).ListCircuits(ctx, req.(*ListCircuitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_StreamCircuits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCircuitsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CircuitServiceServer).StreamCircuits(m, &grpc.GenericServerStream[StreamCircuitsRequest, Circuit]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CircuitService_StreamCircuitsServer = grpc.ServerStreamingServer[Circuit]
+
+func _CircuitService_UpdateCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).UpdateCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_UpdateCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).UpdateCircuit(ctx, req.(*UpdateCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_DeleteCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).DeleteCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_DeleteCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).DeleteCircuit(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_RestoreCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).RestoreCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_RestoreCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).RestoreCircuit(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_PurgeCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).PurgeCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_PurgeCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).PurgeCircuit(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_BulkUpsertCircuits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertCircuitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).BulkUpsertCircuits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_BulkUpsertCircuits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).BulkUpsertCircuits(ctx, req.(*BulkUpsertCircuitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_GetCircuitHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).GetCircuitHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_GetCircuitHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).GetCircuitHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CircuitService_RevertCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CircuitServiceServer).RevertCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CircuitService_RevertCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CircuitServiceServer).RevertCircuit(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CircuitService_ServiceDesc is the grpc.ServiceDesc for CircuitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CircuitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "f1.v1.CircuitService",
+	HandlerType: (*CircuitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCircuit",
+			Handler:    _CircuitService_CreateCircuit_Handler,
+		},
+		{
+			MethodName: "GetCircuit",
+			Handler:    _CircuitService_GetCircuit_Handler,
+		},
+		{
+			MethodName: "ListCircuits",
+			Handler:    _CircuitService_ListCircuits_Handler,
+		},
+		{
+			MethodName: "UpdateCircuit",
+			Handler:    _CircuitService_UpdateCircuit_Handler,
+		},
+		{
+			MethodName: "DeleteCircuit",
+			Handler:    _CircuitService_DeleteCircuit_Handler,
+		},
+		{
+			MethodName: "RestoreCircuit",
+			Handler:    _CircuitService_RestoreCircuit_Handler,
+		},
+		{
+			MethodName: "PurgeCircuit",
+			Handler:    _CircuitService_PurgeCircuit_Handler,
+		},
+		{
+			MethodName: "BulkUpsertCircuits",
+			Handler:    _CircuitService_BulkUpsertCircuits_Handler,
+		},
+		{
+			MethodName: "GetCircuitHistory",
+			Handler:    _CircuitService_GetCircuitHistory_Handler,
+		},
+		{
+			MethodName: "RevertCircuit",
+			Handler:    _CircuitService_RevertCircuit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCircuits",
+			Handler:       _CircuitService_StreamCircuits_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "f1/v1/f1.proto",
+}
+
+const (
+	SeasonService_ListSeasonRaces_FullMethodName       = "/f1.v1.SeasonService/ListSeasonRaces"
+	SeasonService_GetSeasonRace_FullMethodName         = "/f1.v1.SeasonService/GetSeasonRace"
+	SeasonService_ListSeasonRaceResults_FullMethodName = "/f1.v1.SeasonService/ListSeasonRaceResults"
+	SeasonService_DeleteSeason_FullMethodName          = "/f1.v1.SeasonService/DeleteSeason"
+	SeasonService_RestoreSeason_FullMethodName         = "/f1.v1.SeasonService/RestoreSeason"
+	SeasonService_PurgeSeason_FullMethodName           = "/f1.v1.SeasonService/PurgeSeason"
+	SeasonService_BulkUpsertSeasons_FullMethodName     = "/f1.v1.SeasonService/BulkUpsertSeasons"
+	SeasonService_GetSeasonHistory_FullMethodName      = "/f1.v1.SeasonService/GetSeasonHistory"
+	SeasonService_RevertSeason_FullMethodName          = "/f1.v1.SeasonService/RevertSeason"
+)
+
+// SeasonServiceClient is the client API for SeasonService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SeasonServiceClient interface {
+	ListSeasonRaces(ctx context.Context, in *SeasonRequest, opts ...grpc.CallOption) (*ListRacesResponse, error)
+	GetSeasonRace(ctx context.Context, in *SeasonRaceRequest, opts ...grpc.CallOption) (*Race, error)
+	ListSeasonRaceResults(ctx context.Context, in *SeasonRaceRequest, opts ...grpc.CallOption) (*ListResultsResponse, error)
+	// Seasons are addressed by ID or year.
+	DeleteSeason(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreSeason(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeSeason(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkUpsertSeasons(ctx context.Context, in *BulkUpsertSeasonsRequest, opts ...grpc.CallOption) (*BulkResult, error)
+	GetSeasonHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	RevertSeason(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+}
+
+type seasonServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSeasonServiceClient(cc grpc.ClientConnInterface) SeasonServiceClient {
+	return &seasonServiceClient{cc}
+}
+
+func (c *seasonServiceClient) ListSeasonRaces(ctx context.Context, in *SeasonRequest, opts ...grpc.CallOption) (*ListRacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRacesResponse)
+	err := c.cc.Invoke(ctx, SeasonService_ListSeasonRaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) GetSeasonRace(ctx context.Context, in *SeasonRaceRequest, opts ...grpc.CallOption) (*Race, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Race)
+	err := c.cc.Invoke(ctx, SeasonService_GetSeasonRace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) ListSeasonRaceResults(ctx context.Context, in *SeasonRaceRequest, opts ...grpc.CallOption) (*ListResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResultsResponse)
+	err := c.cc.Invoke(ctx, SeasonService_ListSeasonRaceResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) DeleteSeason(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SeasonService_DeleteSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) RestoreSeason(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SeasonService_RestoreSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) PurgeSeason(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SeasonService_PurgeSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) BulkUpsertSeasons(ctx context.Context, in *BulkUpsertSeasonsRequest, opts ...grpc.CallOption) (*BulkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResult)
+	err := c.cc.Invoke(ctx, SeasonService_BulkUpsertSeasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) GetSeasonHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, SeasonService_GetSeasonHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seasonServiceClient) RevertSeason(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, SeasonService_RevertSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SeasonServiceServer is the server API for SeasonService service.
+// All implementations must embed UnimplementedSeasonServiceServer
+// for forward compatibility.
+type SeasonServiceServer interface {
+	ListSeasonRaces(context.Context, *SeasonRequest) (*ListRacesResponse, error)
+	GetSeasonRace(context.Context, *SeasonRaceRequest) (*Race, error)
+	ListSeasonRaceResults(context.Context, *SeasonRaceRequest) (*ListResultsResponse, error)
+	// Seasons are addressed by ID or year.
+	DeleteSeason(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	RestoreSeason(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	PurgeSeason(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	BulkUpsertSeasons(context.Context, *BulkUpsertSeasonsRequest) (*BulkResult, error)
+	GetSeasonHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	RevertSeason(context.Context, *RevertRequest) (*RevertResponse, error)
+	mustEmbedUnimplementedSeasonServiceServer()
+}
+
+// UnimplementedSeasonServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSeasonServiceServer struct{}
+
+func (UnimplementedSeasonServiceServer) ListSeasonRaces(context.Context, *SeasonRequest) (*ListRacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonRaces not implemented")
+}
+func (UnimplementedSeasonServiceServer) GetSeasonRace(context.Context, *SeasonRaceRequest) (*Race, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasonRace not implemented")
+}
+func (UnimplementedSeasonServiceServer) ListSeasonRaceResults(context.Context, *SeasonRaceRequest) (*ListResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonRaceResults not implemented")
+}
+func (UnimplementedSeasonServiceServer) DeleteSeason(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeason not implemented")
+}
+func (UnimplementedSeasonServiceServer) RestoreSeason(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSeason not implemented")
+}
+func (UnimplementedSeasonServiceServer) PurgeSeason(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeSeason not implemented")
+}
+func (UnimplementedSeasonServiceServer) BulkUpsertSeasons(context.Context, *BulkUpsertSeasonsRequest) (*BulkResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertSeasons not implemented")
+}
+func (UnimplementedSeasonServiceServer) GetSeasonHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasonHistory not implemented")
+}
+func (UnimplementedSeasonServiceServer) RevertSeason(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertSeason not implemented")
+}
+func (UnimplementedSeasonServiceServer) mustEmbedUnimplementedSeasonServiceServer() {}
+func (UnimplementedSeasonServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSeasonServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SeasonServiceServer will
+// result in compilation errors.
+type UnsafeSeasonServiceServer interface {
+	mustEmbedUnimplementedSeasonServiceServer()
+}
+
+func RegisterSeasonServiceServer(s grpc.ServiceRegistrar, srv SeasonServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSeasonServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SeasonService_ServiceDesc, srv)
+}
+
+func _SeasonService_ListSeasonRaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).ListSeasonRaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_ListSeasonRaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).ListSeasonRaces(ctx, req.(*SeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_GetSeasonRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonRaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).GetSeasonRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_GetSeasonRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).GetSeasonRace(ctx, req.(*SeasonRaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_ListSeasonRaceResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonRaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).ListSeasonRaceResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_ListSeasonRaceResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).ListSeasonRaceResults(ctx, req.(*SeasonRaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_DeleteSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).DeleteSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_DeleteSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).DeleteSeason(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_RestoreSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).RestoreSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_RestoreSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).RestoreSeason(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_PurgeSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).PurgeSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_PurgeSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).PurgeSeason(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_BulkUpsertSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).BulkUpsertSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_BulkUpsertSeasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).BulkUpsertSeasons(ctx, req.(*BulkUpsertSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_GetSeasonHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).GetSeasonHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_GetSeasonHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).GetSeasonHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeasonService_RevertSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeasonServiceServer).RevertSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeasonService_RevertSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeasonServiceServer).RevertSeason(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SeasonService_ServiceDesc is the grpc.ServiceDesc for SeasonService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SeasonService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "f1.v1.SeasonService",
+	HandlerType: (*SeasonServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSeasonRaces",
+			Handler:    _SeasonService_ListSeasonRaces_Handler,
+		},
+		{
+			MethodName: "GetSeasonRace",
+			Handler:    _SeasonService_GetSeasonRace_Handler,
+		},
+		{
+			MethodName: "ListSeasonRaceResults",
+			Handler:    _SeasonService_ListSeasonRaceResults_Handler,
+		},
+		{
+			MethodName: "DeleteSeason",
+			Handler:    _SeasonService_DeleteSeason_Handler,
+		},
+		{
+			MethodName: "RestoreSeason",
+			Handler:    _SeasonService_RestoreSeason_Handler,
+		},
+		{
+			MethodName: "PurgeSeason",
+			Handler:    _SeasonService_PurgeSeason_Handler,
+		},
+		{
+			MethodName: "BulkUpsertSeasons",
+			Handler:    _SeasonService_BulkUpsertSeasons_Handler,
+		},
+		{
+			MethodName: "GetSeasonHistory",
+			Handler:    _SeasonService_GetSeasonHistory_Handler,
+		},
+		{
+			MethodName: "RevertSeason",
+			Handler:    _SeasonService_RevertSeason_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "f1/v1/f1.proto",
+}
+
+const (
+	RaceService_CreateRace_FullMethodName      = "/f1.v1.RaceService/CreateRace"
+	RaceService_ListRaceResults_FullMethodName = "/f1.v1.RaceService/ListRaceResults"
+	RaceService_DeleteRace_FullMethodName      = "/f1.v1.RaceService/DeleteRace"
+	RaceService_RestoreRace_FullMethodName     = "/f1.v1.RaceService/RestoreRace"
+	RaceService_PurgeRace_FullMethodName       = "/f1.v1.RaceService/PurgeRace"
+	RaceService_BulkUpsertRaces_FullMethodName = "/f1.v1.RaceService/BulkUpsertRaces"
+	RaceService_GetRaceHistory_FullMethodName  = "/f1.v1.RaceService/GetRaceHistory"
+	RaceService_RevertRace_FullMethodName      = "/f1.v1.RaceService/RevertRace"
+)
+
+// RaceServiceClient is the client API for RaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RaceServiceClient interface {
+	// CreateRace creates a race together with its results.
+	CreateRace(ctx context.Context, in *RaceWithResults, opts ...grpc.CallOption) (*RaceWithResults, error)
+	ListRaceResults(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ListResultsResponse, error)
+	DeleteRace(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreRace(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeRace(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkUpsertRaces(ctx context.Context, in *BulkUpsertRacesRequest, opts ...grpc.CallOption) (*BulkResult, error)
+	GetRaceHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	RevertRace(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+}
+
+type raceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRaceServiceClient(cc grpc.ClientConnInterface) RaceServiceClient {
+	return &raceServiceClient{cc}
+}
+
+func (c *raceServiceClient) CreateRace(ctx context.Context, in *RaceWithResults, opts ...grpc.CallOption) (*RaceWithResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RaceWithResults)
+	err := c.cc.Invoke(ctx, RaceService_CreateRace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceServiceClient) ListRaceResults(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ListResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResultsResponse)
+	err := c.cc.Invoke(ctx, RaceService_ListRaceResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceServiceClient) DeleteRace(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RaceService_DeleteRace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceServiceClient) RestoreRace(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RaceService_RestoreRace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceServiceClient) PurgeRace(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RaceService_PurgeRace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceServiceClient) BulkUpsertRaces(ctx context.Context, in *BulkUpsertRacesRequest, opts ...grpc.CallOption) (*BulkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResult)
+	err := c.cc.Invoke(ctx, RaceService_BulkUpsertRaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceServiceClient) GetRaceHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, RaceService_GetRaceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceServiceClient) RevertRace(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, RaceService_RevertRace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RaceServiceServer is the server API for RaceService service.
+// All implementations must embed UnimplementedRaceServiceServer
+// for forward compatibility.
+type RaceServiceServer interface {
+	// CreateRace creates a race together with its results.
+	CreateRace(context.Context, *RaceWithResults) (*RaceWithResults, error)
+	ListRaceResults(context.Context, *GetRequest) (*ListResultsResponse, error)
+	DeleteRace(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	RestoreRace(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	PurgeRace(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	BulkUpsertRaces(context.Context, *BulkUpsertRacesRequest) (*BulkResult, error)
+	GetRaceHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	RevertRace(context.Context, *RevertRequest) (*RevertResponse, error)
+	mustEmbedUnimplementedRaceServiceServer()
+}
+
+// UnimplementedRaceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRaceServiceServer struct{}
+
+func (UnimplementedRaceServiceServer) CreateRace(context.Context, *RaceWithResults) (*RaceWithResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRace not implemented")
+}
+func (UnimplementedRaceServiceServer) ListRaceResults(context.Context, *GetRequest) (*ListResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRaceResults not implemented")
+}
+func (UnimplementedRaceServiceServer) DeleteRace(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRace not implemented")
+}
+func (UnimplementedRaceServiceServer) RestoreRace(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRace not implemented")
+}
+func (UnimplementedRaceServiceServer) PurgeRace(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeRace not implemented")
+}
+func (UnimplementedRaceServiceServer) BulkUpsertRaces(context.Context, *BulkUpsertRacesRequest) (*BulkResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertRaces not implemented")
+}
+func (UnimplementedRaceServiceServer) GetRaceHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRaceHistory not implemented")
+}
+func (UnimplementedRaceServiceServer) RevertRace(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertRace not implemented")
+}
+func (UnimplementedRaceServiceServer) mustEmbedUnimplementedRaceServiceServer() {}
+func (UnimplementedRaceServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeRaceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RaceServiceServer will
+// result in compilation errors.
+type UnsafeRaceServiceServer interface {
+	mustEmbedUnimplementedRaceServiceServer()
+}
+
+func RegisterRaceServiceServer(s grpc.ServiceRegistrar, srv RaceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRaceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RaceService_ServiceDesc, srv)
+}
+
+func _RaceService_CreateRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaceWithResults)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceServiceServer).CreateRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RaceService_CreateRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceServiceServer).CreateRace(ctx, req.(*RaceWithResults))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceService_ListRaceResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceServiceServer).ListRaceResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RaceService_ListRaceResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceServiceServer).ListRaceResults(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceService_DeleteRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceServiceServer).DeleteRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RaceService_DeleteRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceServiceServer).DeleteRace(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceService_RestoreRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceServiceServer).RestoreRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RaceService_RestoreRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceServiceServer).RestoreRace(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceService_PurgeRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceServiceServer).PurgeRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RaceService_PurgeRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceServiceServer).PurgeRace(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceService_BulkUpsertRaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertRacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceServiceServer).BulkUpsertRaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RaceService_BulkUpsertRaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceServiceServer).BulkUpsertRaces(ctx, req.(*BulkUpsertRacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceService_GetRaceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceServiceServer).GetRaceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RaceService_GetRaceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceServiceServer).GetRaceHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceService_RevertRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceServiceServer).RevertRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RaceService_RevertRace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceServiceServer).RevertRace(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RaceService_ServiceDesc is the grpc.ServiceDesc for RaceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "f1.v1.RaceService",
+	HandlerType: (*RaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRace",
+			Handler:    _RaceService_CreateRace_Handler,
+		},
+		{
+			MethodName: "ListRaceResults",
+			Handler:    _RaceService_ListRaceResults_Handler,
+		},
+		{
+			MethodName: "DeleteRace",
+			Handler:    _RaceService_DeleteRace_Handler,
+		},
+		{
+			MethodName: "RestoreRace",
+			Handler:    _RaceService_RestoreRace_Handler,
+		},
+		{
+			MethodName: "PurgeRace",
+			Handler:    _RaceService_PurgeRace_Handler,
+		},
+		{
+			MethodName: "BulkUpsertRaces",
+			Handler:    _RaceService_BulkUpsertRaces_Handler,
+		},
+		{
+			MethodName: "GetRaceHistory",
+			Handler:    _RaceService_GetRaceHistory_Handler,
+		},
+		{
+			MethodName: "RevertRace",
+			Handler:    _RaceService_RevertRace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "f1/v1/f1.proto",
+}
+
+const (
+	ResultService_DeleteResult_FullMethodName      = "/f1.v1.ResultService/DeleteResult"
+	ResultService_RestoreResult_FullMethodName     = "/f1.v1.ResultService/RestoreResult"
+	ResultService_PurgeResult_FullMethodName       = "/f1.v1.ResultService/PurgeResult"
+	ResultService_BulkUpsertResults_FullMethodName = "/f1.v1.ResultService/BulkUpsertResults"
+	ResultService_GetResultHistory_FullMethodName  = "/f1.v1.ResultService/GetResultHistory"
+	ResultService_RevertResult_FullMethodName      = "/f1.v1.ResultService/RevertResult"
+)
+
+// ResultServiceClient is the client API for ResultService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ResultServiceClient interface {
+	DeleteResult(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreResult(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeResult(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkUpsertResults(ctx context.Context, in *BulkUpsertResultsRequest, opts ...grpc.CallOption) (*BulkResult, error)
+	GetResultHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	RevertResult(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+}
+
+type resultServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResultServiceClient(cc grpc.ClientConnInterface) ResultServiceClient {
+	return &resultServiceClient{cc}
+}
+
+func (c *resultServiceClient) DeleteResult(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResultService_DeleteResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) RestoreResult(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResultService_RestoreResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) PurgeResult(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResultService_PurgeResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) BulkUpsertResults(ctx context.Context, in *BulkUpsertResultsRequest, opts ...grpc.CallOption) (*BulkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResult)
+	err := c.cc.Invoke(ctx, ResultService_BulkUpsertResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetResultHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, ResultService_GetResultHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) RevertResult(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, ResultService_RevertResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResultServiceServer is the server API for ResultService service.
+// All implementations must embed UnimplementedResultServiceServer
+// for forward compatibility.
+type ResultServiceServer interface {
+	DeleteResult(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	RestoreResult(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	PurgeResult(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	BulkUpsertResults(context.Context, *BulkUpsertResultsRequest) (*BulkResult, error)
+	GetResultHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	RevertResult(context.Context, *RevertRequest) (*RevertResponse, error)
+	mustEmbedUnimplementedResultServiceServer()
+}
+
+// UnimplementedResultServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResultServiceServer struct{}
+
+func (UnimplementedResultServiceServer) DeleteResult(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResult not implemented")
+}
+func (UnimplementedResultServiceServer) RestoreResult(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreResult not implemented")
+}
+func (UnimplementedResultServiceServer) PurgeResult(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeResult not implemented")
+}
+func (UnimplementedResultServiceServer) BulkUpsertResults(context.Context, *BulkUpsertResultsRequest) (*BulkResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertResults not implemented")
+}
+func (UnimplementedResultServiceServer) GetResultHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResultHistory not implemented")
+}
+func (UnimplementedResultServiceServer) RevertResult(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertResult not implemented")
+}
+func (UnimplementedResultServiceServer) mustEmbedUnimplementedResultServiceServer() {}
+func (UnimplementedResultServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeResultServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResultServiceServer will
+// result in compilation errors.
+type UnsafeResultServiceServer interface {
+	mustEmbedUnimplementedResultServiceServer()
+}
+
+func RegisterResultServiceServer(s grpc.ServiceRegistrar, srv ResultServiceServer) {
+	// If the following call pancis, it indicates UnimplementedResultServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ResultService_ServiceDesc, srv)
+}
+
+func _ResultService_DeleteResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).DeleteResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_DeleteResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).DeleteResult(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_RestoreResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).RestoreResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_RestoreResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).RestoreResult(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_PurgeResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).PurgeResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_PurgeResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).PurgeResult(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_BulkUpsertResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).BulkUpsertResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_BulkUpsertResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).BulkUpsertResults(ctx, req.(*BulkUpsertResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetResultHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetResultHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetResultHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetResultHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_RevertResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).RevertResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_RevertResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).RevertResult(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResultService_ServiceDesc is the grpc.ServiceDesc for ResultService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResultService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "f1.v1.ResultService",
+	HandlerType: (*ResultServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteResult",
+			Handler:    _ResultService_DeleteResult_Handler,
+		},
+		{
+			MethodName: "RestoreResult",
+			Handler:    _ResultService_RestoreResult_Handler,
+		},
+		{
+			MethodName: "PurgeResult",
+			Handler:    _ResultService_PurgeResult_Handler,
+		},
+		{
+			MethodName: "BulkUpsertResults",
+			Handler:    _ResultService_BulkUpsertResults_Handler,
+		},
+		{
+			MethodName: "GetResultHistory",
+			Handler:    _ResultService_GetResultHistory_Handler,
+		},
+		{
+			MethodName: "RevertResult",
+			Handler:    _ResultService_RevertResult_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "f1/v1/f1.proto",
+}
+
+const (
+	StandingService_ListSeasonDriverStandings_FullMethodName      = "/f1.v1.StandingService/ListSeasonDriverStandings"
+	StandingService_ListSeasonConstructorStandings_FullMethodName = "/f1.v1.StandingService/ListSeasonConstructorStandings"
+	StandingService_DeleteDriverStanding_FullMethodName           = "/f1.v1.StandingService/DeleteDriverStanding"
+	StandingService_RestoreDriverStanding_FullMethodName          = "/f1.v1.StandingService/RestoreDriverStanding"
+	StandingService_PurgeDriverStanding_FullMethodName            = "/f1.v1.StandingService/PurgeDriverStanding"
+	StandingService_BulkUpsertDriverStandings_FullMethodName      = "/f1.v1.StandingService/BulkUpsertDriverStandings"
+	StandingService_GetDriverStandingHistory_FullMethodName       = "/f1.v1.StandingService/GetDriverStandingHistory"
+	StandingService_RevertDriverStanding_FullMethodName           = "/f1.v1.StandingService/RevertDriverStanding"
+	StandingService_DeleteConstructorStanding_FullMethodName      = "/f1.v1.StandingService/DeleteConstructorStanding"
+	StandingService_RestoreConstructorStanding_FullMethodName     = "/f1.v1.StandingService/RestoreConstructorStanding"
+	StandingService_PurgeConstructorStanding_FullMethodName       = "/f1.v1.StandingService/PurgeConstructorStanding"
+	StandingService_BulkUpsertConstructorStandings_FullMethodName = "/f1.v1.StandingService/BulkUpsertConstructorStandings"
+	StandingService_GetConstructorStandingHistory_FullMethodName  = "/f1.v1.StandingService/GetConstructorStandingHistory"
+	StandingService_RevertConstructorStanding_FullMethodName      = "/f1.v1.StandingService/RevertConstructorStanding"
+)
+
+// StandingServiceClient is the client API for StandingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StandingServiceClient interface {
+	ListSeasonDriverStandings(ctx context.Context, in *SeasonRequest, opts ...grpc.CallOption) (*ListDriverStandingsResponse, error)
+	ListSeasonConstructorStandings(ctx context.Context, in *SeasonRequest, opts ...grpc.CallOption) (*ListConstructorStandingsResponse, error)
+	DeleteDriverStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreDriverStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeDriverStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkUpsertDriverStandings(ctx context.Context, in *BulkUpsertDriverStandingsRequest, opts ...grpc.CallOption) (*BulkResult, error)
+	GetDriverStandingHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	RevertDriverStanding(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+	DeleteConstructorStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreConstructorStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PurgeConstructorStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BulkUpsertConstructorStandings(ctx context.Context, in *BulkUpsertConstructorStandingsRequest, opts ...grpc.CallOption) (*BulkResult, error)
+	GetConstructorStandingHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	RevertConstructorStanding(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error)
+}
+
+type standingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStandingServiceClient(cc grpc.ClientConnInterface) StandingServiceClient {
+	return &standingServiceClient{cc}
+}
+
+func (c *standingServiceClient) ListSeasonDriverStandings(ctx context.Context, in *SeasonRequest, opts ...grpc.CallOption) (*ListDriverStandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDriverStandingsResponse)
+	err := c.cc.Invoke(ctx, StandingService_ListSeasonDriverStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) ListSeasonConstructorStandings(ctx context.Context, in *SeasonRequest, opts ...grpc.CallOption) (*ListConstructorStandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConstructorStandingsResponse)
+	err := c.cc.Invoke(ctx, StandingService_ListSeasonConstructorStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) DeleteDriverStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StandingService_DeleteDriverStanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) RestoreDriverStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StandingService_RestoreDriverStanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) PurgeDriverStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StandingService_PurgeDriverStanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) BulkUpsertDriverStandings(ctx context.Context, in *BulkUpsertDriverStandingsRequest, opts ...grpc.CallOption) (*BulkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResult)
+	err := c.cc.Invoke(ctx, StandingService_BulkUpsertDriverStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) GetDriverStandingHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, StandingService_GetDriverStandingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) RevertDriverStanding(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, StandingService_RevertDriverStanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) DeleteConstructorStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StandingService_DeleteConstructorStanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) RestoreConstructorStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StandingService_RestoreConstructorStanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) PurgeConstructorStanding(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StandingService_PurgeConstructorStanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) BulkUpsertConstructorStandings(ctx context.Context, in *BulkUpsertConstructorStandingsRequest, opts ...grpc.CallOption) (*BulkResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResult)
+	err := c.cc.Invoke(ctx, StandingService_BulkUpsertConstructorStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) GetConstructorStandingHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, StandingService_GetConstructorStandingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingServiceClient) RevertConstructorStanding(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, StandingService_RevertConstructorStanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StandingServiceServer is the server API for StandingService service.
+// All implementations must embed UnimplementedStandingServiceServer
+// for forward compatibility.
+type StandingServiceServer interface {
+	ListSeasonDriverStandings(context.Context, *SeasonRequest) (*ListDriverStandingsResponse, error)
+	ListSeasonConstructorStandings(context.Context, *SeasonRequest) (*ListConstructorStandingsResponse, error)
+	DeleteDriverStanding(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	RestoreDriverStanding(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	PurgeDriverStanding(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	BulkUpsertDriverStandings(context.Context, *BulkUpsertDriverStandingsRequest) (*BulkResult, error)
+	GetDriverStandingHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	RevertDriverStanding(context.Context, *RevertRequest) (*RevertResponse, error)
+	DeleteConstructorStanding(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	RestoreConstructorStanding(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	PurgeConstructorStanding(context.Context, *RecordRequest) (*emptypb.Empty, error)
+	BulkUpsertConstructorStandings(context.Context, *BulkUpsertConstructorStandingsRequest) (*BulkResult, error)
+	GetConstructorStandingHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	RevertConstructorStanding(context.Context, *RevertRequest) (*RevertResponse, error)
+	mustEmbedUnimplementedStandingServiceServer()
+}
+
+// UnimplementedStandingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStandingServiceServer struct{}
+
+func (UnimplementedStandingServiceServer) ListSeasonDriverStandings(context.Context, *SeasonRequest) (*ListDriverStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonDriverStandings not implemented")
+}
+func (UnimplementedStandingServiceServer) ListSeasonConstructorStandings(context.Context, *SeasonRequest) (*ListConstructorStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonConstructorStandings not implemented")
+}
+func (UnimplementedStandingServiceServer) DeleteDriverStanding(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDriverStanding not implemented")
+}
+func (UnimplementedStandingServiceServer) RestoreDriverStanding(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDriverStanding not implemented")
+}
+func (UnimplementedStandingServiceServer) PurgeDriverStanding(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDriverStanding not implemented")
+}
+func (UnimplementedStandingServiceServer) BulkUpsertDriverStandings(context.Context, *BulkUpsertDriverStandingsRequest) (*BulkResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertDriverStandings not implemented")
+}
+func (UnimplementedStandingServiceServer) GetDriverStandingHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverStandingHistory not implemented")
+}
+func (UnimplementedStandingServiceServer) RevertDriverStanding(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertDriverStanding not implemented")
+}
+func (UnimplementedStandingServiceServer) DeleteConstructorStanding(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConstructorStanding not implemented")
+}
+func (UnimplementedStandingServiceServer) RestoreConstructorStanding(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConstructorStanding not implemented")
+}
+func (UnimplementedStandingServiceServer) PurgeConstructorStanding(context.Context, *RecordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeConstructorStanding not implemented")
+}
+func (UnimplementedStandingServiceServer) BulkUpsertConstructorStandings(context.Context, *BulkUpsertConstructorStandingsRequest) (*BulkResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertConstructorStandings not implemented")
+}
+func (UnimplementedStandingServiceServer) GetConstructorStandingHistory(context.Context, *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConstructorStandingHistory not implemented")
+}
+func (UnimplementedStandingServiceServer) RevertConstructorStanding(context.Context, *RevertRequest) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertConstructorStanding not implemented")
+}
+func (UnimplementedStandingServiceServer) mustEmbedUnimplementedStandingServiceServer() {}
+func (UnimplementedStandingServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeStandingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StandingServiceServer will
+// result in compilation errors.
+type UnsafeStandingServiceServer interface {
+	mustEmbedUnimplementedStandingServiceServer()
+}
+
+func RegisterStandingServiceServer(s grpc.ServiceRegistrar, srv StandingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStandingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StandingService_ServiceDesc, srv)
+}
+
+func _StandingService_ListSeasonDriverStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).ListSeasonDriverStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_ListSeasonDriverStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).ListSeasonDriverStandings(ctx, req.(*SeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_ListSeasonConstructorStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).ListSeasonConstructorStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_ListSeasonConstructorStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).ListSeasonConstructorStandings(ctx, req.(*SeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_DeleteDriverStanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).DeleteDriverStanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_DeleteDriverStanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).DeleteDriverStanding(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_RestoreDriverStanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).RestoreDriverStanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_RestoreDriverStanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).RestoreDriverStanding(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_PurgeDriverStanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).PurgeDriverStanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_PurgeDriverStanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).PurgeDriverStanding(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_BulkUpsertDriverStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertDriverStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).BulkUpsertDriverStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_BulkUpsertDriverStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).BulkUpsertDriverStandings(ctx, req.(*BulkUpsertDriverStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_GetDriverStandingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).GetDriverStandingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_GetDriverStandingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).GetDriverStandingHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_RevertDriverStanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).RevertDriverStanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_RevertDriverStanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).RevertDriverStanding(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_DeleteConstructorStanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).DeleteConstructorStanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_DeleteConstructorStanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).DeleteConstructorStanding(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_RestoreConstructorStanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).RestoreConstructorStanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_RestoreConstructorStanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).RestoreConstructorStanding(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_PurgeConstructorStanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).PurgeConstructorStanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_PurgeConstructorStanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).PurgeConstructorStanding(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_BulkUpsertConstructorStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertConstructorStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).BulkUpsertConstructorStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_BulkUpsertConstructorStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).BulkUpsertConstructorStandings(ctx, req.(*BulkUpsertConstructorStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_GetConstructorStandingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).GetConstructorStandingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_GetConstructorStandingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).GetConstructorStandingHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingService_RevertConstructorStanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingServiceServer).RevertConstructorStanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingService_RevertConstructorStanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingServiceServer).RevertConstructorStanding(ctx, req.(*RevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StandingService_ServiceDesc is the grpc.ServiceDesc for StandingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StandingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "f1.v1.StandingService",
+	HandlerType: (*StandingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSeasonDriverStandings",
+			Handler:    _StandingService_ListSeasonDriverStandings_Handler,
+		},
+		{
+			MethodName: "ListSeasonConstructorStandings",
+			Handler:    _StandingService_ListSeasonConstructorStandings_Handler,
+		},
+		{
+			MethodName: "DeleteDriverStanding",
+			Handler:    _StandingService_DeleteDriverStanding_Handler,
+		},
+		{
+			MethodName: "RestoreDriverStanding",
+			Handler:    _StandingService_RestoreDriverStanding_Handler,
+		},
+		{
+			MethodName: "PurgeDriverStanding",
+			Handler:    _StandingService_PurgeDriverStanding_Handler,
+		},
+		{
+			MethodName: "BulkUpsertDriverStandings",
+			Handler:    _StandingService_BulkUpsertDriverStandings_Handler,
+		},
+		{
+			MethodName: "GetDriverStandingHistory",
+			Handler:    _StandingService_GetDriverStandingHistory_Handler,
+		},
+		{
+			MethodName: "RevertDriverStanding",
+			Handler:    _StandingService_RevertDriverStanding_Handler,
+		},
+		{
+			MethodName: "DeleteConstructorStanding",
+			Handler:    _StandingService_DeleteConstructorStanding_Handler,
+		},
+		{
+			MethodName: "RestoreConstructorStanding",
+			Handler:    _StandingService_RestoreConstructorStanding_Handler,
+		},
+		{
+			MethodName: "PurgeConstructorStanding",
+			Handler:    _StandingService_PurgeConstructorStanding_Handler,
+		},
+		{
+			MethodName: "BulkUpsertConstructorStandings",
+			Handler:    _StandingService_BulkUpsertConstructorStandings_Handler,
+		},
+		{
+			MethodName: "GetConstructorStandingHistory",
+			Handler:    _StandingService_GetConstructorStandingHistory_Handler,
+		},
+		{
+			MethodName: "RevertConstructorStanding",
+			Handler:    _StandingService_RevertConstructorStanding_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "f1/v1/f1.proto",
+}
@@ -0,0 +1,1417 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: f1/v1/models.proto
+
+package f1v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BulkMode int32
+
+const (
+	// Treated as BULK_MODE_ATOMIC.
+	BulkMode_BULK_MODE_UNSPECIFIED BulkMode = 0
+	// Apply every item or none of them.
+	BulkMode_BULK_MODE_ATOMIC BulkMode = 1
+	// Apply every item that can be and report the rest.
+	BulkMode_BULK_MODE_BEST_EFFORT BulkMode = 2
+)
+
+// Enum value maps for BulkMode.
+var (
+	BulkMode_name = map[int32]string{
+		0: "BULK_MODE_UNSPECIFIED",
+		1: "BULK_MODE_ATOMIC",
+		2: "BULK_MODE_BEST_EFFORT",
+	}
+	BulkMode_value = map[string]int32{
+		"BULK_MODE_UNSPECIFIED": 0,
+		"BULK_MODE_ATOMIC":      1,
+		"BULK_MODE_BEST_EFFORT": 2,
+	}
+)
+
+func (x BulkMode) Enum() *BulkMode {
+	p := new(BulkMode)
+	*p = x
+	return p
+}
+
+func (x BulkMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_f1_v1_models_proto_enumTypes[0].Descriptor()
+}
+
+func (BulkMode) Type() protoreflect.EnumType {
+	return &file_f1_v1_models_proto_enumTypes[0]
+}
+
+func (x BulkMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkMode.Descriptor instead.
+func (BulkMode) EnumDescriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{0}
+}
+
+type Constructor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ref           string                 `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Nationality   string                 `protobuf:"bytes,4,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Constructor) Reset() {
+	*x = Constructor{}
+	mi := &file_f1_v1_models_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Constructor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constructor) ProtoMessage() {}
+
+func (x *Constructor) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constructor.ProtoReflect.Descriptor instead.
+func (*Constructor) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Constructor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Constructor) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Constructor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Constructor) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *Constructor) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Constructor) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type Driver struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The constructor's name. When writing it may also be its ID or ref.
+	Constructor   string                 `protobuf:"bytes,2,opt,name=constructor,proto3" json:"constructor,omitempty"`
+	Ref           string                 `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`
+	Code          *string                `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	Number        *int32                 `protobuf:"varint,5,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	FirstName     string                 `protobuf:"bytes,6,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,7,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DateOfBirth   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Nationality   string                 `protobuf:"bytes,9,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Url           string                 `protobuf:"bytes,11,opt,name=url,proto3" json:"url,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Driver) Reset() {
+	*x = Driver{}
+	mi := &file_f1_v1_models_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Driver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Driver) ProtoMessage() {}
+
+func (x *Driver) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Driver.ProtoReflect.Descriptor instead.
+func (*Driver) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Driver) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Driver) GetConstructor() string {
+	if x != nil {
+		return x.Constructor
+	}
+	return ""
+}
+
+func (x *Driver) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Driver) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *Driver) GetNumber() int32 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+func (x *Driver) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Driver) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Driver) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *Driver) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *Driver) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Driver) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Driver) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type Circuit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ref           string                 `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Country       string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Current       bool                   `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Circuit) Reset() {
+	*x = Circuit{}
+	mi := &file_f1_v1_models_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Circuit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Circuit) ProtoMessage() {}
+
+func (x *Circuit) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Circuit.ProtoReflect.Descriptor instead.
+func (*Circuit) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Circuit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Circuit) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Circuit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Circuit) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Circuit) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Circuit) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *Circuit) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Circuit) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type Season struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	mi := &file_f1_v1_models_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Season) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Season) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Season) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Season) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type Race struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeasonId      string                 `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	CircuitId     string                 `protobuf:"bytes,3,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	Round         int32                  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Race) Reset() {
+	*x = Race{}
+	mi := &file_f1_v1_models_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Race) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Race) ProtoMessage() {}
+
+func (x *Race) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Race.ProtoReflect.Descriptor instead.
+func (*Race) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Race) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Race) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *Race) GetCircuitId() string {
+	if x != nil {
+		return x.CircuitId
+	}
+	return ""
+}
+
+func (x *Race) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Race) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Race) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Race) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Race) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type RaceWithResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Race          *Race                  `protobuf:"bytes,1,opt,name=race,proto3" json:"race,omitempty"`
+	Results       []*Result              `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaceWithResults) Reset() {
+	*x = RaceWithResults{}
+	mi := &file_f1_v1_models_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaceWithResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaceWithResults) ProtoMessage() {}
+
+func (x *RaceWithResults) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaceWithResults.ProtoReflect.Descriptor instead.
+func (*RaceWithResults) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RaceWithResults) GetRace() *Race {
+	if x != nil {
+		return x.Race
+	}
+	return nil
+}
+
+func (x *RaceWithResults) GetResults() []*Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Result struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RaceId        string                 `protobuf:"bytes,2,opt,name=race_id,json=raceId,proto3" json:"race_id,omitempty"`
+	DriverId      string                 `protobuf:"bytes,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	ConstructorId string                 `protobuf:"bytes,4,opt,name=constructor_id,json=constructorId,proto3" json:"constructor_id,omitempty"`
+	Number        int32                  `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"`
+	Grid          int32                  `protobuf:"varint,6,opt,name=grid,proto3" json:"grid,omitempty"`
+	// Unset for drivers that were not classified.
+	Position      *int32                 `protobuf:"varint,7,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	PositionText  string                 `protobuf:"bytes,8,opt,name=position_text,json=positionText,proto3" json:"position_text,omitempty"`
+	Points        float64                `protobuf:"fixed64,9,opt,name=points,proto3" json:"points,omitempty"`
+	Laps          int32                  `protobuf:"varint,10,opt,name=laps,proto3" json:"laps,omitempty"`
+	Time          string                 `protobuf:"bytes,11,opt,name=time,proto3" json:"time,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	mi := &file_f1_v1_models_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Result) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Result) GetRaceId() string {
+	if x != nil {
+		return x.RaceId
+	}
+	return ""
+}
+
+func (x *Result) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *Result) GetConstructorId() string {
+	if x != nil {
+		return x.ConstructorId
+	}
+	return ""
+}
+
+func (x *Result) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Result) GetGrid() int32 {
+	if x != nil {
+		return x.Grid
+	}
+	return 0
+}
+
+func (x *Result) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+func (x *Result) GetPositionText() string {
+	if x != nil {
+		return x.PositionText
+	}
+	return ""
+}
+
+func (x *Result) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Result) GetLaps() int32 {
+	if x != nil {
+		return x.Laps
+	}
+	return 0
+}
+
+func (x *Result) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Result) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Result) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type DriverStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeasonId      string                 `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	DriverId      string                 `protobuf:"bytes,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Points        float64                `protobuf:"fixed64,5,opt,name=points,proto3" json:"points,omitempty"`
+	Wins          int32                  `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverStanding) Reset() {
+	*x = DriverStanding{}
+	mi := &file_f1_v1_models_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverStanding) ProtoMessage() {}
+
+func (x *DriverStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverStanding.ProtoReflect.Descriptor instead.
+func (*DriverStanding) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DriverStanding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DriverStanding) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *DriverStanding) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *DriverStanding) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *DriverStanding) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *DriverStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *DriverStanding) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ConstructorStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeasonId      string                 `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	ConstructorId string                 `protobuf:"bytes,3,opt,name=constructor_id,json=constructorId,proto3" json:"constructor_id,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Points        float64                `protobuf:"fixed64,5,opt,name=points,proto3" json:"points,omitempty"`
+	Wins          int32                  `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConstructorStanding) Reset() {
+	*x = ConstructorStanding{}
+	mi := &file_f1_v1_models_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConstructorStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstructorStanding) ProtoMessage() {}
+
+func (x *ConstructorStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstructorStanding.ProtoReflect.Descriptor instead.
+func (*ConstructorStanding) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConstructorStanding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConstructorStanding) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ConstructorStanding) GetConstructorId() string {
+	if x != nil {
+		return x.ConstructorId
+	}
+	return ""
+}
+
+func (x *ConstructorStanding) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ConstructorStanding) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *ConstructorStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *ConstructorStanding) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// AuditEntry is one recorded change of a record. before and after hold the
+// record and are unset for creations and purges respectively; changes maps
+// each modified column to its "from" and "to" values.
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityType    string                 `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Before        *structpb.Struct       `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Struct       `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	Changes       *structpb.Struct       `protobuf:"bytes,9,opt,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_f1_v1_models_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetChanges() *structpb.Struct {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BulkItemResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Index int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// created, updated, failed, rolled_back or skipped.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// The record as stored.
+	Data          *structpb.Struct `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkItemResult) Reset() {
+	*x = BulkItemResult{}
+	mi := &file_f1_v1_models_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkItemResult) ProtoMessage() {}
+
+func (x *BulkItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkItemResult.ProtoReflect.Descriptor instead.
+func (*BulkItemResult) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BulkItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkItemResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkItemResult) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BulkResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          BulkMode               `protobuf:"varint,1,opt,name=mode,proto3,enum=f1.v1.BulkMode" json:"mode,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Items         []*BulkItemResult      `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkResult) Reset() {
+	*x = BulkResult{}
+	mi := &file_f1_v1_models_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkResult) ProtoMessage() {}
+
+func (x *BulkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_f1_v1_models_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkResult.ProtoReflect.Descriptor instead.
+func (*BulkResult) Descriptor() ([]byte, []int) {
+	return file_f1_v1_models_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BulkResult) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+func (x *BulkResult) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkResult) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BulkResult) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkResult) GetItems() []*BulkItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_f1_v1_models_proto protoreflect.FileDescriptor
+
+const file_f1_v1_models_proto_rawDesc = "" +
+	"\n" +
+	"\x12f1/v1/models.proto\x12\x05f1.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x01\n" +
+	"\vConstructor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03ref\x18\x02 \x01(\tR\x03ref\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vnationality\x18\x04 \x01(\tR\vnationality\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\x99\x03\n" +
+	"\x06Driver\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vconstructor\x18\x02 \x01(\tR\vconstructor\x12\x10\n" +
+	"\x03ref\x18\x03 \x01(\tR\x03ref\x12\x17\n" +
+	"\x04code\x18\x04 \x01(\tH\x00R\x04code\x88\x01\x01\x12\x1b\n" +
+	"\x06number\x18\x05 \x01(\x05H\x01R\x06number\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x06 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\a \x01(\tR\blastName\x12>\n" +
+	"\rdate_of_birth\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vdateOfBirth\x12 \n" +
+	"\vnationality\x18\t \x01(\tR\vnationality\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x10\n" +
+	"\x03url\x18\v \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAtB\a\n" +
+	"\x05_codeB\t\n" +
+	"\a_number\"\xdc\x01\n" +
+	"\aCircuit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03ref\x18\x02 \x01(\tR\x03ref\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12\x18\n" +
+	"\acurrent\x18\x06 \x01(\bR\acurrent\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"y\n" +
+	"\x06Season\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xf9\x01\n" +
+	"\x04Race\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12\x1d\n" +
+	"\n" +
+	"circuit_id\x18\x03 \x01(\tR\tcircuitId\x12\x14\n" +
+	"\x05round\x18\x04 \x01(\x05R\x05round\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12.\n" +
+	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"[\n" +
+	"\x0fRaceWithResults\x12\x1f\n" +
+	"\x04race\x18\x01 \x01(\v2\v.f1.v1.RaceR\x04race\x12'\n" +
+	"\aresults\x18\x02 \x03(\v2\r.f1.v1.ResultR\aresults\"\x87\x03\n" +
+	"\x06Result\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\arace_id\x18\x02 \x01(\tR\x06raceId\x12\x1b\n" +
+	"\tdriver_id\x18\x03 \x01(\tR\bdriverId\x12%\n" +
+	"\x0econstructor_id\x18\x04 \x01(\tR\rconstructorId\x12\x16\n" +
+	"\x06number\x18\x05 \x01(\x05R\x06number\x12\x12\n" +
+	"\x04grid\x18\x06 \x01(\x05R\x04grid\x12\x1f\n" +
+	"\bposition\x18\a \x01(\x05H\x00R\bposition\x88\x01\x01\x12#\n" +
+	"\rposition_text\x18\b \x01(\tR\fpositionText\x12\x16\n" +
+	"\x06points\x18\t \x01(\x01R\x06points\x12\x12\n" +
+	"\x04laps\x18\n" +
+	" \x01(\x05R\x04laps\x12\x12\n" +
+	"\x04time\x18\v \x01(\tR\x04time\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAtB\v\n" +
+	"\t_position\"\xdd\x01\n" +
+	"\x0eDriverStanding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12\x1b\n" +
+	"\tdriver_id\x18\x03 \x01(\tR\bdriverId\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x01R\x06points\x12\x12\n" +
+	"\x04wins\x18\x06 \x01(\x05R\x04wins\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xec\x01\n" +
+	"\x13ConstructorStanding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12%\n" +
+	"\x0econstructor_id\x18\x03 \x01(\tR\rconstructorId\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x01R\x06points\x12\x12\n" +
+	"\x04wins\x18\x06 \x01(\x05R\x04wins\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xf5\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\ventity_type\x18\x02 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\x12/\n" +
+	"\x06before\x18\a \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\b \x01(\v2\x17.google.protobuf.StructR\x05after\x121\n" +
+	"\achanges\x18\t \x01(\v2\x17.google.protobuf.StructR\achanges\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x81\x01\n" +
+	"\x0eBulkItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12+\n" +
+	"\x04data\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x04data\"\xaa\x01\n" +
+	"\n" +
+	"BulkResult\x12#\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x0f.f1.v1.BulkModeR\x04mode\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12+\n" +
+	"\x05items\x18\x05 \x03(\v2\x15.f1.v1.BulkItemResultR\x05items*V\n" +
+	"\bBulkMode\x12\x19\n" +
+	"\x15BULK_MODE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10BULK_MODE_ATOMIC\x10\x01\x12\x19\n" +
+	"\x15BULK_MODE_BEST_EFFORT\x10\x02B-Z+github.com/ChinmayNoob/f1/pkg/pb/f1/v1;f1v1b\x06proto3"
+
+var (
+	file_f1_v1_models_proto_rawDescOnce sync.Once
+	file_f1_v1_models_proto_rawDescData []byte
+)
+
+func file_f1_v1_models_proto_rawDescGZIP() []byte {
+	file_f1_v1_models_proto_rawDescOnce.Do(func() {
+		file_f1_v1_models_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_f1_v1_models_proto_rawDesc), len(file_f1_v1_models_proto_rawDesc)))
+	})
+	return file_f1_v1_models_proto_rawDescData
+}
+
+var file_f1_v1_models_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_f1_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_f1_v1_models_proto_goTypes = []any{
+	(BulkMode)(0),                 // 0: f1.v1.BulkMode
+	(*Constructor)(nil),           // 1: f1.v1.Constructor
+	(*Driver)(nil),                // 2: f1.v1.Driver
+	(*Circuit)(nil),               // 3: f1.v1.Circuit
+	(*Season)(nil),                // 4: f1.v1.Season
+	(*Race)(nil),                  // 5: f1.v1.Race
+	(*RaceWithResults)(nil),       // 6: f1.v1.RaceWithResults
+	(*Result)(nil),                // 7: f1.v1.Result
+	(*DriverStanding)(nil),        // 8: f1.v1.DriverStanding
+	(*ConstructorStanding)(nil),   // 9: f1.v1.ConstructorStanding
+	(*AuditEntry)(nil),            // 10: f1.v1.AuditEntry
+	(*BulkItemResult)(nil),        // 11: f1.v1.BulkItemResult
+	(*BulkResult)(nil),            // 12: f1.v1.BulkResult
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 14: google.protobuf.Struct
+}
+var file_f1_v1_models_proto_depIdxs = []int32{
+	13, // 0: f1.v1.Constructor.deleted_at:type_name -> google.protobuf.Timestamp
+	13, // 1: f1.v1.Driver.date_of_birth:type_name -> google.protobuf.Timestamp
+	13, // 2: f1.v1.Driver.deleted_at:type_name -> google.protobuf.Timestamp
+	13, // 3: f1.v1.Circuit.deleted_at:type_name -> google.protobuf.Timestamp
+	13, // 4: f1.v1.Season.deleted_at:type_name -> google.protobuf.Timestamp
+	13, // 5: f1.v1.Race.date:type_name -> google.protobuf.Timestamp
+	13, // 6: f1.v1.Race.deleted_at:type_name -> google.protobuf.Timestamp
+	5,  // 7: f1.v1.RaceWithResults.race:type_name -> f1.v1.Race
+	7,  // 8: f1.v1.RaceWithResults.results:type_name -> f1.v1.Result
+	13, // 9: f1.v1.Result.deleted_at:type_name -> google.protobuf.Timestamp
+	13, // 10: f1.v1.DriverStanding.deleted_at:type_name -> google.protobuf.Timestamp
+	13, // 11: f1.v1.ConstructorStanding.deleted_at:type_name -> google.protobuf.Timestamp
+	14, // 12: f1.v1.AuditEntry.before:type_name -> google.protobuf.Struct
+	14, // 13: f1.v1.AuditEntry.after:type_name -> google.protobuf.Struct
+	14, // 14: f1.v1.AuditEntry.changes:type_name -> google.protobuf.Struct
+	13, // 15: f1.v1.AuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	14, // 16: f1.v1.BulkItemResult.data:type_name -> google.protobuf.Struct
+	0,  // 17: f1.v1.BulkResult.mode:type_name -> f1.v1.BulkMode
+	11, // 18: f1.v1.BulkResult.items:type_name -> f1.v1.BulkItemResult
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_f1_v1_models_proto_init() }
+func file_f1_v1_models_proto_init() {
+	if File_f1_v1_models_proto != nil {
+		return
+	}
+	file_f1_v1_models_proto_msgTypes[1].OneofWrappers = []any{}
+	file_f1_v1_models_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_f1_v1_models_proto_rawDesc), len(file_f1_v1_models_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_f1_v1_models_proto_goTypes,
+		DependencyIndexes: file_f1_v1_models_proto_depIdxs,
+		EnumInfos:         file_f1_v1_models_proto_enumTypes,
+		MessageInfos:      file_f1_v1_models_proto_msgTypes,
+	}.Build()
+	File_f1_v1_models_proto = out.File
+	file_f1_v1_models_proto_goTypes = nil
+	file_f1_v1_models_proto_depIdxs = nil
+}